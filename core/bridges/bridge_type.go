@@ -21,6 +21,12 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// MaxConcurrency is the maximum number of concurrent requests to the
+	// bridge, 0 if unlimited
+	MaxConcurrency uint32 `json:"maxConcurrency"`
+	// RateLimit is the maximum number of requests per second to the bridge,
+	// 0 if unlimited
+	RateLimit float64 `json:"rateLimit"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +54,8 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	MaxConcurrency         uint32
+	RateLimit              float64
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,6 +68,8 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	MaxConcurrency         uint32
+	RateLimit              float64
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
@@ -84,6 +94,8 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			IncomingToken:          incomingToken,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			MaxConcurrency:         btr.MaxConcurrency,
+			RateLimit:              btr.RateLimit,
 		}, &BridgeType{
 			Name:                   btr.Name,
 			URL:                    btr.URL,
@@ -92,6 +104,8 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			MaxConcurrency:         btr.MaxConcurrency,
+			RateLimit:              btr.RateLimit,
 		}, nil
 }
 
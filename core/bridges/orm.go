@@ -14,10 +14,10 @@ import (
 
 type ORM interface {
 	FindBridge(name BridgeName) (bt BridgeType, err error)
-	FindBridges(name []BridgeName) (bts []BridgeType, err error)
+	FindBridges(name []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error)
 	DeleteBridgeType(bt *BridgeType) error
 	BridgeTypes(offset int, limit int) ([]BridgeType, int, error)
-	CreateBridgeType(bt *BridgeType) error
+	CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error
 	UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error
 
 	ExternalInitiators(offset int, limit int) ([]ExternalInitiator, int, error)
@@ -49,13 +49,14 @@ func (o *orm) FindBridge(name BridgeName) (bt BridgeType, err error) {
 // FindBridges looks up multiple bridges in a single query.
 // Errors unless all bridges successfully found. Requires at least one bridge.
 // Expects all bridges to be unique
-func (o *orm) FindBridges(names []BridgeName) (bts []BridgeType, err error) {
+func (o *orm) FindBridges(names []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error) {
+	q := o.q.WithOpts(qopts...)
 	sql := "SELECT * FROM bridge_types WHERE name IN (?)"
 	query, args, err := sqlx.In(sql, names)
 	if err != nil {
 		return nil, err
 	}
-	err = o.q.Select(&bts, o.q.Rebind(query), args...)
+	err = q.Select(&bts, q.Rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,11 +101,11 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 }
 
 // CreateBridgeType saves the bridge type.
-func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+func (o *orm) CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error {
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, max_concurrency, rate_limit, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :max_concurrency, :rate_limit, now(), now())
 	RETURNING *;`
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	err := o.q.WithOpts(qopts...).Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
 		if err != nil {
 			return err
@@ -117,8 +118,8 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
 	btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, max_concurrency = $4, rate_limit = $5 WHERE name = $6 RETURNING *"
+	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, btr.MaxConcurrency, btr.RateLimit, bt.Name)
 }
 
 // --- External Initiator
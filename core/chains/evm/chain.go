@@ -22,8 +22,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -40,6 +42,8 @@ type Chain interface {
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() *logpoller.LogPoller
+	// StandbyHead returns the latest head seen while the node is on standby
+	StandbyHead() *types.Head
 }
 
 var _ Chain = &chain{}
@@ -56,7 +60,12 @@ type chain struct {
 	logBroadcaster  log.Broadcaster
 	logPoller       *logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	topUpper        monitor.TopUpper
 	keyStore        keystore.Eth
+
+	standbyMu sync.Mutex
+	standby   *chainStandby
+	dialed    bool
 }
 
 func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*chain, error) {
@@ -81,7 +90,7 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		client = evmclient.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err2 error
-		client, err2 = newEthClientFromChain(cfg, l, dbchain, nodes)
+		client, err2 = newEthClientFromChain(cfg, l, dbchain, nodes, opts.AlertNotifier)
 		if err2 != nil {
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", dbchain.ID.String())
 		}
@@ -117,7 +126,7 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
 		checker := &txmgr.CheckerFactory{Client: client}
-		txm = txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		txm = txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller, opts.AlertNotifier)
 	} else {
 		txm = opts.GenTxManager(dbchain)
 	}
@@ -132,10 +141,17 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 
 	var balanceMonitor monitor.BalanceMonitor
 	if cfg.EVMRPCEnabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, cfg, l, opts.AlertNotifier)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var topUpper monitor.TopUpper
+	if balanceMonitor != nil && cfg.EvmTopUpEnabled() {
+		topUpORM := monitor.NewTopUpORM(db, l, cfg, *chainID)
+		topUpper = monitor.NewTopUpper(pg.NewQ(db, l, cfg), client, cfg, opts.KeyStore, balanceMonitor, txm, topUpORM, l)
+		headBroadcaster.Subscribe(topUpper)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !cfg.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -164,6 +180,7 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		topUpper:        topUpper,
 		keyStore:        opts.KeyStore,
 	}, nil
 }
@@ -172,9 +189,12 @@ func (c *chain) Start(ctx context.Context) error {
 	return c.StartOnce("Chain", func() (merr error) {
 		c.logger.Debugf("Chain: starting with ID %s", c.ID().String())
 		// Must ensure that EthClient is dialed first because subsequent
-		// services may make eth calls on startup
-		if err := c.client.Dial(ctx); err != nil {
-			return errors.Wrap(err, "failed to dial ethclient")
+		// services may make eth calls on startup. A chain kept warm on
+		// standby is already dialed.
+		if dialed := c.stopStandby(); !dialed {
+			if err := c.client.Dial(ctx); err != nil {
+				return errors.Wrap(err, "failed to dial ethclient")
+			}
 		}
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
@@ -187,6 +207,9 @@ func (c *chain) Start(ctx context.Context) error {
 		if c.balanceMonitor != nil {
 			merr = multierr.Combine(merr, c.balanceMonitor.Start(ctx))
 		}
+		if c.topUpper != nil {
+			merr = multierr.Combine(merr, c.topUpper.Start(ctx))
+		}
 
 		if merr != nil {
 			return merr
@@ -230,9 +253,13 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.topUpper != nil {
+			c.logger.Debug("Chain: stopping top-upper")
+			merr = c.topUpper.Close()
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -260,6 +287,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.topUpper != nil {
+		merr = multierr.Combine(merr, c.topUpper.Ready())
+	}
 	return
 }
 
@@ -274,6 +304,9 @@ func (c *chain) Healthy() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.topUpper != nil {
+		merr = multierr.Combine(merr, c.topUpper.Healthy())
+	}
 	return
 }
 
@@ -288,7 +321,7 @@ func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 
-func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain types.DBChain, nodes []types.Node) (evmclient.Client, error) {
+func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain types.DBChain, nodes []types.Node, notifier alerts.Notifier) (evmclient.Client, error) {
 	chainID := big.Int(chain.ID)
 	var primaries []evmclient.Node
 	var sendonlys []evmclient.SendOnlyNode
@@ -307,7 +340,7 @@ func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain t
 			primaries = append(primaries, primary)
 		}
 	}
-	return evmclient.NewClientWithNodes(lggr, primaries, sendonlys, &chainID)
+	return evmclient.NewClientWithNodes(lggr, primaries, sendonlys, &chainID, notifier)
 }
 
 func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n types.Node) (evmclient.Node, error) {
@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -36,6 +37,8 @@ type ChainConfigUpdater func(*types.ChainCfg) error
 //go:generate mockery --name ChainSet --output ./mocks/ --case=underscore
 type ChainSet interface {
 	services.ServiceCtx
+	// StartStandby keeps the chains warm for a standby node, without writing to the database
+	StartStandby(ctx context.Context) error
 	Get(id *big.Int) (Chain, error)
 	Show(id utils.Big) (types.DBChain, error)
 	Add(ctx context.Context, id utils.Big, config types.ChainCfg) (types.DBChain, error)
@@ -88,11 +91,29 @@ func (cll *chainSet) Start(ctx context.Context) error {
 	cll.logger.Infow(fmt.Sprintf("EVM: Started %d/%d chains, default chain ID is %s", len(cll.startedChains), len(cll.Chains()), cll.defaultID.String()), "startedEvmChainIDs", evmChainIDs)
 	return nil
 }
+func (cll *chainSet) StartStandby(ctx context.Context) error {
+	if !cll.opts.Config.EVMEnabled() || !cll.opts.Config.EVMRPCEnabled() {
+		return nil
+	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for _, c := range cll.chains {
+		if err := c.startStandby(ctx); err != nil {
+			cll.logger.Errorw(fmt.Sprintf("EVM: Chain with ID %s failed to start standby, it will be dialed on promotion instead. Got error: %v", c.ID(), err), "evmChainID", c.ID(), "err", err)
+		}
+	}
+	return nil
+}
 func (cll *chainSet) Close() (err error) {
 	cll.logger.Debug("EVM: stopping")
 	for _, c := range cll.startedChains {
 		err = multierr.Combine(err, c.Close())
 	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for _, c := range cll.chains {
+		c.closeStandby()
+	}
 	return
 }
 func (cll *chainSet) Healthy() (err error) {
@@ -367,6 +388,7 @@ type ChainSetOpts struct {
 	KeyStore         keystore.Eth
 	EventBroadcaster pg.EventBroadcaster
 	ORM              types.ORM
+	AlertNotifier    alerts.Notifier
 
 	// Gen-functions are useful for dependency injection by tests
 	GenEthClient      func(types.DBChain) evmclient.Client
@@ -435,6 +457,9 @@ func checkOpts(opts *ChainSetOpts) error {
 	if opts.ORM == nil {
 		opts.ORM = NewORM(opts.DB, opts.Logger, opts.Config)
 	}
+	if opts.AlertNotifier == nil {
+		opts.AlertNotifier = alerts.NullNotifier{}
+	}
 	return nil
 }
 
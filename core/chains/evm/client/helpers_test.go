@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 )
 
 type TestNodeConfig struct {
@@ -41,7 +42,7 @@ func NewClientWithTestNode(cfg NodeConfig, lggr logger.Logger, rpcUrl string, rp
 		sendonlys = append(sendonlys, s)
 	}
 
-	pool := NewPool(lggr, primaries, sendonlys, chainID)
+	pool := NewPool(lggr, primaries, sendonlys, chainID, alerts.NullNotifier{})
 	return &client{logger: lggr, pool: pool}, nil
 }
 
@@ -14,10 +14,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -29,6 +34,9 @@ var (
 	}, []string{"evmChainID", "state"})
 )
 
+// tracerName is the name of the tracer of the RPC calls made through a pool
+const tracerName = "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+
 // Pool represents an abstraction over one or more primary nodes
 // It is responsible for liveness checking and balancing queries across live nodes
 type Pool struct {
@@ -38,12 +46,13 @@ type Pool struct {
 	chainID         *big.Int
 	roundRobinCount atomic.Uint32
 	logger          logger.Logger
+	notifier        alerts.Notifier
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
 }
 
-func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
+func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int, notifier alerts.Notifier) *Pool {
 	if chainID == nil {
 		panic("chainID is required")
 	}
@@ -54,6 +63,7 @@ func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chain
 		chainID,
 		atomic.Uint32{},
 		logger.Named("Pool").With("evmChainID", chainID.String()),
+		notifier,
 		make(chan struct{}),
 		sync.WaitGroup{},
 	}
@@ -157,6 +167,17 @@ func (p *Pool) report() {
 	p.logger.Tracew(fmt.Sprintf("Pool state: %d/%d nodes are alive", live, total), "nodeStates", nodeStates)
 	if total == dead {
 		p.logger.Criticalw(fmt.Sprintf("No EVM primary nodes available: 0/%d nodes are alive", total), "nodeStates", nodeStates)
+		details := map[string]string{"evmChainID": p.chainID.String()}
+		for _, ns := range nodeStates {
+			details[ns.Node] = ns.State
+		}
+		p.notifier.Notify(alerts.Alert{
+			Type:     alerts.TypeNoLiveRPCNodes,
+			Severity: alerts.SeverityCritical,
+			Key:      p.chainID.String(),
+			Summary:  fmt.Sprintf("No EVM primary nodes available for chain %s: 0/%d nodes are alive", p.chainID, total),
+			Details:  details,
+		})
 	} else if dead > 0 {
 		p.logger.Errorw(fmt.Sprintf("At least one EVM primary node is dead: %d/%d nodes are alive", live, total), "nodeStates", nodeStates)
 	}
@@ -219,11 +240,30 @@ func (p *Pool) liveNodes() (liveNodes []Node) {
 	return
 }
 
-func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+// startSpan starts the tracing span of an RPC call made through the pool.
+func (p *Pool) startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("rpc.method", method),
+		attribute.String("evm.chain_id", p.chainID.String()),
+	))
+}
+
+func endSpan(span trace.Span, err error) {
+	tracing.RecordError(span, err)
+	span.End()
+}
+
+func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) (err error) {
+	ctx, span := p.startSpan(ctx, method)
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().CallContext(ctx, result, method, args...)
 }
 
-func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) (err error) {
+	ctx, span := p.startSpan(ctx, "batch")
+	span.SetAttributes(attribute.Int("rpc.batch_size", len(b)))
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().BatchCallContext(ctx, b)
 }
 
@@ -231,7 +271,11 @@ func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 // sendonlys.
 // CAUTION: This should only be used for mass re-transmitting transactions, it
 // might have unexpected effects to use it for anything else.
-func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) (err error) {
+	ctx, span := p.startSpan(ctx, "batch")
+	span.SetAttributes(attribute.Int("rpc.batch_size", len(b)), attribute.Bool("rpc.all_nodes", true))
+	defer func() { endSpan(span, err) }()
+
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
@@ -263,7 +307,11 @@ func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error
 }
 
 // Wrapped Geth client methods
-func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	ctx, span := p.startSpan(ctx, "eth_sendRawTransaction")
+	span.SetAttributes(attribute.String("evm.tx_hash", tx.Hash().Hex()))
+	defer func() { endSpan(span, err) }()
+
 	main := p.getRoundRobin()
 	var all []SendOnlyNode
 	for _, n := range p.nodes {
@@ -308,64 +356,95 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	return main.SendTransaction(ctx, tx)
 }
 
-func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getCode")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().PendingCodeAt(ctx, account)
 }
 
-func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getTransactionCount")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().PendingNonceAt(ctx, account)
 }
 
-func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getTransactionCount")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().NonceAt(ctx, account, blockNumber)
 }
 
-func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getTransactionReceipt")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().TransactionReceipt(ctx, txHash)
 }
 
-func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (block *types.Block, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getBlockByNumber")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().BlockByNumber(ctx, number)
 }
 
-func (p *Pool) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+func (p *Pool) BlockByHash(ctx context.Context, hash common.Hash) (block *types.Block, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getBlockByHash")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().BlockByHash(ctx, hash)
 }
 
-func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getBalance")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().BalanceAt(ctx, account, blockNumber)
 }
 
-func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (logs []types.Log, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getLogs")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().FilterLogs(ctx, q)
 }
 
-func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	ctx, span := p.startSpan(ctx, "eth_subscribe")
+	span.SetAttributes(attribute.String("rpc.subscription", "logs"))
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().SubscribeFilterLogs(ctx, q, ch)
 }
 
-func (p *Pool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+func (p *Pool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	ctx, span := p.startSpan(ctx, "eth_estimateGas")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().EstimateGas(ctx, call)
 }
 
-func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+func (p *Pool) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	ctx, span := p.startSpan(ctx, "eth_gasPrice")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().SuggestGasPrice(ctx)
 }
 
-func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	ctx, span := p.startSpan(ctx, "eth_call")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().CallContract(ctx, msg, blockNumber)
 }
 
-func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getCode")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().CodeAt(ctx, account, blockNumber)
 }
 
 // bind.ContractBackend methods
-func (p *Pool) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+func (p *Pool) HeaderByNumber(ctx context.Context, n *big.Int) (header *types.Header, err error) {
+	ctx, span := p.startSpan(ctx, "eth_getBlockByNumber")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().HeaderByNumber(ctx, n)
 }
 
-func (p *Pool) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+func (p *Pool) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
+	ctx, span := p.startSpan(ctx, "eth_maxPriorityFeePerGas")
+	defer func() { endSpan(span, err) }()
 	return p.getRoundRobin().SuggestGasTipCap(ctx)
 }
 
@@ -2,25 +2,33 @@ package client_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/test-go/testify/mock"
 	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/tracingtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 )
 
 func TestPool_Dial(t *testing.T) {
@@ -142,7 +150,7 @@ func TestPool_Dial(t *testing.T) {
 			for i, n := range test.sendNodes {
 				sendNodes[i] = n.newSendOnlyNode(t, test.sendNodeChainID)
 			}
-			p := evmclient.NewPool(logger.TestLogger(t), nodes, sendNodes, test.poolChainID)
+			p := evmclient.NewPool(logger.TestLogger(t), nodes, sendNodes, test.poolChainID, alerts.NullNotifier{})
 			err := p.Dial(ctx)
 			if test.errStr != "" {
 				require.Error(t, err)
@@ -211,7 +219,7 @@ func (x *chainIDService) ChainId(ctx context.Context) (*hexutil.Big, error) {
 }
 
 func newPool(t *testing.T, nodes []evmclient.Node) *evmclient.Pool {
-	return evmclient.NewPool(logger.TestLogger(t), nodes, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID)
+	return evmclient.NewPool(logger.TestLogger(t), nodes, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID, alerts.NullNotifier{})
 }
 
 func TestUnit_Pool_RunLoop(t *testing.T) {
@@ -225,7 +233,7 @@ func TestUnit_Pool_RunLoop(t *testing.T) {
 		nodes := []evmclient.Node{n1, n2, n3}
 
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
-		p := evmclient.NewPool(lggr, nodes, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID)
+		p := evmclient.NewPool(lggr, nodes, []evmclient.SendOnlyNode{}, &cltest.FixtureChainID, alerts.NullNotifier{})
 
 		n1.On("String").Maybe().Return("n1")
 		n2.On("String").Maybe().Return("n2")
@@ -296,19 +304,19 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 		node := new(evmmocks.Node)
 		node.On("State").Return(evmclient.NodeStateAlive)
 		node.Test(t)
-		node.On("BatchCallContext", ctx, b).Return(nil).Once()
+		node.On("BatchCallContext", mock.Anything, b).Return(nil).Once()
 		nodes = append(nodes, node)
 		mockNodes = append(mockNodes, node)
 	}
 	for i := 0; i < sendOnlyCount; i++ {
 		s := new(evmmocks.SendOnlyNode)
 		s.Test(t)
-		s.On("BatchCallContext", ctx, b).Return(nil).Once()
+		s.On("BatchCallContext", mock.Anything, b).Return(nil).Once()
 		sendonlys = append(sendonlys, s)
 		mockSendonlys = append(mockSendonlys, s)
 	}
 
-	p := evmclient.NewPool(logger.TestLogger(t), nodes, sendonlys, &cltest.FixtureChainID)
+	p := evmclient.NewPool(logger.TestLogger(t), nodes, sendonlys, &cltest.FixtureChainID, alerts.NullNotifier{})
 
 	p.BatchCallContextAll(ctx, b)
 
@@ -319,3 +327,38 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 		s.AssertExpectations(t)
 	}
 }
+
+func TestUnit_Pool_Tracing(t *testing.T) {
+	recorder := tracingtest.NewSpanRecorder(t)
+
+	node := new(evmmocks.Node)
+	node.Test(t)
+	node.On("State").Return(evmclient.NodeStateAlive)
+	node.On("CallContext", mock.Anything, mock.Anything, "eth_chainId").Return(nil).Once()
+	node.On("BatchCallContext", mock.Anything, mock.Anything).Return(errors.New("boom")).Once()
+	node.On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	sendonly := new(evmmocks.SendOnlyNode)
+	sendonly.Test(t)
+	sendonly.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Once()
+	p := evmclient.NewPool(logger.TestLogger(t), []evmclient.Node{node}, []evmclient.SendOnlyNode{sendonly}, &cltest.FixtureChainID, alerts.NullNotifier{})
+
+	ctx := testutils.Context(t)
+	require.NoError(t, p.CallContext(ctx, nil, "eth_chainId"))
+	require.EqualError(t, p.BatchCallContextAll(ctx, []rpc.BatchElem{{Method: "eth_call"}}), "boom")
+	_, err := p.SubscribeFilterLogs(ctx, ethereum.FilterQuery{}, make(chan types.Log))
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "eth_chainId", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("rpc.method", "eth_chainId"))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "batch", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), attribute.Bool("rpc.all_nodes", true))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "eth_subscribe", spans[2].Name())
+
+	node.AssertExpectations(t)
+	sendonly.AssertExpectations(t)
+}
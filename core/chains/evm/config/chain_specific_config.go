@@ -68,6 +68,14 @@ type (
 		nonceAutoSync       bool
 		useForwarders       bool
 		rpcDefaultBatchSize uint32
+
+		topUpEnabled       bool
+		topUpThresholdWei  big.Int
+		topUpTargetWei     big.Int
+		topUpDailyLimitWei big.Int
+
+		balanceAlertThresholdWei big.Int
+		txStuckAlertBlocks       uint32
 		// set true if fully configured
 		complete bool
 
@@ -143,6 +151,12 @@ func setChainSpecificConfigDefaultSets() {
 		nodePollInterval:                      10 * time.Second,
 		nonceAutoSync:                         true,
 		useForwarders:                         false,
+		topUpEnabled:                          false,
+		topUpThresholdWei:                     *big.NewInt(0),
+		topUpTargetWei:                        *big.NewInt(0),
+		topUpDailyLimitWei:                    *big.NewInt(0),
+		balanceAlertThresholdWei:              *big.NewInt(0),
+		txStuckAlertBlocks:                    0,
 		ocrContractConfirmations:              4,
 		ocrContractTransmitterTransmitTimeout: 10 * time.Second,
 		ocrDatabaseTimeout:                    10 * time.Second,
@@ -63,6 +63,12 @@ type ChainScopedOnlyConfig interface {
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
+	EvmTopUpEnabled() bool
+	EvmTopUpThresholdWei() *big.Int
+	EvmTopUpTargetWei() *big.Int
+	EvmTopUpDailyLimitWei() *big.Int
+	EvmBalanceAlertThresholdWei() *big.Int
+	EvmTxStuckAlertBlocks() uint32
 	EvmRPCDefaultBatchSize() uint32
 	FlagsContractAddress() string
 	GasEstimatorMode() string
@@ -193,6 +199,17 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
+	if c.EvmTopUpEnabled() {
+		if !c.BalanceMonitorEnabled() {
+			err = multierr.Combine(err, errors.New("ETH_TOP_UP_ENABLED requires BALANCE_MONITOR_ENABLED"))
+		}
+		if c.EvmTopUpTargetWei().Cmp(c.EvmTopUpThresholdWei()) <= 0 {
+			err = multierr.Combine(err, errors.Errorf("ETH_TOP_UP_TARGET_WEI (%s) must be greater than ETH_TOP_UP_THRESHOLD_WEI (%s)", c.EvmTopUpTargetWei(), c.EvmTopUpThresholdWei()))
+		}
+		if c.EvmTopUpDailyLimitWei().Sign() <= 0 {
+			err = multierr.Combine(err, errors.New("ETH_TOP_UP_DAILY_LIMIT_WEI must be greater than 0 if ETH_TOP_UP_ENABLED is set"))
+		}
+	}
 	lc := ocrtypes.LocalConfig{
 		BlockchainTimeout:                      c.OCRBlockchainTimeout(),
 		ContractConfigConfirmations:            c.OCRContractConfirmations(),
@@ -884,6 +901,116 @@ func (c *chainScopedConfig) EvmUseForwarders() bool {
 	return c.defaultSet.useForwarders
 }
 
+// EvmTopUpEnabled enables automatic top-ups of sending keys from the funding key
+func (c *chainScopedConfig) EvmTopUpEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmTopUpEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmTopUpEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTopUpEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmTopUpEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.topUpEnabled
+}
+
+// EvmTopUpThresholdWei is the balance below which a sending key is topped up
+func (c *chainScopedConfig) EvmTopUpThresholdWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmTopUpThresholdWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmTopUpThresholdWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTopUpThresholdWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmTopUpThresholdWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.topUpThresholdWei
+	return &n
+}
+
+// EvmTopUpTargetWei is the balance that a sending key is topped up to
+func (c *chainScopedConfig) EvmTopUpTargetWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmTopUpTargetWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmTopUpTargetWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTopUpTargetWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmTopUpTargetWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.topUpTargetWei
+	return &n
+}
+
+// EvmTopUpDailyLimitWei is the maximum amount sent by top-ups on this chain
+// in any 24 hour window
+func (c *chainScopedConfig) EvmTopUpDailyLimitWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmTopUpDailyLimitWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmTopUpDailyLimitWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTopUpDailyLimitWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmTopUpDailyLimitWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.topUpDailyLimitWei
+	return &n
+}
+
+// EvmBalanceAlertThresholdWei is the balance below which an alert is raised
+// for a key. Zero disables the alert.
+func (c *chainScopedConfig) EvmBalanceAlertThresholdWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmBalanceAlertThresholdWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmBalanceAlertThresholdWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmBalanceAlertThresholdWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmBalanceAlertThresholdWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.balanceAlertThresholdWei
+	return &n
+}
+
+// EvmTxStuckAlertBlocks is the number of blocks after which an alert is
+// raised for a transaction that is still unconfirmed. Zero disables the
+// alert.
+func (c *chainScopedConfig) EvmTxStuckAlertBlocks() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmTxStuckAlertBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmTxStuckAlertBlocks", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmTxStuckAlertBlocks
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmTxStuckAlertBlocks", p.Int64)
+		return uint32(p.Int64)
+	}
+	return c.defaultSet.txStuckAlertBlocks
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -246,6 +246,28 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.Error(t, cfg.Validate())
 		})
 	})
+
+	t.Run("top-up", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		newCfg := func(threshold, target, limit int64) evmconfig.ChainScopedConfig {
+			return evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+				EvmTopUpEnabled:       null.BoolFrom(true),
+				EvmTopUpThresholdWei:  utils.NewBigI(threshold),
+				EvmTopUpTargetWei:     utils.NewBigI(target),
+				EvmTopUpDailyLimitWei: utils.NewBigI(limit),
+			}, nil, lggr, gcfg)
+		}
+		assert.NoError(t, newCfg(100, 500, 1000).Validate())
+
+		err := newCfg(500, 500, 1000).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_TOP_UP_TARGET_WEI (500) must be greater than ETH_TOP_UP_THRESHOLD_WEI (500)")
+
+		err = newCfg(100, 500, 0).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ETH_TOP_UP_DAILY_LIMIT_WEI must be greater than 0")
+	})
 }
 
 type fakeChainConfigORM map[string]map[string]string
@@ -89,6 +89,50 @@ func (_m *ChainScopedConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertDedupeInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertDedupeInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertWebhookTemplate provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertWebhookTemplate() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertWebhookURLs provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertWebhookURLs() []url.URL {
+	ret := _m.Called()
+
+	var r0 []url.URL
+	if rf, ok := ret.Get(0).(func() []url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]url.URL)
+		}
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *ChainScopedConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -175,6 +219,76 @@ func (_m *ChainScopedConfig) AutoPprofCPUProfileRate() int {
 	return r0
 }
 
+// AutoPprofContinuousBlockProfileRate provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofContinuousBlockProfileRate() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofContinuousEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofContinuousInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousMutexProfileFraction provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofContinuousMutexProfileFraction() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousRetention provides a mock function with given fields:
+func (_m *ChainScopedConfig) AutoPprofContinuousRetention() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // AutoPprofEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) AutoPprofEnabled() bool {
 	ret := _m.Called()
@@ -543,6 +657,20 @@ func (_m *ChainScopedConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -585,6 +713,34 @@ func (_m *ChainScopedConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupRetentionAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupRetentionAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupRetentionCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupRetentionCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -873,6 +1029,22 @@ func (_m *ChainScopedConfig) EthereumURL() string {
 	return r0
 }
 
+// EvmBalanceAlertThresholdWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmBalanceAlertThresholdWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -1223,6 +1395,82 @@ func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmTopUpDailyLimitWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTopUpDailyLimitWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmTopUpEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTopUpEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmTopUpTargetWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTopUpTargetWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmTopUpThresholdWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTopUpThresholdWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// EvmTxStuckAlertBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmTxStuckAlertBlocks() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmUseForwarders provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmUseForwarders() bool {
 	ret := _m.Called()
@@ -1393,6 +1641,20 @@ func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeedsManagerHealthReportInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeedsManagerHealthReportInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1680,6 +1942,29 @@ func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, b
 	return r0, r1
 }
 
+// GlobalEvmBalanceAlertThresholdWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmBalanceAlertThresholdWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	ret := _m.Called()
@@ -2198,6 +2483,117 @@ func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmTopUpDailyLimitWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTopUpDailyLimitWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTopUpEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpTargetWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTopUpTargetWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpThresholdWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTopUpThresholdWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTxStuckAlertBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmTxStuckAlertBlocks() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmUseForwarders provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmUseForwarders() (bool, bool) {
 	ret := _m.Called()
@@ -2494,6 +2890,34 @@ func (_m *ChainScopedConfig) GlobalOCRObservationGracePeriod() (time.Duration, b
 	return r0, r1
 }
 
+// HTTPCircuitBreakerThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) HTTPCircuitBreakerThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// HTTPCircuitBreakerTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) HTTPCircuitBreakerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // HTTPServerWriteTimeout provides a mock function with given fields:
 func (_m *ChainScopedConfig) HTTPServerWriteTimeout() time.Duration {
 	ret := _m.Called()
@@ -2508,6 +2932,48 @@ func (_m *ChainScopedConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HealthBridgeTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) HealthBridgeTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// HealthHeadAgeThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) HealthHeadAgeThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// HealthTxBacklogThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) HealthTxBacklogThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *ChainScopedConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -4066,6 +4532,34 @@ func (_m *ChainScopedConfig) SolanaNodes() string {
 	return r0
 }
 
+// StandbyEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) StandbyEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// StandbyPromotionTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) StandbyPromotionTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TerraNodes provides a mock function with given fields:
 func (_m *ChainScopedConfig) TerraNodes() string {
 	ret := _m.Called()
@@ -4306,6 +4800,48 @@ func (_m *ChainScopedConfig) TerraEnabled() bool {
 	return r0
 }
 
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingSamplingRatio provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingSamplingRatio() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
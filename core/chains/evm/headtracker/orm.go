@@ -27,6 +27,8 @@ type ORM interface {
 	LatestHeads(ctx context.Context, limit uint) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// HeadByNumber fetches the most recently seen head with the given number from the db, returns nil if none exists
+	HeadByNumber(ctx context.Context, number int64) (head *evmtypes.Head, err error)
 }
 
 type orm struct {
@@ -91,3 +93,13 @@ func (orm *orm) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtype
 	}
 	return head, err
 }
+
+func (orm *orm) HeadByNumber(ctx context.Context, number int64) (head *evmtypes.Head, err error) {
+	q := orm.q.WithOpts(pg.WithParentCtx(ctx))
+	head = new(evmtypes.Head)
+	err = q.Get(head, `SELECT * FROM evm_heads WHERE evm_chain_id = $1 AND number = $2 ORDER BY created_at DESC, id DESC LIMIT 1`, orm.chainID, number)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return head, err
+}
@@ -104,6 +104,28 @@ func TestORM_HeadByHash_NotFound(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestORM_HeadByNumber(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := headtracker.NewORM(db, logger, cfg, cltest.FixtureChainID)
+
+	for idx := 0; idx < 10; idx++ {
+		require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), cltest.Head(idx)))
+	}
+
+	head, err := orm.HeadByNumber(testutils.Context(t), 5)
+	require.NoError(t, err)
+	require.NotNil(t, head)
+	require.Equal(t, int64(5), head.Number)
+
+	head, err = orm.HeadByNumber(testutils.Context(t), 123)
+	require.NoError(t, err)
+	require.Nil(t, head)
+}
+
 func TestORM_LatestHeads_NoRows(t *testing.T) {
 	t.Parallel()
 
@@ -54,6 +54,14 @@ type (
 		// previously by any subscribers.
 		ReplayFromBlock(number int64, forceBroadcast bool)
 
+		// ReplayTargeted enqueues a replay restricted to the jobs, contract addresses and block
+		// range of the request, and returns its initial progress.
+		ReplayTargeted(req ReplayRequest) (ReplayProgress, error)
+		// ReplayProgress returns the progress of the targeted replay with the given ID.
+		ReplayProgress(id string) (ReplayProgress, error)
+		// AwaitReplay blocks until the targeted replay with the given ID is done or ctx is cancelled.
+		AwaitReplay(ctx context.Context, id string) (ReplayProgress, error)
+
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
 
@@ -114,6 +122,9 @@ type (
 		wgDone                sync.WaitGroup
 		trackedAddressesCount atomic.Uint32
 		replayChannel         chan replayRequest
+		targetedReplays       chan *targetedReplay
+		replayDeliveries      chan *replayDelivery
+		replays               *replayTracker
 		highestSavedHead      *evmtypes.Head
 		lastSeenHeadNumber    atomic.Int64
 		logger                logger.Logger
@@ -182,6 +193,9 @@ func NewBroadcaster(orm ORM, ethClient evmclient.Client, config Config, lggr log
 		chStop:                 chStop,
 		highestSavedHead:       highestSavedHead,
 		replayChannel:          make(chan replayRequest, 1),
+		targetedReplays:        make(chan *targetedReplay, maxPendingReplays),
+		replayDeliveries:       make(chan *replayDelivery),
+		replays:                newReplayTracker(),
 	}
 }
 
@@ -444,6 +458,12 @@ func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error)
 			b.onReplayRequest(req)
 			return true, nil
 
+		case r := <-b.targetedReplays:
+			b.onTargetedReplayRequest(r)
+
+		case d := <-b.replayDeliveries:
+			d.done <- b.onReplayDelivery(d)
+
 		case <-debounceResubscribe.C:
 			if needsResubscribe {
 				b.logger.Debug("Returning from the event loop to resubscribe")
@@ -736,6 +756,21 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 // ReplayFromBlock implements the Broadcaster interface.
 func (n *NullBroadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {}
 
+// ReplayTargeted implements the Broadcaster interface.
+func (n *NullBroadcaster) ReplayTargeted(req ReplayRequest) (ReplayProgress, error) {
+	return ReplayProgress{}, errors.New(n.ErrMsg)
+}
+
+// ReplayProgress implements the Broadcaster interface.
+func (n *NullBroadcaster) ReplayProgress(id string) (ReplayProgress, error) {
+	return ReplayProgress{}, errors.New(n.ErrMsg)
+}
+
+// AwaitReplay implements the Broadcaster interface.
+func (n *NullBroadcaster) AwaitReplay(ctx context.Context, id string) (ReplayProgress, error) {
+	return ReplayProgress{}, errors.New(n.ErrMsg)
+}
+
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -261,6 +262,85 @@ func TestBroadcaster_ReplaysLogs(t *testing.T) {
 	require.Eventually(t, func() bool { return helper.mockEth.UnsubscribeCallCount() >= 1 }, cltest.WaitTimeout(t), time.Second)
 }
 
+func TestBroadcaster_ReplayTargeted(t *testing.T) {
+	const (
+		blockHeight = 10
+	)
+
+	blocks := cltest.NewBlocks(t, blockHeight+3)
+	contract, err := flux_aggregator_wrapper.NewFluxAggregator(testutils.NewAddress(), nil)
+	require.NoError(t, err)
+	sentLogs := []types.Log{
+		blocks.LogOnBlockNum(3, contract.Address()),
+		blocks.LogOnBlockNum(7, contract.Address()),
+	}
+
+	mockEth := newMockEthClient(t, make(chan evmtest.RawSub[types.Log], 4), blockHeight, mockEthClientExpectedCalls{
+		FilterLogs:       5,
+		FilterLogsResult: sentLogs,
+	})
+	helper := newBroadcasterHelperWithEthClient(t, mockEth.EthClient, cltest.Head(blockHeight))
+	helper.mockEth = mockEth
+
+	listener := helper.newLogListenerWithJob("listener")
+	helper.register(listener, contract, 2)
+	other := helper.newLogListenerWithJob("other")
+	helper.register(other, contract, 2)
+
+	replay := func(req log.ReplayRequest) log.ReplayProgress {
+		progress, err := helper.lb.ReplayTargeted(req)
+		require.NoError(t, err)
+		progress, err = helper.lb.AwaitReplay(testutils.Context(t), progress.ID)
+		require.NoError(t, err)
+		require.True(t, progress.Done)
+		require.Empty(t, progress.Error)
+		return progress
+	}
+
+	func() {
+		helper.start()
+		defer helper.stop()
+
+		_, err := helper.lb.ReplayTargeted(log.ReplayRequest{FromBlock: 5, ToBlock: clnull.Int64From(2)})
+		require.Error(t, err)
+
+		// A dry run lists the logs without delivering them
+		progress := replay(log.ReplayRequest{FromBlock: 2, JobIDs: []int32{listener.JobID()}, DryRun: true})
+		assert.Equal(t, int64(blockHeight), progress.CurrentBlock)
+		assert.Equal(t, 2, progress.LogsDelivered)
+		require.Len(t, progress.Logs, 2)
+		assert.Equal(t, listener.JobID(), progress.Logs[0].JobID)
+		assert.Equal(t, contract.Address(), progress.Logs[0].Address)
+		assert.Len(t, listener.getUniqueLogs(), 0)
+
+		// Only the targeted job receives the logs
+		progress = replay(log.ReplayRequest{FromBlock: 2, JobIDs: []int32{listener.JobID()}})
+		assert.Equal(t, 2, progress.LogsDelivered)
+		assert.Empty(t, progress.Logs)
+		assert.Len(t, listener.getUniqueLogs(), 2)
+		assert.Len(t, other.getUniqueLogs(), 0)
+
+		// The logs were consumed, so they are not delivered again
+		progress = replay(log.ReplayRequest{FromBlock: 2, Addresses: []common.Address{contract.Address()}, JobIDs: []int32{listener.JobID()}})
+		assert.Equal(t, 2, progress.LogsFound)
+		assert.Equal(t, 0, progress.LogsDelivered)
+
+		// Unless the replay is forced
+		progress = replay(log.ReplayRequest{FromBlock: 2, JobIDs: []int32{listener.JobID()}, ForceBroadcast: true})
+		assert.Equal(t, 2, progress.LogsDelivered)
+		assert.Len(t, listener.getUniqueLogs(), 4)
+
+		// No subscriber matches, so no logs are fetched
+		progress = replay(log.ReplayRequest{FromBlock: 2, Addresses: []common.Address{testutils.NewAddress()}})
+		assert.Equal(t, 0, progress.LogsFound)
+
+		_, err = helper.lb.ReplayProgress("unknown")
+		require.ErrorIs(t, err, log.ErrReplayNotFound)
+	}()
+
+	require.Eventually(t, func() bool { return helper.mockEth.UnsubscribeCallCount() >= 1 }, cltest.WaitTimeout(t), time.Second)
+}
+
 func TestBroadcaster_BackfillUnconsumedAfterCrash(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
@@ -1611,7 +1691,8 @@ func TestBroadcaster_ProcessesLogsFromReorgsAndMissedHead(t *testing.T) {
 	for _, event := range events {
 		switch x := event.(type) {
 		case *evmtypes.Head:
-			ctx, _ := context.WithTimeout(context.Background(), cltest.WaitTimeout(t))
+			ctx, cancel := context.WithTimeout(context.Background(), cltest.WaitTimeout(t))
+			t.Cleanup(cancel)
 			(helper.lb).(httypes.HeadTrackable).OnNewLongestChain(ctx, x)
 		case types.Log:
 			chRawLogs.TrySend(x)
@@ -41,6 +41,27 @@ func (_m *Broadcaster) AwaitDependents() <-chan struct{} {
 	return r0
 }
 
+// AwaitReplay provides a mock function with given fields: ctx, id
+func (_m *Broadcaster) AwaitReplay(ctx context.Context, id string) (log.ReplayProgress, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 log.ReplayProgress
+	if rf, ok := ret.Get(0).(func(context.Context, string) log.ReplayProgress); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(log.ReplayProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *Broadcaster) Close() error {
 	ret := _m.Called()
@@ -170,6 +191,48 @@ func (_m *Broadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
 	_m.Called(number, forceBroadcast)
 }
 
+// ReplayProgress provides a mock function with given fields: id
+func (_m *Broadcaster) ReplayProgress(id string) (log.ReplayProgress, error) {
+	ret := _m.Called(id)
+
+	var r0 log.ReplayProgress
+	if rf, ok := ret.Get(0).(func(string) log.ReplayProgress); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(log.ReplayProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplayTargeted provides a mock function with given fields: req
+func (_m *Broadcaster) ReplayTargeted(req log.ReplayRequest) (log.ReplayProgress, error) {
+	ret := _m.Called(req)
+
+	var r0 log.ReplayProgress
+	if rf, ok := ret.Get(0).(func(log.ReplayRequest) log.ReplayProgress); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(log.ReplayProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(log.ReplayRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Broadcaster) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -38,6 +38,8 @@ type ORM interface {
 	// MarkBroadcastsUnconsumed marks all log broadcasts from all jobs on or after fromBlock as
 	// unconsumed.
 	MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
+	// MarkBroadcastUnconsumed marks the log broadcast as unconsumed by jobID.
+	MarkBroadcastUnconsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...pg.QOpt) error
 
 	// SetPendingMinBlock sets the minimum block number for which there are pending broadcasts in the pool, or nil if empty.
 	SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error
@@ -166,6 +168,20 @@ func (o *orm) MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
 	return errors.Wrap(err, "failed to mark broadcasts unconsumed")
 }
 
+// MarkBroadcastUnconsumed implements the ORM interface.
+func (o *orm) MarkBroadcastUnconsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.ExecQ(`
+        UPDATE log_broadcasts
+        SET consumed = false, updated_at = NOW()
+        WHERE block_hash = $1
+		AND log_index = $2
+		AND job_id = $3
+		AND evm_chain_id = $4
+        `, blockHash, logIndex, jobID, o.evmChainID)
+	return errors.Wrap(err, "failed to mark broadcast unconsumed")
+}
+
 func (o *orm) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
 	// Minimum block number from the set of unconsumed logs, which we'll remove later.
 	minUnconsumed, err := o.getUnconsumedMinBlock(qopts...)
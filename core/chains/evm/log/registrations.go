@@ -212,6 +212,39 @@ func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 	return addresses, topics
 }
 
+// subscribersMatching returns the registered subscribers for any of the given
+// jobs and contract addresses. Empty jobIDs or addresses match everything.
+func (r *registrations) subscribersMatching(jobIDs []int32, addresses []common.Address) (subs []*subscriber) {
+	for sub := range r.registeredSubs {
+		if len(jobIDs) > 0 && !containsJobID(jobIDs, sub.listener.JobID()) {
+			continue
+		}
+		if len(addresses) > 0 && !containsAddress(addresses, sub.opts.Contract) {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	return
+}
+
+func containsJobID(jobIDs []int32, jobID int32) bool {
+	for _, id := range jobIDs {
+		if id == jobID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *registrations) isAddressRegistered(address common.Address) bool {
 	for _, sub := range r.handlersByConfs {
 		if sub.isAddressRegistered(address) {
@@ -0,0 +1,513 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// maxTrackedReplays is the number of targeted replays for which the progress
+// is kept in memory.
+const maxTrackedReplays = 100
+
+// maxPendingReplays is the number of targeted replays which can be queued
+// before they are started.
+const maxPendingReplays = 10
+
+// ErrReplayNotFound is returned when no targeted replay exists for an ID.
+var ErrReplayNotFound = errors.New("replay not found")
+
+type (
+	// ReplayRequest describes a replay restricted to a set of jobs, contract
+	// addresses and a block range.
+	ReplayRequest struct {
+		// FromBlock is the first block to replay.
+		FromBlock int64
+		// ToBlock is the last block to replay. The latest block is used when
+		// it is not valid.
+		ToBlock null.Int64
+		// JobIDs restricts the replay to the listeners of these jobs. Every
+		// job is replayed when empty.
+		JobIDs []int32
+		// Addresses restricts the replay to these contract addresses. Every
+		// address is replayed when empty.
+		Addresses []common.Address
+		// ForceBroadcast re-delivers logs which were already consumed.
+		ForceBroadcast bool
+		// DryRun lists the logs which would be re-delivered without
+		// delivering them.
+		DryRun bool
+		// LogPoller, when set, also replays the block range into the log
+		// poller for consumers which read their logs from it.
+		LogPoller RangeReplayer
+	}
+
+	// RangeReplayer fetches the logs of a block range again and saves the
+	// missing ones. It returns the number of logs found and the logs which
+	// were, or for a dry run would be, saved. It is implemented by the log
+	// poller.
+	RangeReplayer interface {
+		ReplayRange(ctx context.Context, fromBlock, toBlock int64, addresses []common.Address, dryRun bool) (found int, replayed []types.Log, err error)
+	}
+
+	// ReplayedLog is a log which was, or for a dry run would be, re-delivered
+	// by a targeted replay.
+	ReplayedLog struct {
+		// JobID is the job the log was delivered to. It is zero for logs
+		// replayed into the log poller.
+		JobID       int32
+		LogPoller   bool
+		Address     common.Address
+		Topic       common.Hash
+		BlockNumber uint64
+		BlockHash   common.Hash
+		TxHash      common.Hash
+		LogIndex    uint
+	}
+
+	// ReplayProgress reports the progress of a targeted replay.
+	ReplayProgress struct {
+		ID             string
+		FromBlock      int64
+		ToBlock        int64
+		CurrentBlock   int64
+		JobIDs         []int32
+		Addresses      []common.Address
+		ForceBroadcast bool
+		DryRun         bool
+		// LogsFound is the number of (log, consumer) pairs matching the replay.
+		LogsFound int
+		// LogsDelivered is the number of logs which were, or for a dry run
+		// would be, re-delivered.
+		LogsDelivered int
+		// Logs lists the logs which would be re-delivered. It is only set
+		// for dry runs.
+		Logs       []ReplayedLog
+		Done       bool
+		Error      string
+		StartedAt  time.Time
+		FinishedAt *time.Time
+	}
+
+	targetedReplay struct {
+		req      ReplayRequest
+		mu       sync.RWMutex
+		progress ReplayProgress
+		done     chan struct{}
+	}
+
+	// replayDelivery is a batch of logs fetched by a targeted replay, which
+	// is handed to the event loop to be delivered to the subscribers.
+	replayDelivery struct {
+		r          *targetedReplay
+		logs       []types.Log
+		fromBlock  int64
+		toBlock    int64
+		latestHead evmtypes.Head
+		done       chan error
+	}
+
+	replayTracker struct {
+		mu      sync.RWMutex
+		nextID  int64
+		replays map[string]*targetedReplay
+		order   []string
+	}
+)
+
+func newReplayTracker() *replayTracker {
+	return &replayTracker{replays: make(map[string]*targetedReplay)}
+}
+
+func (t *replayTracker) add(req ReplayRequest) *targetedReplay {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	id := strconv.FormatInt(t.nextID, 10)
+	r := &targetedReplay{
+		req: req,
+		progress: ReplayProgress{
+			ID:             id,
+			FromBlock:      req.FromBlock,
+			ToBlock:        req.ToBlock.Int64,
+			CurrentBlock:   req.FromBlock,
+			JobIDs:         req.JobIDs,
+			Addresses:      req.Addresses,
+			ForceBroadcast: req.ForceBroadcast,
+			DryRun:         req.DryRun,
+			StartedAt:      time.Now(),
+		},
+		done: make(chan struct{}),
+	}
+	t.replays[id] = r
+	t.order = append(t.order, id)
+	if len(t.order) > maxTrackedReplays {
+		delete(t.replays, t.order[0])
+		t.order = t.order[1:]
+	}
+	return r
+}
+
+func (t *replayTracker) get(id string) (*targetedReplay, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	r, exists := t.replays[id]
+	if !exists {
+		return nil, ErrReplayNotFound
+	}
+	return r, nil
+}
+
+// Progress returns a copy of the current progress of the replay.
+func (r *targetedReplay) Progress() ReplayProgress {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p := r.progress
+	p.Logs = append([]ReplayedLog(nil), r.progress.Logs...)
+	return p
+}
+
+func (r *targetedReplay) update(fn func(p *ReplayProgress)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(&r.progress)
+}
+
+func (r *targetedReplay) finish(err error) {
+	r.update(func(p *ReplayProgress) {
+		now := time.Now()
+		p.Done = true
+		p.FinishedAt = &now
+		if err != nil {
+			p.Error = err.Error()
+		}
+	})
+	close(r.done)
+}
+
+// ReplayTargeted implements the Broadcaster interface.
+func (b *broadcaster) ReplayTargeted(req ReplayRequest) (ReplayProgress, error) {
+	if req.FromBlock < 0 {
+		return ReplayProgress{}, errors.Errorf("from block cannot be negative: %v", req.FromBlock)
+	}
+	if req.ToBlock.Valid && req.ToBlock.Int64 < req.FromBlock {
+		return ReplayProgress{}, errors.Errorf("to block %v is before from block %v", req.ToBlock.Int64, req.FromBlock)
+	}
+
+	r := b.replays.add(req)
+	b.logger.Infow("Targeted replay requested", "id", r.progress.ID, "fromBlock", req.FromBlock, "toBlock", req.ToBlock,
+		"jobIDs", req.JobIDs, "addresses", req.Addresses, "force", req.ForceBroadcast, "dryRun", req.DryRun)
+	select {
+	case b.targetedReplays <- r:
+		return r.Progress(), nil
+	default:
+		err := errors.New("too many targeted replays are pending")
+		r.finish(err)
+		return r.Progress(), err
+	}
+}
+
+// ReplayProgress implements the Broadcaster interface.
+func (b *broadcaster) ReplayProgress(id string) (ReplayProgress, error) {
+	r, err := b.replays.get(id)
+	if err != nil {
+		return ReplayProgress{}, err
+	}
+	return r.Progress(), nil
+}
+
+// AwaitReplay implements the Broadcaster interface.
+func (b *broadcaster) AwaitReplay(ctx context.Context, id string) (ReplayProgress, error) {
+	r, err := b.replays.get(id)
+	if err != nil {
+		return ReplayProgress{}, err
+	}
+	select {
+	case <-r.done:
+		return r.Progress(), nil
+	case <-ctx.Done():
+		return r.Progress(), ctx.Err()
+	}
+}
+
+// onTargetedReplayRequest starts a targeted replay. The logs are fetched in the
+// background and each batch is handed back to the event loop, which delivers
+// it to the subscribers registered at that time, since registrations are not
+// thread-safe and listeners expect logs from the event loop only.
+func (b *broadcaster) onTargetedReplayRequest(r *targetedReplay) {
+	subs := b.registrations.subscribersMatching(r.req.JobIDs, r.req.Addresses)
+	if !r.req.DryRun {
+		for _, sub := range subs {
+			if sub.opts.ReplayStartedCallback != nil {
+				sub.opts.ReplayStartedCallback()
+			}
+		}
+	}
+
+	// The logs are fetched for the subscribers matching when the replay
+	// starts. Subscribers which are registered later for other addresses or
+	// topics are not replayed to.
+	addresses, topics := replayFilter(subs)
+
+	b.wgDone.Add(1)
+	go func() {
+		defer b.wgDone.Done()
+		ctx, cancel := utils.ContextFromChan(b.chStop)
+		defer cancel()
+		r.finish(b.runTargetedReplay(ctx, r, addresses, topics))
+	}()
+}
+
+func replayFilter(subs []*subscriber) (addresses []common.Address, topics []common.Hash) {
+	addressSet := make(map[common.Address]struct{})
+	topicSet := make(map[common.Hash]struct{})
+	for _, sub := range subs {
+		addressSet[sub.opts.Contract] = struct{}{}
+		for topic := range sub.opts.LogsWithTopics {
+			topicSet[topic] = struct{}{}
+		}
+	}
+	for addr := range addressSet {
+		addresses = append(addresses, addr)
+	}
+	for topic := range topicSet {
+		topics = append(topics, topic)
+	}
+	return
+}
+
+func (b *broadcaster) runTargetedReplay(ctx context.Context, r *targetedReplay, addresses []common.Address, topics []common.Hash) error {
+	latestHead, err := b.ethSubscriber.ethClient.HeadByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch latest head")
+	} else if latestHead == nil {
+		return errors.New("got nil latest head")
+	}
+
+	toBlock := latestHead.Number
+	if r.req.ToBlock.Valid && r.req.ToBlock.Int64 < toBlock {
+		toBlock = r.req.ToBlock.Int64
+	}
+	r.update(func(p *ReplayProgress) { p.ToBlock = toBlock })
+
+	lggr := b.logger.With("id", r.progress.ID)
+	lggr.Infow("Starting targeted replay", "fromBlock", r.req.FromBlock, "toBlock", toBlock, "addresses", len(addresses))
+
+	if err = b.replayToSubscribers(ctx, r, addresses, topics, *latestHead, toBlock); err != nil {
+		return err
+	}
+
+	if r.req.LogPoller != nil {
+		found, logs, err := r.req.LogPoller.ReplayRange(ctx, r.req.FromBlock, toBlock, r.req.Addresses, r.req.DryRun)
+		if err != nil {
+			return errors.Wrap(err, "failed to replay logs into the log poller")
+		}
+		r.update(func(p *ReplayProgress) {
+			p.LogsFound += found
+			p.LogsDelivered += len(logs)
+			if r.req.DryRun {
+				for _, log := range logs {
+					p.Logs = append(p.Logs, newReplayedLog(log, 0, true))
+				}
+			}
+		})
+	}
+
+	progress := r.Progress()
+	lggr.Infow("Finished targeted replay", "logsFound", progress.LogsFound, "logsDelivered", progress.LogsDelivered)
+	return nil
+}
+
+func (b *broadcaster) replayToSubscribers(ctx context.Context, r *targetedReplay, addresses []common.Address, topics []common.Hash, latestHead evmtypes.Head, toBlock int64) error {
+	if len(addresses) == 0 {
+		r.update(func(p *ReplayProgress) { p.CurrentBlock = toBlock })
+		return nil
+	}
+
+	batchSize := int64(b.config.EvmLogBackfillBatchSize())
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	start := time.Now()
+	for from := r.req.FromBlock; from <= toBlock; from += batchSize {
+		to := from + batchSize - 1
+		if to > toBlock {
+			to = toBlock
+		}
+
+		batchCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		logs, err := b.ethSubscriber.fetchLogBatch(batchCtx, ethereum.FilterQuery{
+			FromBlock: big.NewInt(from),
+			ToBlock:   big.NewInt(to),
+			Addresses: addresses,
+			Topics:    [][]common.Hash{topics},
+		}, start)
+		cancel()
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch logs from block %v to %v", from, to)
+		}
+
+		if len(logs) > 0 {
+			if err = b.deliverReplayBatch(ctx, &replayDelivery{
+				r:          r,
+				logs:       logs,
+				fromBlock:  from,
+				toBlock:    to,
+				latestHead: latestHead,
+				done:       make(chan error, 1),
+			}); err != nil {
+				return err
+			}
+		}
+
+		r.update(func(p *ReplayProgress) { p.CurrentBlock = to })
+	}
+	return nil
+}
+
+// deliverReplayBatch hands the batch to the event loop and waits until it has
+// been delivered.
+func (b *broadcaster) deliverReplayBatch(ctx context.Context, d *replayDelivery) error {
+	select {
+	case b.replayDeliveries <- d:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-d.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// onReplayDelivery delivers a batch of replayed logs to the subscribers which
+// currently match the replay. It runs on the event loop.
+func (b *broadcaster) onReplayDelivery(d *replayDelivery) error {
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	subs := b.registrations.subscribersMatching(d.r.req.JobIDs, d.r.req.Addresses)
+	if len(subs) == 0 {
+		return nil
+	}
+
+	broadcasts, err := b.orm.FindBroadcasts(d.fromBlock, d.toBlock)
+	if err != nil {
+		return err
+	}
+	consumed := make(map[LogBroadcastAsKey]bool)
+	for _, lb := range broadcasts {
+		consumed[lb.AsKey()] = lb.Consumed
+	}
+
+	for _, log := range d.logs {
+		if log.Removed || len(log.Topics) == 0 {
+			continue
+		}
+		for _, sub := range subs {
+			if err = b.replayLogToSubscriber(ctx, d.r, sub, log, d.latestHead, consumed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *broadcaster) replayLogToSubscriber(ctx context.Context, r *targetedReplay, sub *subscriber, log types.Log, latestHead evmtypes.Head, consumed map[LogBroadcastAsKey]bool) error {
+	if sub.opts.Contract != log.Address {
+		return nil
+	}
+	filters, exists := sub.opts.LogsWithTopics[log.Topics[0]]
+	if !exists {
+		return nil
+	}
+	if len(filters) > 0 && len(log.Topics) > 1 && !filtersContainValues(log.Topics[1:], filters) {
+		return nil
+	}
+	if log.BlockNumber+uint64(sub.opts.MinIncomingConfirmations)-1 > uint64(latestHead.Number) {
+		// Not confirmed enough for this subscriber yet, it will be delivered as usual
+		return nil
+	}
+
+	jobID := sub.listener.JobID()
+	key := NewLogBroadcastAsKey(log, sub.listener)
+	wasConsumed, broadcastExists := consumed[key]
+
+	r.update(func(p *ReplayProgress) { p.LogsFound++ })
+	if wasConsumed && !r.req.ForceBroadcast {
+		return nil
+	}
+
+	if r.req.DryRun {
+		r.update(func(p *ReplayProgress) {
+			p.LogsDelivered++
+			p.Logs = append(p.Logs, newReplayedLog(log, jobID, false))
+		})
+		return nil
+	}
+
+	logCopy := gethwrappers.DeepCopyLog(log)
+	decodedLog, err := sub.opts.ParseLog(logCopy)
+	if err != nil {
+		b.logger.Errorw("Could not parse contract log", "err", err, "jobID", jobID)
+		return nil
+	}
+
+	if !broadcastExists {
+		if err = b.orm.CreateBroadcast(log.BlockHash, log.BlockNumber, log.Index, jobID, pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+	} else if wasConsumed {
+		if err = b.orm.MarkBroadcastUnconsumed(log.BlockHash, log.Index, jobID, pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+	}
+	consumed[key] = false
+
+	b.logger.Debugw("LogBroadcaster: Replaying log",
+		"blockNumber", log.BlockNumber, "blockHash", log.BlockHash,
+		"address", log.Address, "jobID", jobID, "replayID", r.progress.ID)
+
+	sub.listener.HandleLog(&broadcast{
+		uint64(latestHead.Number),
+		latestHead.Hash,
+		decodedLog,
+		logCopy,
+		jobID,
+		b.evmChainID,
+	})
+	r.update(func(p *ReplayProgress) { p.LogsDelivered++ })
+	return nil
+}
+
+func newReplayedLog(log types.Log, jobID int32, logPoller bool) ReplayedLog {
+	var topic common.Hash
+	if len(log.Topics) > 0 {
+		topic = log.Topics[0]
+	}
+	return ReplayedLog{
+		JobID:       jobID,
+		LogPoller:   logPoller,
+		Address:     log.Address,
+		Topic:       topic,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+}
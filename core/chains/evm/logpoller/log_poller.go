@@ -113,6 +113,99 @@ func (lp *LogPoller) Replay(ctx context.Context, fromBlock int64) error {
 	return nil
 }
 
+// ReplayRange fetches the logs matching the filter between fromBlock and toBlock
+// again and saves any which are missing, restricted to the given addresses when
+// not empty. It returns the number of logs found and the logs which were
+// saved. When dryRun is true nothing is saved, and the logs which would have
+// been saved are returned.
+func (lp *LogPoller) ReplayRange(ctx context.Context, fromBlock, toBlock int64, addresses []common.Address, dryRun bool) (found int, replayed []types.Log, err error) {
+	filterAddresses := lp.filterAddresses()
+	if len(addresses) > 0 {
+		var restricted []common.Address
+		for _, addr := range filterAddresses {
+			for _, a := range addresses {
+				if a == addr {
+					restricted = append(restricted, addr)
+					break
+				}
+			}
+		}
+		filterAddresses = restricted
+	}
+	if len(filterAddresses) == 0 {
+		return 0, nil, nil
+	}
+
+	for from := fromBlock; from <= toBlock; from += lp.backfillBatchSize {
+		to := min(from+lp.backfillBatchSize-1, toBlock)
+		logs, err := lp.ec.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: big.NewInt(from),
+			ToBlock:   big.NewInt(to),
+			Addresses: filterAddresses,
+			Topics:    lp.filterTopics(),
+		})
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "unable to query for logs from %v to %v", from, to)
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		found += len(logs)
+
+		var missing []Log
+		if dryRun {
+			missing, err = lp.missingLogs(ctx, from, to, convertLogs(lp.ec.ChainID(), logs))
+		} else {
+			missing, err = lp.orm.InsertMissingLogs(convertLogs(lp.ec.ChainID(), logs), pg.WithParentCtx(ctx))
+		}
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "unable to save logs from %v to %v", from, to)
+		}
+		replayed = append(replayed, matchingLogs(logs, missing)...)
+	}
+	lp.lggr.Infow("Replayed logs", "from", fromBlock, "to", toBlock, "found", found, "saved", len(replayed), "dryRun", dryRun)
+	return found, replayed, nil
+}
+
+// missingLogs returns the logs which are not saved in the block range yet.
+func (lp *LogPoller) missingLogs(ctx context.Context, from, to int64, logs []Log) ([]Log, error) {
+	saved, err := lp.orm.selectLogsByBlockRange(from, to, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	savedKeys := make(map[logKey]struct{}, len(saved))
+	for _, l := range saved {
+		savedKeys[logKey{l.BlockHash, l.LogIndex}] = struct{}{}
+	}
+	var missing []Log
+	for _, l := range logs {
+		if _, exists := savedKeys[logKey{l.BlockHash, l.LogIndex}]; !exists {
+			missing = append(missing, l)
+		}
+	}
+	return missing, nil
+}
+
+type logKey struct {
+	blockHash common.Hash
+	logIndex  int64
+}
+
+// matchingLogs returns the raw logs which correspond to the saved logs.
+func matchingLogs(logs []types.Log, saved []Log) []types.Log {
+	keys := make(map[logKey]struct{}, len(saved))
+	for _, l := range saved {
+		keys[logKey{l.BlockHash, l.LogIndex}] = struct{}{}
+	}
+	var matching []types.Log
+	for _, l := range logs {
+		if _, exists := keys[logKey{l.BlockHash, int64(l.Index)}]; exists {
+			matching = append(matching, l)
+		}
+	}
+	return matching
+}
+
 func (lp *LogPoller) Start(parentCtx context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
 		ctx, cancel := context.WithCancel(parentCtx)
@@ -345,3 +345,88 @@ func TestLogPoller_MergeFilter(t *testing.T) {
 	assert.Equal(t, []common.Address{a1, a2}, lp.filterAddresses())
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}, {EmitterABI.Events["Log2"].ID}}, lp.filterTopics())
 }
+
+func TestLogPoller_ReplayRange(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	chainID := testutils.NewRandomEVMChainID()
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS log_poller_blocks_evm_chain_id_fkey DEFERRED`)))
+	require.NoError(t, utils.JustError(db.Exec(`SET CONSTRAINTS logs_evm_chain_id_fkey DEFERRED`)))
+
+	orm := NewORM(chainID, db, lggr, pgtest.NewPGCfg(true))
+	owner := testutils.MustNewSimTransactor(t)
+	ec := backends.NewSimulatedBackend(map[common.Address]core.GenesisAccount{
+		owner.From: {
+			Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
+		},
+	}, 10e6)
+	t.Cleanup(func() { ec.Close() })
+	emitterAddress1, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
+	require.NoError(t, err)
+	emitterAddress2, _, emitter2, err := log_emitter.DeployLogEmitter(owner, ec)
+	require.NoError(t, err)
+	ec.Commit()
+
+	lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, 2, 3)
+	lp.MergeFilter([]common.Hash{EmitterABI.Events["Log1"].ID}, emitterAddress1)
+	lp.MergeFilter([]common.Hash{EmitterABI.Events["Log1"].ID}, emitterAddress2)
+
+	for i := 0; i < 5; i++ {
+		_, err = emitter1.EmitLog1(owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		_, err = emitter2.EmitLog1(owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		ec.Commit()
+	}
+
+	// A dry run returns the logs without saving them
+	found, logs, err := lp.ReplayRange(testutils.Context(t), 1, 6, []common.Address{emitterAddress1}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 5, found)
+	require.Len(t, logs, 5)
+	for _, l := range logs {
+		assert.Equal(t, emitterAddress1, l.Address)
+	}
+	lgs, err := orm.selectLogsByBlockRange(1, 6)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 0)
+
+	// Restricted to the block range and saved
+	found, logs, err = lp.ReplayRange(testutils.Context(t), 2, 3, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, found)
+	require.Len(t, logs, 4)
+	lgs, err = orm.selectLogsByBlockRange(1, 6)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 4)
+
+	// A dry run only returns the logs which are not saved yet
+	found, logs, err = lp.ReplayRange(testutils.Context(t), 1, 6, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, 10, found)
+	assert.Len(t, logs, 6)
+
+	// Replaying again does not duplicate logs, and only returns the logs
+	// which were saved
+	found, logs, err = lp.ReplayRange(testutils.Context(t), 1, 6, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, found)
+	assert.Len(t, logs, 6)
+	for _, l := range logs {
+		assert.NotContains(t, []uint64{2, 3}, l.BlockNumber)
+	}
+	lgs, err = orm.selectLogsByBlockRange(1, 6)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 10)
+
+	found, logs, err = lp.ReplayRange(testutils.Context(t), 1, 6, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, found)
+	assert.Len(t, logs, 0)
+
+	// Addresses outside of the filter are ignored
+	found, logs, err = lp.ReplayRange(testutils.Context(t), 1, 6, []common.Address{testutils.NewAddress()}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, found)
+	assert.Len(t, logs, 0)
+}
@@ -103,9 +103,32 @@ func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 	return err
 }
 
-func (o *ORM) selectLogsByBlockRange(start, end int64) ([]Log, error) {
+// InsertMissingLogs inserts the logs which are not saved yet and returns the
+// logs which were inserted.
+func (o *ORM) InsertMissingLogs(logs []Log, qopts ...pg.QOpt) ([]Log, error) {
+	for _, log := range logs {
+		if o.chainID.Cmp(log.EvmChainId.ToInt()) != 0 {
+			return nil, errors.Errorf("invalid chainID in log got %v want %v", log.EvmChainId.ToInt(), o.chainID)
+		}
+	}
+	q := o.q.WithOpts(qopts...)
+	query, args, err := q.BindNamed(`INSERT INTO logs 
+(evm_chain_id, log_index, block_hash, block_number, address, event_sig, topics, tx_hash, data, created_at) VALUES 
+(:evm_chain_id, :log_index, :block_hash, :block_number, :address, :event_sig, :topics, :tx_hash, :data, NOW()) ON CONFLICT DO NOTHING RETURNING *`, logs)
+	if err != nil {
+		return nil, errors.Wrap(err, "error binding arg")
+	}
+	var inserted []Log
+	if err = q.Select(&inserted, query, args...); err != nil {
+		return nil, err
+	}
+	return inserted, nil
+}
+
+func (o *ORM) selectLogsByBlockRange(start, end int64, qopts ...pg.QOpt) ([]Log, error) {
 	var logs []Log
-	err := o.q.Select(&logs, `
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
         SELECT * FROM logs 
         WHERE block_number >= $1 AND block_number <= $2 AND evm_chain_id = $3
         ORDER BY (block_number, log_index, created_at)`, start, end, utils.NewBig(o.chainID))
@@ -131,6 +154,32 @@ func (o *ORM) SelectLogsByBlockRangeFilter(start, end int64, address common.Addr
 	return logs, nil
 }
 
+// SelectLogsByBlockRangeAddressTopic finds the logs in the block range,
+// optionally filtered by the address which emitted the log and by a topic
+// which the log contains. A nil address or topic matches any log.
+func (o *ORM) SelectLogsByBlockRangeAddressTopic(start, end int64, address *common.Address, topic *common.Hash, qopts ...pg.QOpt) ([]Log, error) {
+	var addr, tpc []byte
+	if address != nil {
+		addr = address.Bytes()
+	}
+	if topic != nil {
+		tpc = topic.Bytes()
+	}
+
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
+		SELECT * FROM logs
+			WHERE logs.block_number >= $1 AND logs.block_number <= $2 AND logs.evm_chain_id = $3
+			AND ($4::BYTEA IS NULL OR address = $4)
+			AND ($5::BYTEA IS NULL OR $5 = ANY(topics))
+			ORDER BY (logs.block_number, logs.log_index)`, start, end, utils.NewBig(o.chainID), addr, tpc)
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 // LatestLogEventSigsAddrs finds the latest log by (address, event) combination that matches a list of addresses and list of events
 func (o *ORM) LatestLogEventSigsAddrs(fromBlock int64, addresses []common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	var logs []Log
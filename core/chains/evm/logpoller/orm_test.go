@@ -187,4 +187,18 @@ func TestORM(t *testing.T) {
 	lgs, err = o1.LatestLogEventSigsAddrs(0 /* startBlock */, []common.Address{common.HexToAddress("0x1234"), common.HexToAddress("0x1235")}, []common.Hash{topic, topic2})
 	require.NoError(t, err)
 	require.Equal(t, 4, len(lgs))
+
+	// Block range queries with optional address and topic filters
+	addr := common.HexToAddress("0x1234")
+	lgs, err = o1.SelectLogsByBlockRangeAddressTopic(0, 20, &addr, &topic2)
+	require.NoError(t, err)
+	for _, lg := range lgs {
+		assert.Equal(t, addr, lg.Address)
+	}
+	all, err := o1.SelectLogsByBlockRangeAddressTopic(0, 20, nil, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(all), len(lgs))
+	none, err := o1.SelectLogsByBlockRangeAddressTopic(100, 200, nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, none, 0)
 }
@@ -23,6 +23,7 @@ import (
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 )
 
 // Chain is an autogenerated mock type for the Chain type
@@ -216,6 +217,22 @@ func (_m *Chain) Ready() error {
 	return r0
 }
 
+// StandbyHead provides a mock function with given fields:
+func (_m *Chain) StandbyHead() *evmtypes.Head {
+	ret := _m.Called()
+
+	var r0 *evmtypes.Head
+	if rf, ok := ret.Get(0).(func() *evmtypes.Head); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.Head)
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
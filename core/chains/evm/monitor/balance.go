@@ -19,6 +19,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -33,31 +34,39 @@ type (
 		services.ServiceCtx
 	}
 
+	BalanceMonitorConfig interface {
+		EvmBalanceAlertThresholdWei() *big.Int
+	}
+
 	balanceMonitor struct {
 		utils.StartStopOnce
 		logger         logger.Logger
 		ethClient      evmclient.Client
 		chainID        string
 		ethKeyStore    keystore.Eth
+		config         BalanceMonitorConfig
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx *sync.RWMutex
 		sleeperTask    utils.SleeperTask
+		notifier       alerts.Notifier
 	}
 
 	NullBalanceMonitor struct{}
 )
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, config BalanceMonitorConfig, logger logger.Logger, notifier alerts.Notifier) BalanceMonitor {
 	bm := &balanceMonitor{
 		utils.StartStopOnce{},
 		logger,
 		ethClient,
 		ethClient.ChainID().String(),
 		ethKeyStore,
+		config,
 		make(map[gethCommon.Address]*assets.Eth),
 		new(sync.RWMutex),
 		nil,
+		notifier,
 	}
 	bm.sleeperTask = utils.NewSleeperTask(&worker{bm: bm})
 	return bm
@@ -115,6 +124,21 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 		"ethBalance", ethBal.String(),
 		"weiBalance", ethBal.ToInt())
 
+	if threshold := bm.config.EvmBalanceAlertThresholdWei(); threshold.Sign() > 0 && ethBal.ToInt().Cmp(threshold) < 0 {
+		bm.notifier.Notify(alerts.Alert{
+			Type:     alerts.TypeLowKeyBalance,
+			Severity: alerts.SeverityWarning,
+			Key:      bm.chainID + "/" + address.Hex(),
+			Summary:  fmt.Sprintf("ETH balance of key %s on chain %s is %s, below ETH_BALANCE_ALERT_THRESHOLD_WEI", address.Hex(), bm.chainID, ethBal.String()),
+			Details: map[string]string{
+				"evmChainID":   bm.chainID,
+				"address":      address.Hex(),
+				"balanceWei":   ethBal.ToInt().String(),
+				"thresholdWei": threshold.String(),
+			},
+		})
+	}
+
 	if oldBal == nil {
 		lgr.Infof("ETH balance for %s: %s", address.Hex(), ethBal.String())
 		return
@@ -0,0 +1,235 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// topUpWindow is the window over which ETH_TOP_UP_DAILY_LIMIT_WEI applies. The
+// limit covers the amounts sent by top-ups and the gas fees of their
+// transactions.
+const topUpWindow = 24 * time.Hour
+
+type (
+	// TopUpper sends ETH from the funding key of a chain to its sending keys
+	// when their balance drops below a threshold, on every new head
+	TopUpper interface {
+		httypes.HeadTrackable
+		services.ServiceCtx
+	}
+
+	TopUpConfig interface {
+		EvmTopUpThresholdWei() *big.Int
+		EvmTopUpTargetWei() *big.Int
+		EvmTopUpDailyLimitWei() *big.Int
+		EvmGasLimitTransfer() uint64
+		EvmEIP1559DynamicFees() bool
+	}
+
+	TopUpKeyStore interface {
+		GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
+	}
+
+	topUpper struct {
+		utils.StartStopOnce
+		logger         logger.Logger
+		chainID        *big.Int
+		config         TopUpConfig
+		ethClient      evmclient.Client
+		ethKeyStore    TopUpKeyStore
+		balanceMonitor BalanceMonitor
+		txm            txmgr.TxManager
+		orm            TopUpORM
+		q              pg.Q
+		sleeperTask    utils.SleeperTask
+		chStop         chan struct{}
+	}
+)
+
+// NewTopUpper returns a new TopUpper. Balances of sending keys are read from
+// balanceMonitor.
+func NewTopUpper(q pg.Q, ethClient evmclient.Client, config TopUpConfig, ethKeyStore TopUpKeyStore, balanceMonitor BalanceMonitor, txm txmgr.TxManager, orm TopUpORM, lggr logger.Logger) TopUpper {
+	tu := &topUpper{
+		logger:         lggr.Named("TopUpper"),
+		chainID:        ethClient.ChainID(),
+		config:         config,
+		ethClient:      ethClient,
+		ethKeyStore:    ethKeyStore,
+		balanceMonitor: balanceMonitor,
+		txm:            txm,
+		orm:            orm,
+		q:              q,
+		chStop:         make(chan struct{}),
+	}
+	tu.sleeperTask = utils.NewSleeperTask(tu)
+	return tu
+}
+
+func (tu *topUpper) Start(context.Context) error {
+	return tu.StartOnce("TopUpper", func() error {
+		tu.logger.Infow("Sending keys will be topped up from the funding key",
+			"thresholdWei", tu.config.EvmTopUpThresholdWei(),
+			"targetWei", tu.config.EvmTopUpTargetWei(),
+			"dailyLimitWei", tu.config.EvmTopUpDailyLimitWei(),
+		)
+		return nil
+	})
+}
+
+func (tu *topUpper) Close() error {
+	return tu.StopOnce("TopUpper", func() error {
+		close(tu.chStop)
+		return tu.sleeperTask.Stop()
+	})
+}
+
+func (tu *topUpper) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+	tu.IfStarted(tu.sleeperTask.WakeUp)
+}
+
+func (*topUpper) Name() string {
+	return "TopUpperWorker"
+}
+
+// Work implements utils.Worker
+func (tu *topUpper) Work() {
+	ctx, cancel := utils.ContextFromChan(tu.chStop)
+	defer cancel()
+	tu.topUpKeys(ctx)
+}
+
+func (tu *topUpper) topUpKeys(ctx context.Context) {
+	states, err := tu.ethKeyStore.GetStatesForChain(tu.chainID)
+	if err != nil {
+		tu.logger.Errorw("Failed to get keys", "err", err)
+		return
+	}
+	var funding *ethkey.State
+	for i := range states {
+		if states[i].IsFunding {
+			funding = &states[i]
+			break
+		}
+	}
+	if funding == nil {
+		tu.logger.Warnw("Cannot top up sending keys, this chain has no funding key")
+		return
+	}
+	if funding.Disabled {
+		tu.logger.Warnw("Cannot top up sending keys, the funding key is disabled", "address", funding.Address)
+		return
+	}
+
+	threshold := tu.config.EvmTopUpThresholdWei()
+	for _, s := range states {
+		if s.IsFunding || s.Disabled {
+			continue
+		}
+		address := s.Address.Address()
+		balance := tu.balanceMonitor.GetEthBalance(address)
+		if balance == nil || balance.ToInt().Cmp(threshold) >= 0 {
+			continue
+		}
+		if err = tu.topUp(ctx, funding.Address.Address(), address, *balance); err != nil {
+			tu.logger.Errorw("Failed to top up sending key", "address", address, "balance", balance, "err", err)
+		}
+	}
+}
+
+func (tu *topUpper) topUp(ctx context.Context, from, to gethCommon.Address, balance assets.Eth) error {
+	pending, err := tu.orm.HasPendingTopUp(to, pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if pending {
+		tu.logger.Debugw("Sending key has a pending top-up", "address", to)
+		return nil
+	}
+	// The cached balance may predate the confirmation of the previous top-up
+	latest, err := tu.ethClient.BalanceAt(ctx, to, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get balance of sending key")
+	}
+	balance = assets.Eth(*latest)
+	if latest.Cmp(tu.config.EvmTopUpThresholdWei()) >= 0 {
+		return nil
+	}
+
+	amount := new(big.Int).Sub(tu.config.EvmTopUpTargetWei(), balance.ToInt())
+	gasPrice, err := tu.gasPrice()
+	if err != nil {
+		return errors.Wrap(err, "failed to estimate gas price")
+	}
+	spent, err := tu.orm.SpentSince(time.Now().Add(-topUpWindow), gasPrice, pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tu.config.EvmGasLimitTransfer()), gasPrice)
+	remaining := new(big.Int).Sub(tu.config.EvmTopUpDailyLimitWei(), spent)
+	remaining.Sub(remaining, fee)
+	if remaining.Sign() <= 0 {
+		tu.logger.Warnw("Cannot top up sending key, ETH_TOP_UP_DAILY_LIMIT_WEI has been reached", "address", to, "balance", balance, "spentWei", spent)
+		return nil
+	}
+	if amount.Cmp(remaining) > 0 {
+		tu.logger.Warnw("Partially topping up sending key, ETH_TOP_UP_DAILY_LIMIT_WEI would be exceeded", "address", to, "balance", balance, "spentWei", spent)
+		amount = remaining
+	}
+
+	fundingBalance, err := tu.ethClient.BalanceAt(ctx, from, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get balance of funding key")
+	}
+	if fundingBalance.Cmp(new(big.Int).Add(amount, fee)) < 0 {
+		return errors.Errorf("funding key %s has insufficient balance of %s wei to send %s wei with a gas fee of up to %s wei", from.Hex(), fundingBalance, amount, fee)
+	}
+
+	topUp := TopUp{
+		FromAddress: from,
+		ToAddress:   to,
+		Amount:      assets.Eth(*amount),
+		Balance:     balance,
+	}
+	err = tu.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
+		etx, err := tu.txm.SendEther(tu.chainID, from, to, topUp.Amount, tu.config.EvmGasLimitTransfer(), pg.WithQueryer(tx))
+		if err != nil {
+			return err
+		}
+		topUp.EthTxID = null.IntFrom(etx.ID)
+		return tu.orm.InsertTopUp(&topUp, pg.WithQueryer(tx))
+	})
+	if err != nil {
+		return err
+	}
+	tu.logger.Infow("Topped up sending key from funding key", "from", from, "to", to, "amount", topUp.Amount.String(), "balance", balance.String(), "ethTxID", topUp.EthTxID.Int64)
+	return nil
+}
+
+// gasPrice returns the price per gas that a top-up is expected to pay: the
+// estimated gas price, or fee cap on chains with EIP-1559 dynamic fees.
+func (tu *topUpper) gasPrice() (*big.Int, error) {
+	estimator := tu.txm.GetGasEstimator()
+	gasLimit := tu.config.EvmGasLimitTransfer()
+	if tu.config.EvmEIP1559DynamicFees() {
+		fee, _, err := estimator.GetDynamicFee(gasLimit)
+		return fee.FeeCap, err
+	}
+	price, _, err := estimator.GetLegacyGas(nil, gasLimit)
+	return price, err
+}
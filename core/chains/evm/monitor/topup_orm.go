@@ -0,0 +1,171 @@
+package monitor
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// TopUp is an audit record of a transfer from the funding key to a sending key
+type TopUp struct {
+	ID          int64
+	EVMChainID  utils.Big
+	FromAddress common.Address
+	ToAddress   common.Address
+	Amount      assets.Eth
+	// Balance is the balance of the sending key when it was topped up
+	Balance   assets.Eth
+	EthTxID   null.Int
+	CreatedAt time.Time
+}
+
+type TopUpORM interface {
+	// InsertTopUp records a top-up
+	InsertTopUp(topUp *TopUp, qopts ...pg.QOpt) error
+	// HasPendingTopUp returns true if a top-up of address has not been
+	// confirmed or failed yet
+	HasPendingTopUp(address common.Address, qopts ...pg.QOpt) (bool, error)
+	// SpentSince returns the amount sent by top-ups since the given time,
+	// plus the gas fees of their transactions, excluding those whose
+	// transactions failed. Transactions which have no attempt yet are
+	// expected to pay gasPriceWei.
+	SpentSince(since time.Time, gasPriceWei *big.Int, qopts ...pg.QOpt) (*big.Int, error)
+	// TopUps returns the most recent top-ups, newest first
+	TopUps(limit int, qopts ...pg.QOpt) ([]TopUp, error)
+}
+
+type topUpORM struct {
+	q       pg.Q
+	chainID utils.Big
+}
+
+var _ TopUpORM = &topUpORM{}
+
+// NewTopUpORM returns a TopUpORM for the given chain
+func NewTopUpORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, chainID big.Int) TopUpORM {
+	return &topUpORM{pg.NewQ(db, lggr, cfg), utils.Big(chainID)}
+}
+
+func (o *topUpORM) InsertTopUp(topUp *TopUp, qopts ...pg.QOpt) error {
+	topUp.EVMChainID = o.chainID
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO eth_key_top_ups (evm_chain_id, from_address, to_address, amount, balance, eth_tx_id, created_at)
+VALUES (:evm_chain_id, :from_address, :to_address, :amount, :balance, :eth_tx_id, NOW()) RETURNING *`
+	return errors.Wrap(q.GetNamed(sql, topUp, topUp), "InsertTopUp failed")
+}
+
+func (o *topUpORM) HasPendingTopUp(address common.Address, qopts ...pg.QOpt) (exists bool, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT EXISTS (
+	SELECT 1 FROM eth_key_top_ups
+	JOIN eth_txes ON eth_txes.id = eth_key_top_ups.eth_tx_id
+	WHERE eth_key_top_ups.evm_chain_id = $1 AND eth_key_top_ups.to_address = $2
+	AND eth_txes.state IN ('unstarted', 'in_progress', 'unconfirmed')
+)`
+	err = q.Get(&exists, sql, o.chainID, address)
+	return exists, errors.Wrap(err, "HasPendingTopUp failed")
+}
+
+func (o *topUpORM) SpentSince(since time.Time, gasPriceWei *big.Int, qopts ...pg.QOpt) (*big.Int, error) {
+	q := o.q.WithOpts(qopts...)
+	// Top-ups whose transactions have been reaped were confirmed, long enough
+	// ago that their fees are not counted
+	var topUps []struct {
+		Amount   assets.Eth
+		EthTxID  null.Int `db:"eth_tx_id"`
+		GasLimit null.Int `db:"gas_limit"`
+	}
+	sql := `SELECT eth_key_top_ups.amount, eth_txes.id AS eth_tx_id, eth_txes.gas_limit FROM eth_key_top_ups
+	LEFT JOIN eth_txes ON eth_txes.id = eth_key_top_ups.eth_tx_id
+	WHERE eth_key_top_ups.evm_chain_id = $1 AND eth_key_top_ups.created_at > $2
+	AND (eth_txes.state IS NULL OR eth_txes.state <> 'fatal_error')`
+	if err := q.Select(&topUps, sql, o.chainID, since); err != nil {
+		return nil, errors.Wrap(err, "SpentSince failed")
+	}
+	var ethTxIDs []int64
+	for _, t := range topUps {
+		if t.EthTxID.Valid {
+			ethTxIDs = append(ethTxIDs, t.EthTxID.Int64)
+		}
+	}
+	attempts, err := o.attemptFees(q, ethTxIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	spent := new(big.Int)
+	for _, t := range topUps {
+		spent.Add(spent, t.Amount.ToInt())
+		if t.EthTxID.Valid {
+			spent.Add(spent, txFee(attempts[t.EthTxID.Int64], uint64(t.GasLimit.Int64), gasPriceWei))
+		}
+	}
+	return spent, nil
+}
+
+type attemptFee struct {
+	EthTxID int64 `db:"eth_tx_id"`
+	// GasPrice is the gas price of a legacy attempt, or the fee cap of a
+	// dynamic fee attempt
+	GasPrice utils.Big `db:"gas_price"`
+	// GasUsed is the gasUsed of the receipt of the attempt, if it was mined
+	GasUsed null.String `db:"gas_used"`
+}
+
+// attemptFees returns the attempts of the given transactions, by transaction
+func (o *topUpORM) attemptFees(q pg.Q, ethTxIDs []int64) (map[int64][]attemptFee, error) {
+	byTx := make(map[int64][]attemptFee)
+	if len(ethTxIDs) == 0 {
+		return byTx, nil
+	}
+	var attempts []attemptFee
+	sql := `SELECT eth_tx_attempts.eth_tx_id, COALESCE(eth_tx_attempts.gas_fee_cap, eth_tx_attempts.gas_price) AS gas_price,
+	eth_receipts.receipt->>'gasUsed' AS gas_used
+	FROM eth_tx_attempts
+	LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_tx_attempts.eth_tx_id = ANY($1)`
+	if err := q.Select(&attempts, sql, pq.Array(ethTxIDs)); err != nil {
+		return nil, errors.Wrap(err, "failed to load attempts of top-ups")
+	}
+	for _, a := range attempts {
+		byTx[a.EthTxID] = append(byTx[a.EthTxID], a)
+	}
+	return byTx, nil
+}
+
+// txFee returns the fee of a transaction with the given attempts. Once an
+// attempt was mined, it is its gas used times its gas price. Until then, the
+// transaction may use up to gasLimit at the highest price of its attempts, or
+// at gasPriceWei if it has no attempt yet.
+func txFee(attempts []attemptFee, gasLimit uint64, gasPriceWei *big.Int) *big.Int {
+	price := gasPriceWei
+	for i, a := range attempts {
+		if a.GasUsed.Valid {
+			if used, err := hexutil.DecodeUint64(a.GasUsed.String); err == nil {
+				return new(big.Int).Mul(new(big.Int).SetUint64(used), a.GasPrice.ToInt())
+			}
+		}
+		if i == 0 || a.GasPrice.ToInt().Cmp(price) > 0 {
+			price = a.GasPrice.ToInt()
+		}
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), price)
+}
+
+func (o *topUpORM) TopUps(limit int, qopts ...pg.QOpt) (topUps []TopUp, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT * FROM eth_key_top_ups WHERE evm_chain_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2`
+	err = q.Select(&topUps, sql, o.chainID, limit)
+	return topUps, errors.Wrap(err, "TopUps failed")
+}
@@ -0,0 +1,304 @@
+package monitor_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type topUpConfig struct {
+	threshold, target, dailyLimit int64
+}
+
+func (c topUpConfig) EvmTopUpThresholdWei() *big.Int  { return big.NewInt(c.threshold) }
+func (c topUpConfig) EvmTopUpTargetWei() *big.Int     { return big.NewInt(c.target) }
+func (c topUpConfig) EvmTopUpDailyLimitWei() *big.Int { return big.NewInt(c.dailyLimit) }
+func (topUpConfig) EvmGasLimitTransfer() uint64       { return 21000 }
+func (topUpConfig) EvmEIP1559DynamicFees() bool       { return false }
+
+// newTxManagerMock returns a TxManager whose gas estimator estimates a gas
+// price of 1 wei.
+func newTxManagerMock(t *testing.T) *txmmocks.TxManager {
+	estimator := new(gasmocks.Estimator)
+	estimator.Test(t)
+	estimator.On("GetLegacyGas", []byte(nil), uint64(21000)).Return(big.NewInt(1), uint64(21000), nil)
+	txm := new(txmmocks.TxManager)
+	txm.Test(t)
+	txm.On("GetGasEstimator").Return(estimator)
+	return txm
+}
+
+func TestTopUpORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	borm := cltest.NewTxmORM(t, db, cfg)
+	orm := monitor.NewTopUpORM(db, logger.TestLogger(t), cfg, cltest.FixtureChainID)
+
+	_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	insert := func(to common.Address, amount int64, etx txmgr.EthTx) {
+		require.NoError(t, orm.InsertTopUp(&monitor.TopUp{
+			FromAddress: fundingAddr,
+			ToAddress:   to,
+			Amount:      assets.NewEthValue(amount),
+			Balance:     assets.NewEthValue(1),
+			EthTxID:     null.IntFrom(etx.ID),
+		}))
+	}
+
+	spent, err := orm.SpentSince(time.Now().Add(-time.Hour), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), spent.Int64())
+
+	insert(k0Addr, 100, cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fundingAddr))
+	insert(k1Addr, 200, cltest.MustInsertFatalErrorEthTx(t, borm, fundingAddr))
+
+	pending, err := orm.HasPendingTopUp(k0Addr)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	pending, err = orm.HasPendingTopUp(k1Addr)
+	require.NoError(t, err)
+	assert.False(t, pending)
+
+	// The unconfirmed transaction has no attempt yet, so it may use its whole
+	// gas limit at the given gas price
+	spent, err = orm.SpentSince(time.Now().Add(-time.Hour), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100+1e9*2), spent.Int64(), "failed top-ups should not count towards the limit")
+	spent, err = orm.SpentSince(time.Now().Add(time.Hour), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), spent.Int64())
+
+	topUps, err := orm.TopUps(10)
+	require.NoError(t, err)
+	require.Len(t, topUps, 2)
+	assert.Equal(t, k1Addr, topUps[0].ToAddress)
+	assert.Equal(t, k0Addr, topUps[1].ToAddress)
+	assert.Equal(t, fundingAddr, topUps[1].FromAddress)
+	assert.Equal(t, "100", topUps[1].Amount.ToInt().String())
+	assert.Equal(t, *utils.NewBig(&cltest.FixtureChainID), topUps[1].EVMChainID)
+
+	// A mined top-up counts the gas it used at the price of its attempt
+	_, k2Addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+	confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, fundingAddr)
+	mustInsertReceiptWithGasUsed(t, borm, confirmed.EthTxAttempts[0].Hash, 21000)
+	insert(k2Addr, 300, confirmed)
+	spent, err = orm.SpentSince(time.Now().Add(-time.Hour), big.NewInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100+1e9*2+300+21000), spent.Int64())
+}
+
+func mustInsertReceiptWithGasUsed(t *testing.T, borm txmgr.ORM, txHash common.Hash, gasUsed uint64) {
+	r := cltest.NewEthReceipt(t, 1, utils.NewHash(), txHash)
+	data, err := json.Marshal(evmtypes.Receipt{
+		BlockNumber:      big.NewInt(r.BlockNumber),
+		BlockHash:        r.BlockHash,
+		TxHash:           txHash,
+		TransactionIndex: r.TransactionIndex,
+		GasUsed:          gasUsed,
+	})
+	require.NoError(t, err)
+	r.Receipt = data
+	require.NoError(t, borm.InsertEthReceipt(&r))
+}
+
+func TestTopUpper(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	chainID := &cltest.FixtureChainID
+
+	t.Run("tops up sending keys below the threshold up to the target", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		lggr := logger.TestLogger(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, lowAddr := cltest.MustInsertRandomKey(t, ethKeyStore)
+		_, highAddr := cltest.MustInsertRandomKey(t, ethKeyStore)
+		_, disabledAddr := cltest.MustInsertRandomKey(t, ethKeyStore)
+		require.NoError(t, ethKeyStore.Disable(disabledAddr, chainID))
+		borm := cltest.NewTxmORM(t, db, cfg)
+		etx := cltest.MustInsertUnconfirmedEthTx(t, borm, 0, fundingAddr)
+
+		ethClient := newEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+		bm := new(evmmocks.BalanceMonitor)
+		bm.Test(t)
+		bm.On("GetEthBalance", lowAddr).Return(assets.NewEth(10))
+		bm.On("GetEthBalance", highAddr).Return(assets.NewEth(1000))
+		txm := newTxManagerMock(t)
+		defer txm.AssertExpectations(t)
+
+		ethClient.On("BalanceAt", mock.Anything, lowAddr, nilBigInt).Once().Return(big.NewInt(10), nil)
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Once().Return(big.NewInt(1e18), nil)
+		txm.On("SendEther", chainID, fundingAddr, lowAddr, assets.NewEthValue(490), uint64(21000), mock.Anything).Once().Return(etx, nil)
+
+		orm := monitor.NewTopUpORM(db, lggr, cfg, *chainID)
+		tu := monitor.NewTopUpper(pg.NewQ(db, lggr, cfg), ethClient, topUpConfig{threshold: 100, target: 500, dailyLimit: 1e6}, ethKeyStore, bm, txm, orm, lggr)
+		require.NoError(t, tu.Start(testutils.Context(t)))
+		defer tu.Close()
+
+		tu.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+
+		var topUps []monitor.TopUp
+		gomega.NewWithT(t).Eventually(func() []monitor.TopUp {
+			var err error
+			topUps, err = orm.TopUps(10)
+			require.NoError(t, err)
+			return topUps
+		}).Should(gomega.HaveLen(1))
+		assert.Equal(t, fundingAddr, topUps[0].FromAddress)
+		assert.Equal(t, lowAddr, topUps[0].ToAddress)
+		assert.Equal(t, "490", topUps[0].Amount.ToInt().String())
+		assert.Equal(t, "10", topUps[0].Balance.ToInt().String())
+		assert.Equal(t, etx.ID, topUps[0].EthTxID.Int64)
+		bm.AssertNotCalled(t, "GetEthBalance", disabledAddr)
+
+		// The previous top-up is still pending, so the key is not topped up again
+		tu.OnNewLongestChain(testutils.Context(t), cltest.Head(2))
+		time.Sleep(100 * time.Millisecond)
+		topUps, err := orm.TopUps(10)
+		require.NoError(t, err)
+		assert.Len(t, topUps, 1)
+	})
+
+	t.Run("does not exceed the daily limit", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		lggr := logger.TestLogger(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+		borm := cltest.NewTxmORM(t, db, cfg)
+		orm := monitor.NewTopUpORM(db, lggr, cfg, *chainID)
+
+		// A confirmed top-up earlier today, whose fee was 21000 wei
+		confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fundingAddr)
+		mustInsertReceiptWithGasUsed(t, borm, confirmed.EthTxAttempts[0].Hash, 21000)
+		require.NoError(t, orm.InsertTopUp(&monitor.TopUp{
+			FromAddress: fundingAddr,
+			ToAddress:   k0Addr,
+			Amount:      assets.NewEthValue(250),
+			Balance:     assets.NewEthValue(0),
+			EthTxID:     null.IntFrom(confirmed.ID),
+		}))
+		etx := cltest.MustInsertUnconfirmedEthTx(t, borm, 1, fundingAddr)
+
+		ethClient := newEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+		bm := new(evmmocks.BalanceMonitor)
+		bm.Test(t)
+		bm.On("GetEthBalance", k0Addr).Return(assets.NewEth(0))
+		txm := newTxManagerMock(t)
+		defer txm.AssertExpectations(t)
+
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Once().Return(big.NewInt(1e18), nil)
+		txm.On("SendEther", chainID, fundingAddr, k0Addr, assets.NewEthValue(50), uint64(21000), mock.Anything).Once().Return(etx, nil)
+
+		// The limit leaves 50 wei once the fees of both top-ups are counted
+		tu := monitor.NewTopUpper(pg.NewQ(db, lggr, cfg), ethClient, topUpConfig{threshold: 100, target: 500, dailyLimit: 250 + 21000 + 21000 + 50}, ethKeyStore, bm, txm, orm, lggr)
+		require.NoError(t, tu.Start(testutils.Context(t)))
+		defer tu.Close()
+
+		tu.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+
+		gomega.NewWithT(t).Eventually(func() []monitor.TopUp {
+			topUps, err := orm.TopUps(10)
+			require.NoError(t, err)
+			return topUps
+		}).Should(gomega.HaveLen(2))
+		spent, err := orm.SpentSince(time.Now().Add(-time.Hour), big.NewInt(0))
+		require.NoError(t, err)
+		assert.Equal(t, int64(250+21000+50), spent.Int64())
+	})
+
+	t.Run("does not top up if the funding key has insufficient balance", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		lggr := logger.TestLogger(t)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+		ethClient := newEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+		bm := new(evmmocks.BalanceMonitor)
+		bm.Test(t)
+		bm.On("GetEthBalance", k0Addr).Return(assets.NewEth(0))
+		txm := newTxManagerMock(t)
+		defer txm.AssertExpectations(t)
+
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+		fundingChecked := make(chan struct{})
+		// Enough for the amount, but not for the gas fee
+		ethClient.On("BalanceAt", mock.Anything, fundingAddr, nilBigInt).Once().Return(big.NewInt(500), nil).Run(func(mock.Arguments) {
+			close(fundingChecked)
+		})
+
+		orm := monitor.NewTopUpORM(db, lggr, cfg, *chainID)
+		tu := monitor.NewTopUpper(pg.NewQ(db, lggr, cfg), ethClient, topUpConfig{threshold: 100, target: 500, dailyLimit: 1e6}, ethKeyStore, bm, txm, orm, lggr)
+		require.NoError(t, tu.Start(testutils.Context(t)))
+
+		tu.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+		select {
+		case <-fundingChecked:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for top-up")
+		}
+		// Wait for the worker to finish
+		require.NoError(t, tu.Close())
+
+		topUps, err := orm.TopUps(10)
+		require.NoError(t, err)
+		assert.Len(t, topUps, 0)
+	})
+	t.Run("does not top up from a disabled funding key", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		lggr, observed := logger.TestLoggerObserved(t, zapcore.WarnLevel)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, true)
+		cltest.MustInsertRandomKey(t, ethKeyStore)
+		require.NoError(t, ethKeyStore.Disable(fundingAddr, chainID))
+
+		ethClient := newEthClientMock(t)
+		defer ethClient.AssertExpectations(t)
+		bm := new(evmmocks.BalanceMonitor)
+		bm.Test(t)
+		txm := new(txmmocks.TxManager)
+		txm.Test(t)
+
+		orm := monitor.NewTopUpORM(db, lggr, cfg, *chainID)
+		tu := monitor.NewTopUpper(pg.NewQ(db, lggr, cfg), ethClient, topUpConfig{threshold: 100, target: 500, dailyLimit: 1e6}, ethKeyStore, bm, txm, orm, lggr)
+		require.NoError(t, tu.Start(testutils.Context(t)))
+		defer tu.Close()
+
+		tu.OnNewLongestChain(testutils.Context(t), cltest.Head(1))
+		gomega.NewWithT(t).Eventually(func() int {
+			return observed.FilterMessage("Cannot top up sending keys, the funding key is disabled").Len()
+		}).Should(gomega.Equal(1))
+		bm.AssertNotCalled(t, "GetEthBalance", mock.Anything)
+	})
+}
@@ -0,0 +1,105 @@
+package evm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// chainStandby keeps a chain warm on a standby node: the RPC nodes are dialed
+// and new heads are tracked in memory only, so that nothing is written to the
+// database before the node is promoted.
+type chainStandby struct {
+	listener httypes.HeadListener
+	chStop   chan struct{}
+	wg       sync.WaitGroup
+
+	headMu sync.RWMutex
+	head   *evmtypes.Head
+}
+
+func (s *chainStandby) handleNewHead(_ context.Context, head *evmtypes.Head) error {
+	s.headMu.Lock()
+	defer s.headMu.Unlock()
+	if s.head == nil || head.Number > s.head.Number {
+		s.head = head
+	}
+	return nil
+}
+
+func (s *chainStandby) latestHead() *evmtypes.Head {
+	s.headMu.RLock()
+	defer s.headMu.RUnlock()
+	return s.head
+}
+
+func (s *chainStandby) stop() {
+	close(s.chStop)
+	s.wg.Wait()
+}
+
+// startStandby dials the RPC nodes and starts tracking heads, without starting
+// any of the chain's services.
+func (c *chain) startStandby(ctx context.Context) error {
+	c.standbyMu.Lock()
+	defer c.standbyMu.Unlock()
+	if c.State() != utils.StartStopOnce_Unstarted {
+		return errors.Errorf("cannot start standby for chain in state %s", c.State())
+	}
+	if c.standby != nil || c.dialed {
+		return errors.New("standby already started")
+	}
+	if !c.cfg.EVMRPCEnabled() {
+		return nil
+	}
+
+	c.logger.Debugf("Chain: starting standby with ID %s", c.ID().String())
+	if err := c.client.Dial(ctx); err != nil {
+		return errors.Wrap(err, "failed to dial ethclient")
+	}
+	c.dialed = true
+
+	s := &chainStandby{chStop: make(chan struct{})}
+	s.listener = headtracker.NewHeadListener(c.logger, c.client, c.cfg, s.chStop)
+	s.wg.Add(1)
+	go s.listener.ListenForNewHeads(s.handleNewHead, s.wg.Done)
+	c.standby = s
+	return nil
+}
+
+// stopStandby stops tracking heads and reports whether the client was already
+// dialed by startStandby.
+func (c *chain) stopStandby() (dialed bool) {
+	c.standbyMu.Lock()
+	defer c.standbyMu.Unlock()
+	if c.standby != nil {
+		c.logger.Debug("Chain: stopping standby")
+		c.standby.stop()
+		c.standby = nil
+	}
+	return c.dialed
+}
+
+// closeStandby cleans up a chain that was kept warm but never started.
+func (c *chain) closeStandby() {
+	if c.stopStandby() && c.State() == utils.StartStopOnce_Unstarted {
+		c.client.Close()
+	}
+}
+
+// StandbyHead returns the latest head seen while on standby, or nil if the
+// chain is not on standby or has not received any heads yet.
+func (c *chain) StandbyHead() *evmtypes.Head {
+	c.standbyMu.Lock()
+	defer c.standbyMu.Unlock()
+	if c.standby == nil {
+		return nil
+	}
+	return c.standby.latestHead()
+}
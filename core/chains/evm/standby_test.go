@@ -0,0 +1,117 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+)
+
+func newStandbyChainSet(t *testing.T, ethClient *evmmocks.Client) (evm.ChainSet, evm.Chain) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	db := pgtest.NewSqlxDB(t)
+	kst := cltest.NewKeyStore(t, db, cfg)
+
+	chainSet := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, KeyStore: kst.Eth(), GeneralConfig: cfg, Client: ethClient})
+	chain, err := chainSet.Get(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	return chainSet, chain
+}
+
+// mockStandbySubscription expects the head subscription made on standby and
+// returns the channel that heads are delivered on.
+func mockStandbySubscription(t *testing.T, ethClient *evmmocks.Client) <-chan chan<- *evmtypes.Head {
+	chHeadsCh := make(chan chan<- *evmtypes.Head, 1)
+	var chHeads chan<- *evmtypes.Head
+	chErr := make(chan error)
+	var chSubErr <-chan error = chErr
+	sub := new(evmmocks.Subscription)
+	sub.Test(t)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.AnythingOfType("chan<- *types.Head")).Return(sub, nil).Once().Run(func(args mock.Arguments) {
+		chHeads = args.Get(1).(chan<- *evmtypes.Head)
+		chHeadsCh <- chHeads
+	})
+	sub.On("Err").Return(chSubErr)
+	sub.On("Unsubscribe").Return().Once().Run(func(mock.Arguments) {
+		close(chHeads)
+		close(chErr)
+	})
+	t.Cleanup(func() { sub.AssertExpectations(t) })
+	return chHeadsCh
+}
+
+func TestChainSet_StartStandby(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tracks heads and closes the client if never started", func(t *testing.T) {
+		ethClient := cltest.NewEthMocks(t)
+		ethClient.On("ChainID").Return(&cltest.FixtureChainID).Maybe()
+		ethClient.On("Dial", mock.Anything).Return(nil).Once()
+		chHeadsCh := mockStandbySubscription(t, ethClient)
+		ethClient.On("Close").Return().Once()
+
+		chainSet, chain := newStandbyChainSet(t, ethClient)
+		assert.Nil(t, chain.StandbyHead())
+
+		require.NoError(t, chainSet.StartStandby(testutils.Context(t)))
+		chHeads := <-chHeadsCh
+
+		chHeads <- cltest.Head(41)
+		chHeads <- cltest.Head(42)
+		chHeads <- cltest.Head(40)
+		require.Eventually(t, func() bool {
+			head := chain.StandbyHead()
+			return head != nil && head.Number == 42
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+
+		require.NoError(t, chainSet.Close())
+		assert.Nil(t, chain.StandbyHead())
+	})
+
+	t.Run("does not dial again on start", func(t *testing.T) {
+		testutils.SkipShort(t, "long test")
+		ethClient := cltest.NewEthMocks(t)
+		ethClient.On("ChainID").Return(&cltest.FixtureChainID).Maybe()
+		ethClient.On("Dial", mock.Anything).Return(nil).Once()
+		chHeadsCh := mockStandbySubscription(t, ethClient)
+		ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(cltest.EmptyMockSubscription(t), nil).Maybe()
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(cltest.Head(0), nil).Maybe()
+		ethClient.On("BlockByNumber", mock.Anything, mock.Anything).Return(gethTypes.NewBlockWithHeader(&gethTypes.Header{Number: big.NewInt(100)}), nil).Maybe()
+		ethClient.On("Close").Return().Once()
+
+		chainSet, chain := newStandbyChainSet(t, ethClient)
+		require.NoError(t, chainSet.StartStandby(testutils.Context(t)))
+		<-chHeadsCh
+
+		require.NoError(t, chainSet.Start(testutils.Context(t)))
+		assert.Nil(t, chain.StandbyHead())
+		require.NoError(t, chainSet.Close())
+	})
+
+	t.Run("leaves the chain to be dialed on start if dialing fails", func(t *testing.T) {
+		ethClient := cltest.NewEthMocks(t)
+		ethClient.On("ChainID").Return(&cltest.FixtureChainID).Maybe()
+		ethClient.On("Dial", mock.Anything).Return(errors.New("connection refused")).Once()
+
+		chainSet, chain := newStandbyChainSet(t, ethClient)
+		require.NoError(t, chainSet.StartStandby(testutils.Context(t)))
+		assert.Nil(t, chain.StandbyHead())
+
+		// The client was never dialed, so there is nothing to close
+		require.NoError(t, chainSet.Close())
+	})
+}
@@ -11,6 +11,9 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
@@ -21,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -37,6 +41,9 @@ const (
 
 var errEthTxRemoved = errors.New("eth_tx removed")
 
+// tracerName is the name of the tracer of broadcasts
+const tracerName = "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+
 // TransmitCheckerFactory creates a transmit checker based on a spec.
 type TransmitCheckerFactory interface {
 
@@ -238,14 +245,16 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 
 // ProcessUnstartedEthTxs picks up and handles all eth_txes in the queue
 func (eb *EthBroadcaster) ProcessUnstartedEthTxs(ctx context.Context, keyState ethkey.State) error {
-	return eb.processUnstartedEthTxs(ctx, keyState.Address.Address())
+	return eb.processUnstartedEthTxs(ctx, keyState.Address.Address(), keyState.Disabled)
 }
 
 // NOTE: This MUST NOT be run concurrently for the same address or it could
 // result in undefined state or deadlocks.
 // First handle any in_progress transactions left over from last time.
 // Then keep looking up unstarted transactions and processing them until there are none remaining.
-func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddress gethCommon.Address) error {
+// A disabled key only finishes its in_progress transaction, and its unstarted
+// transactions wait until it is enabled again.
+func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddress gethCommon.Address, disabled bool) error {
 	var n uint
 	mark := time.Now()
 	defer func() {
@@ -260,6 +269,9 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	} else if err != nil {
 		return errors.Wrap(err, "processUnstartedEthTxs failed")
 	}
+	if disabled {
+		return nil
+	}
 	for {
 		maxInFlightTransactions := eb.config.EvmMaxInFlightTransactions()
 		if maxInFlightTransactions > 0 {
@@ -360,7 +372,20 @@ func getInProgressEthTx(q pg.Q, fromAddress gethCommon.Address) (etx *EthTx, err
 
 // There can be at most one in_progress transaction per address.
 // Here we complete the job that we didn't finish last time.
-func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
+func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) (err error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "txmgr broadcast", trace.WithAttributes(
+		attribute.Int64("eth_tx.id", etx.ID),
+		attribute.String("eth_tx.from_address", etx.FromAddress.Hex()),
+		attribute.String("evm.chain_id", eb.chainID.String()),
+	))
+	if etx.Nonce != nil {
+		span.SetAttributes(attribute.Int64("eth_tx.nonce", *etx.Nonce))
+	}
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	if etx.State != EthTxInProgress {
 		return errors.Errorf("invariant violation: expected transaction %v to be in_progress, it was %s", etx.ID, etx.State)
 	}
@@ -18,6 +18,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -30,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/tracingtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -790,6 +793,41 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 		ethClient.AssertExpectations(t)
 	})
 
+	t.Run("disabled key finishes its in_progress transaction but does not start new ones", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		borm := cltest.NewTxmORM(t, db, cfg)
+
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, nextNonce)
+		require.NoError(t, ethKeyStore.Disable(fromAddress, keyState.EVMChainID.ToInt()))
+		keyState, err := ethKeyStore.GetState(fromAddress.Hex())
+		require.NoError(t, err)
+		require.True(t, keyState.Disabled)
+
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+		eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+		inProgressEthTx := cltest.MustInsertInProgressEthTxWithAttempt(t, borm, firstNonce, fromAddress)
+		unstartedEthTx := cltest.MustInsertUnstartedEthTx(t, borm, fromAddress)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(firstNonce)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx, err := borm.FindEthTxWithAttempts(inProgressEthTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+
+		etx, err = borm.FindEthTxWithAttempts(unstartedEthTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnstarted, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+
 	t.Run("previous run assigned nonce and broadcast but it fatally errored before we could save", func(t *testing.T) {
 		db := pgtest.NewSqlxDB(t)
 		borm := cltest.NewTxmORM(t, db, cfg)
@@ -1716,3 +1754,44 @@ func (t *testChecker) Check(
 ) error {
 	return t.err
 }
+
+func TestEthBroadcaster_Tracing(t *testing.T) {
+	recorder := tracingtest.NewSpanRecorder(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	// The transaction is sent within the span of the broadcast
+	var sendSpan trace.SpanContext
+	ethClient.On("SendTransaction", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sendSpan = trace.SpanContextFromContext(args.Get(0).(context.Context))
+	}).Return(nil).Once()
+
+	tx := txmgr.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          assets.NewEthValue(242),
+		GasLimit:       1231,
+		CreatedAt:      time.Unix(0, 0),
+		State:          txmgr.EthTxUnstarted,
+	}
+	require.NoError(t, borm.InsertEthTx(&tx))
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+	ethClient.AssertExpectations(t)
+
+	span := tracingtest.SpanNamed(recorder, "txmgr broadcast")
+	require.NotNil(t, span)
+	assert.Equal(t, span.SpanContext(), sendSpan)
+	assert.Contains(t, span.Attributes(), attribute.Int64("eth_tx.id", tx.ID))
+	assert.Contains(t, span.Attributes(), attribute.Int64("eth_tx.nonce", 0))
+	assert.Contains(t, span.Attributes(), attribute.String("eth_tx.from_address", fromAddress.Hex()))
+}
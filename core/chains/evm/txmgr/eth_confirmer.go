@@ -29,6 +29,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -95,6 +96,7 @@ type EthConfirmer struct {
 	ChainKeyStore
 	estimator      gas.Estimator
 	resumeCallback ResumeCallback
+	notifier       alerts.Notifier
 
 	keyStates []ethkey.State
 
@@ -108,7 +110,7 @@ type EthConfirmer struct {
 
 // NewEthConfirmer instantiates a new eth confirmer
 func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, keystore KeyStore,
-	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback, lggr logger.Logger) *EthConfirmer {
+	keyStates []ethkey.State, estimator gas.Estimator, resumeCallback ResumeCallback, lggr logger.Logger, notifier alerts.Notifier) *EthConfirmer {
 
 	context, cancel := context.WithCancel(context.Background())
 	lggr = lggr.Named("EthConfirmer")
@@ -127,6 +129,7 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 		},
 		estimator,
 		resumeCallback,
+		notifier,
 		keyStates,
 		utils.NewMailbox[*evmtypes.Head](1),
 		context,
@@ -219,6 +222,12 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 	}
 
 	ec.lggr.Debugw("Finished RebroadcastWhereNecessary", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+
+	// Failing to raise alerts should not prevent transactions from being
+	// confirmed
+	if err := ec.CheckForStuckTransactions(ctx, head.Number); err != nil {
+		ec.lggr.Errorw("CheckForStuckTransactions failed", "headNum", head.Number, "err", err)
+	}
 	mark = time.Now()
 
 	if err := ec.EnsureConfirmedTransactionsInLongestChain(ctx, head); err != nil {
@@ -718,6 +727,50 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 	return rows.Err()
 }
 
+// CheckForStuckTransactions raises an alert for each transaction which has
+// been unconfirmed for at least ETH_TX_STUCK_ALERT_BLOCKS since it was first
+// broadcast.
+func (ec *EthConfirmer) CheckForStuckTransactions(ctx context.Context, blockNum int64) error {
+	stuckBlocks := int64(ec.config.EvmTxStuckAlertBlocks())
+	if stuckBlocks == 0 {
+		return nil
+	}
+	var stuck []struct {
+		ID                      int64
+		FromAddress             gethCommon.Address
+		Nonce                   int64
+		BroadcastBeforeBlockNum int64
+	}
+	err := ec.q.WithOpts(pg.WithParentCtx(ctx)).Select(&stuck, `
+SELECT eth_txes.id, eth_txes.from_address, eth_txes.nonce, MIN(eth_tx_attempts.broadcast_before_block_num) AS broadcast_before_block_num
+FROM eth_txes
+JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+WHERE eth_txes.state = 'unconfirmed' AND eth_txes.evm_chain_id = $1
+GROUP BY eth_txes.id
+HAVING MIN(eth_tx_attempts.broadcast_before_block_num) <= $2
+`, ec.chainID.String(), blockNum-stuckBlocks)
+	if err != nil {
+		return errors.Wrap(err, "failed to load stuck transactions")
+	}
+	for _, etx := range stuck {
+		blocks := blockNum - etx.BroadcastBeforeBlockNum
+		ec.notifier.Notify(alerts.Alert{
+			Type:     alerts.TypeStuckTransaction,
+			Severity: alerts.SeverityWarning,
+			Key:      fmt.Sprintf("%s/%d", ec.chainID.String(), etx.ID),
+			Summary:  fmt.Sprintf("Transaction %d from %s on chain %s has been unconfirmed for %d blocks", etx.ID, etx.FromAddress.Hex(), ec.chainID.String(), blocks),
+			Details: map[string]string{
+				"evmChainID":  ec.chainID.String(),
+				"ethTxID":     fmt.Sprint(etx.ID),
+				"fromAddress": etx.FromAddress.Hex(),
+				"nonce":       fmt.Sprint(etx.Nonce),
+				"blocks":      fmt.Sprint(blocks),
+			},
+		})
+	}
+	return nil
+}
+
 // RebroadcastWhereNecessary bumps gas or resends transactions that were previously out-of-eth
 func (ec *EthConfirmer) RebroadcastWhereNecessary(ctx context.Context, blockHeight int64) error {
 	var wg sync.WaitGroup
@@ -410,6 +410,20 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// EvmTxStuckAlertBlocks provides a mock function with given fields:
+func (_m *Config) EvmTxStuckAlertBlocks() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmUseForwarders provides a mock function with given fields:
 func (_m *Config) EvmUseForwarders() bool {
 	ret := _m.Called()
@@ -104,6 +104,29 @@ func (_m *ORM) EthTxAttempts(offset int, limit int) ([]txmgr.EthTxAttempt, int,
 	return r0, r1, r2
 }
 
+// FindEthReceiptByTxHash provides a mock function with given fields: hash
+func (_m *ORM) FindEthReceiptByTxHash(hash common.Hash) (*txmgr.EthReceipt, error) {
+	ret := _m.Called(hash)
+
+	var r0 *txmgr.EthReceipt
+	if rf, ok := ret.Get(0).(func(common.Hash) *txmgr.EthReceipt); ok {
+		r0 = rf(hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txmgr.EthReceipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindEthTxAttempt provides a mock function with given fields: hash
 func (_m *ORM) FindEthTxAttempt(hash common.Hash) (*txmgr.EthTxAttempt, error) {
 	ret := _m.Called(hash)
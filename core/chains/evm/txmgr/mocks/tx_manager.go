@@ -123,20 +123,27 @@ func (_m *TxManager) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
 }
 
-// SendEther provides a mock function with given fields: chainID, from, to, value, gasLimit
-func (_m *TxManager) SendEther(chainID *big.Int, from common.Address, to common.Address, value assets.Eth, gasLimit uint64) (txmgr.EthTx, error) {
-	ret := _m.Called(chainID, from, to, value, gasLimit)
+// SendEther provides a mock function with given fields: chainID, from, to, value, gasLimit, qopts
+func (_m *TxManager) SendEther(chainID *big.Int, from common.Address, to common.Address, value assets.Eth, gasLimit uint64, qopts ...pg.QOpt) (txmgr.EthTx, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainID, from, to, value, gasLimit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 txmgr.EthTx
-	if rf, ok := ret.Get(0).(func(*big.Int, common.Address, common.Address, assets.Eth, uint64) txmgr.EthTx); ok {
-		r0 = rf(chainID, from, to, value, gasLimit)
+	if rf, ok := ret.Get(0).(func(*big.Int, common.Address, common.Address, assets.Eth, uint64, ...pg.QOpt) txmgr.EthTx); ok {
+		r0 = rf(chainID, from, to, value, gasLimit, qopts...)
 	} else {
 		r0 = ret.Get(0).(txmgr.EthTx)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*big.Int, common.Address, common.Address, assets.Eth, uint64) error); ok {
-		r1 = rf(chainID, from, to, value, gasLimit)
+	if rf, ok := ret.Get(1).(func(*big.Int, common.Address, common.Address, assets.Eth, uint64, ...pg.QOpt) error); ok {
+		r1 = rf(chainID, from, to, value, gasLimit, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
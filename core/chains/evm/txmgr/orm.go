@@ -24,6 +24,7 @@ type ORM interface {
 	InsertEthTxAttempt(attempt *EthTxAttempt) error
 	InsertEthTx(etx *EthTx) error
 	InsertEthReceipt(receipt *EthReceipt) error
+	FindEthReceiptByTxHash(hash common.Hash) (*EthReceipt, error)
 	FindEthTxWithAttempts(etxID int64) (etx EthTx, err error)
 }
 
@@ -215,6 +216,18 @@ func (o *orm) InsertEthReceipt(receipt *EthReceipt) error {
 	return errors.Wrap(err, "InsertEthReceipt failed")
 }
 
+// FindEthReceiptByTxHash finds the receipt of the transaction with the given
+// hash. If the transaction was included in more than one block due to a
+// re-org, the receipt from the highest block is returned.
+func (o *orm) FindEthReceiptByTxHash(hash common.Hash) (*EthReceipt, error) {
+	var receipt EthReceipt
+	err := o.q.Get(&receipt, `SELECT * FROM eth_receipts WHERE tx_hash = $1 ORDER BY block_number DESC, id DESC LIMIT 1`, hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindEthReceiptByTxHash failed")
+	}
+	return &receipt, nil
+}
+
 // FindEthTxWithAttempts finds the EthTx with its attempts and receipts preloaded
 func (o *orm) FindEthTxWithAttempts(etxID int64) (etx EthTx, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
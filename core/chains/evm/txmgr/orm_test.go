@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"database/sql"
 	"math/big"
 	"testing"
 
@@ -160,6 +161,15 @@ func TestORM(t *testing.T) {
 		assert.Equal(t, etx.ID, foundEtx.ID)
 		assert.Equal(t, etx.EVMChainID, foundEtx.EVMChainID)
 	})
+	t.Run("FindEthReceiptByTxHash", func(t *testing.T) {
+		receipt, err := orm.FindEthReceiptByTxHash(attemptD.Hash)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		assert.Equal(t, r.ID, receipt.ID)
+
+		_, err = orm.FindEthReceiptByTxHash(utils.NewHash())
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
 	t.Run("FindEthTxAttemptsByEthTxIDs", func(t *testing.T) {
 		attempts, err := orm.FindEthTxAttemptsByEthTxIDs([]int64{etx.ID})
 		require.NoError(t, err)
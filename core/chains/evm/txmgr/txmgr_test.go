@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -50,7 +51,7 @@ func TestTxm_SendEther_DoesNotSendToZero(t *testing.T) {
 	checkerFactory := &testCheckerFactory{}
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		ethClient, lggr, 100*time.Millisecond, 2, 3)
-	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp)
+	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp, alerts.NullNotifier{})
 
 	_, err := txm.SendEther(big.NewInt(0), from, to, *value, 21000)
 	require.Error(t, err)
@@ -216,7 +217,7 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 	checkerFactory := &testCheckerFactory{}
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		ethClient, lggr, 100*time.Millisecond, 2, 3)
-	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp)
+	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp, alerts.NullNotifier{})
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
 		subject := uuid.NewV4()
@@ -325,6 +326,22 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("cannot send transaction on chain ID 0; eth key with address %s is pegged to chain ID 1337", otherAddress.Hex()))
 	})
 
+	t.Run("returns error if eth key is disabled", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Once()
+		_, disabledAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+		require.NoError(t, keyStore.Eth().Disable(disabledAddress, testutils.FixtureChainID))
+
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    disabledAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       txmgr.SendEveryStrategy{},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("cannot send transaction from eth key with address %s; it is disabled", disabledAddress.Hex()))
+	})
+
 	t.Run("simulate transmit checker", func(t *testing.T) {
 		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
 
@@ -456,7 +473,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		ethClient, lggr, 100*time.Millisecond, 2, 3)
-	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, &testCheckerFactory{}, lp)
+	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, &testCheckerFactory{}, lp, alerts.NullNotifier{})
 
 	t.Run("if another key has any transactions with insufficient eth errors, transmits as normal", func(t *testing.T) {
 		payload := cltest.MustRandomBytes(t, 100)
@@ -557,7 +574,7 @@ func TestTxm_Lifecycle(t *testing.T) {
 
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		ethClient, lggr, 100*time.Millisecond, 2, 3)
-	txm := txmgr.NewTxm(db, ethClient, config, kst, eventBroadcaster, lggr, checkerFactory, lp)
+	txm := txmgr.NewTxm(db, ethClient, config, kst, eventBroadcaster, lggr, checkerFactory, lp, alerts.NullNotifier{})
 
 	head := cltest.Head(42)
 	// It should not hang or panic
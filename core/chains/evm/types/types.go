@@ -79,6 +79,12 @@ type ChainCfg struct {
 	EvmMaxGasPriceWei                              *utils.Big
 	EvmNonceAutoSync                               null.Bool
 	EvmUseForwarders                               null.Bool
+	EvmTopUpEnabled                                null.Bool
+	EvmTopUpThresholdWei                           *utils.Big
+	EvmTopUpTargetWei                              *utils.Big
+	EvmTopUpDailyLimitWei                          *utils.Big
+	EvmBalanceAlertThresholdWei                    *utils.Big
+	EvmTxStuckAlertBlocks                          null.Int
 	EvmRPCDefaultBatchSize                         null.Int
 	FlagsContractAddress                           null.String
 	GasEstimatorMode                               null.String
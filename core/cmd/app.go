@@ -104,6 +104,63 @@ func NewApp(client *Client) *cli.App {
 							Name:  "force",
 							Usage: "Whether to force broadcasting logs which were already consumed and that would otherwise be skipped",
 						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "(optional) last block to replay, defaults to the latest block",
+						},
+						cli.IntSliceFlag{
+							Name:  "job-id",
+							Usage: "(optional) only replay logs for the given job ID, can be repeated",
+						},
+						cli.StringSliceFlag{
+							Name:  "address",
+							Usage: "(optional) only replay logs from the given contract address, can be repeated",
+						},
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "list the logs which would be re-delivered without delivering them",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID to replay",
+						},
+					},
+				},
+				{
+					Name:   "replay-status",
+					Usage:  "Show the progress of a targeted replay",
+					Action: client.ShowReplay,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID of the replay",
+						},
+					},
+				},
+				{
+					Name:   "list",
+					Usage:  "List the latest blocks saved by the node, highest first",
+					Action: client.ListBlocks,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "limit",
+							Usage: "maximum number of blocks to list",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID of the blocks",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show the block with the given number saved by the node",
+					Action: client.ShowBlock,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID of the block",
+						},
 					},
 				},
 			},
@@ -245,12 +302,97 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:  "export",
+					Usage: format(`Exports jobs to a JSON bundle, with the bridges, external initiators and keys they depend on. Exports all jobs unless job IDs are given`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "Path where the JSON bundle will be saved (required)",
+						},
+					},
+					Action: client.ExportJobs,
+				},
+				{
+					Name:  "import",
+					Usage: format(`Imports a JSON bundle of jobs, creating all jobs and missing bridges in a single transaction once every job is valid for this node`),
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "only report what would be imported",
+						},
+					},
+					Action: client.ImportJobs,
+				},
+				{
+					Name:  "runs",
+					Usage: "Commands for managing the runs of jobs",
+					Subcommands: []cli.Command{
+						{
+							Name:   "replay",
+							Usage:  "Replay a finished run, using the recorded outputs of its http, bridge, ethcall and other external tasks, and show where it diverges",
+							Action: client.ReplayPipelineRun,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "templates",
+			Usage: "Commands for managing pipeline templates, which jobs invoke with subpipeline tasks",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the latest version of every pipeline template",
+					Action: client.IndexPipelineTemplates,
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a pipeline template",
+					Action: client.ShowPipelineTemplate,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "version",
+							Usage: "the version to show, defaults to the latest",
+						},
+					},
+				},
+				{
+					Name:   "versions",
+					Usage:  "List every version of a pipeline template",
+					Action: client.ListPipelineTemplateVersions,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a pipeline template, or a new version of an existing one, from a TOML spec",
+					Action: client.CreatePipelineTemplate,
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete every version of a pipeline template",
+					Action: client.DeletePipelineTemplate,
+				},
 			},
 		},
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
 			Subcommands: []cli.Command{
+				{
+					Name:  "rotate-password",
+					Usage: format(`Re-encrypts all keys of the keystore with a new password`),
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current keystore password (required)",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new keystore password (required)",
+						},
+					},
+					Action: client.RotateKeystorePassword,
+				},
 				{
 					Name:  "eth",
 					Usage: "Remote commands for administering the node's Ethereum keys",
@@ -279,6 +421,22 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Maximum gas price (GWei) for the specified key.",
 								},
+								cli.BoolFlag{
+									Name:  "enable",
+									Usage: "Enable the key for new transactions",
+								},
+								cli.BoolFlag{
+									Name:  "disable",
+									Usage: "Disable the key for new transactions, while its in-flight transactions are still confirmed",
+								},
+								cli.BoolFlag{
+									Name:  "enable-signing",
+									Usage: "Allow the key to sign messages in ethsign and ethsigntypeddata tasks",
+								},
+								cli.BoolFlag{
+									Name:  "disable-signing",
+									Usage: "Forbid the key to sign messages",
+								},
 							},
 						},
 						{
@@ -331,6 +489,21 @@ func NewApp(client *Client) *cli.App {
 							},
 							Action: client.ExportETHKey,
 						},
+						{
+							Name:   "topups",
+							Usage:  "List the latest top-ups of sending keys from the funding key, newest first",
+							Action: client.ListETHKeyTopUps,
+							Flags: []cli.Flag{
+								cli.IntFlag{
+									Name:  "limit",
+									Usage: "maximum number of top-ups to list",
+								},
+								cli.StringFlag{
+									Name:  "evmChainID",
+									Usage: "(optional) specify the chain ID of the top-ups",
+								},
+							},
+						},
 					},
 				},
 
@@ -435,6 +608,34 @@ func NewApp(client *Client) *cli.App {
 					},
 				},
 
+				{
+					Name:  "secrets",
+					Usage: "Remote commands for administering the node's secrets, which job pipelines refer to as $(secrets.name)",
+					Subcommands: cli.Commands{
+						{
+							Name:   "list",
+							Usage:  format(`List the names of the secrets`),
+							Action: client.ListSecrets,
+						},
+						{
+							Name:  "set",
+							Usage: format(`Sets a secret to the contents of a file, encrypted with the keystore password.`),
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "file, f",
+									Usage: "`FILE` containing the value of the secret (required)",
+								},
+							},
+							Action: client.SetSecret,
+						},
+						{
+							Name:   "delete",
+							Usage:  format(`Deletes a secret by its name`),
+							Action: client.DeleteSecret,
+						},
+					},
+				},
+
 				{
 					Name:  "ocr",
 					Usage: "Remote commands for administering the node's legacy off chain reporting keys",
@@ -725,6 +926,41 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
+		{
+			Name:  "logs",
+			Usage: "Commands for inspecting the EVM logs saved by the node",
+			Subcommands: []cli.Command{
+				{
+					Name:   "query",
+					Usage:  "List the logs saved by the node in a block range",
+					Action: client.QueryLogs,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "from",
+							Usage:    "first block of the range",
+							Required: true,
+						},
+						cli.Int64Flag{
+							Name:     "to",
+							Usage:    "last block of the range",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:  "address",
+							Usage: "(optional) only list logs emitted by this contract address",
+						},
+						cli.StringFlag{
+							Name:  "topic",
+							Usage: "(optional) only list logs which contain this topic",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID of the logs",
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:        "node",
 			Aliases:     []string{"local"},
@@ -811,6 +1047,11 @@ func NewApp(client *Client) *cli.App {
 					Action: client.Status,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:   "health",
+					Usage:  "Displays the health of the node's chains, jobs and external dependencies.",
+					Action: client.Health,
+				},
 				{
 					Name:   "profile",
 					Usage:  "Collects profile metrics from the node.",
@@ -881,6 +1122,17 @@ func NewApp(client *Client) *cli.App {
 							Action: client.RollbackDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "restore",
+							Usage:  "Restore a database backup taken by the node into DATABASE_URL. WARNING: This will REPLACE ALL DATA in the database.",
+							Action: client.RestoreDatabase,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+						},
 						{
 							Name:   "create-migration",
 							Usage:  "Create a new migration.",
@@ -920,6 +1172,17 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "receipts",
+			Usage: "Commands for inspecting the EVM transaction receipts saved by the node",
+			Subcommands: []cli.Command{
+				{
+					Name:   "show",
+					Usage:  "Show the receipt of the transaction with the given hash",
+					Action: client.ShowReceipt,
+				},
+			},
+		},
 		{
 			Name:  "txs",
 			Usage: "Commands for handling transactions",
@@ -18,14 +18,33 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyMaxConcurrency converts the max concurrency to a string
+func (p *BridgePresenter) FriendlyMaxConcurrency() string {
+	if p.MaxConcurrency == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatUint(uint64(p.MaxConcurrency), 10)
+}
+
+// FriendlyRateLimit converts the rate limit to a string
+func (p *BridgePresenter) FriendlyRateLimit() string {
+	if p.RateLimit == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatFloat(p.RateLimit, 'f', -1, 64) + "/s"
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Max Concurrency", "Rate Limit", "Circuit Breaker"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyMaxConcurrency(),
+		p.FriendlyRateLimit(),
+		string(p.CircuitBreaker),
 	})
 	render("Bridge", table)
 	return nil
@@ -35,12 +54,13 @@ type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Circuit Breaker"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			string(p.CircuitBreaker),
 		})
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,12 +30,15 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 
 	p := cmd.BridgePresenter{
 		BridgeResource: presenters.BridgeResource{
-			JAID:          presenters.NewJAID(name),
-			Name:          name,
-			URL:           url,
-			Confirmations: 10,
-			OutgoingToken: outgoingToken,
-			CreatedAt:     createdAt,
+			JAID:           presenters.NewJAID(name),
+			Name:           name,
+			URL:            url,
+			Confirmations:  10,
+			OutgoingToken:  outgoingToken,
+			MaxConcurrency: 5,
+			RateLimit:      2.5,
+			CircuitBreaker: pipeline.CircuitBreakerOpen,
+			CreatedAt:      createdAt,
 		},
 	}
 
@@ -46,6 +50,8 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "2.5/s")
+	assert.Contains(t, output, "open")
 
 	// Render many resources
 	buffer.Reset()
@@ -57,6 +63,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.NotContains(t, output, outgoingToken)
+	assert.Contains(t, output, "open")
 }
 
 func TestClient_IndexBridges(t *testing.T) {
@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
@@ -87,18 +88,48 @@ func (cli *Client) errorOut(err error) error {
 
 // AppFactory implements the NewApplication method.
 type AppFactory interface {
-	NewApplication(cfg config.GeneralConfig, db *sqlx.DB) (chainlink.Application, error)
+	NewApplication(cfg config.GeneralConfig, db *sqlx.DB, notifier alerts.Notifier) (chainlink.Application, error)
+}
+
+// StandbyAppFactory is implemented by AppFactories able to create the
+// Application of a warm standby node.
+type StandbyAppFactory interface {
+	// NewStandbyApplication returns an Application which does not write to the
+	// DB, along with the function preparing the DB for promotion, which must be
+	// called once the DB locks are held and before the Application is started.
+	NewStandbyApplication(cfg config.GeneralConfig, db *sqlx.DB, notifier alerts.Notifier) (app chainlink.Application, prepareDB func() error, err error)
 }
 
 // ChainlinkAppFactory is used to create a new Application.
 type ChainlinkAppFactory struct{}
 
+var _ StandbyAppFactory = ChainlinkAppFactory{}
+
 // NewApplication returns a new instance of the node with the given config.
-func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.DB) (app chainlink.Application, err error) {
+func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.DB, notifier alerts.Notifier) (app chainlink.Application, err error) {
 	appLggr, closeLggr := logger.NewLogger()
 
-	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
+	if err = prepareDB(cfg, db, appLggr); err != nil {
+		return nil, err
+	}
+	return newApplication(cfg, db, notifier, appLggr, closeLggr)
+}
 
+// NewStandbyApplication returns a new instance of the node with the given
+// config, without writing to the DB. The DB must already be migrated.
+func (n ChainlinkAppFactory) NewStandbyApplication(cfg config.GeneralConfig, db *sqlx.DB, notifier alerts.Notifier) (app chainlink.Application, prepare func() error, err error) {
+	appLggr, closeLggr := logger.NewLogger()
+
+	app, err = newApplication(cfg, db, notifier, appLggr, closeLggr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return app, func() error { return prepareDB(cfg, db, appLggr) }, nil
+}
+
+// prepareDB checks the DB version, takes a backup and migrates the DB if
+// needed, then updates it with the chains and nodes configured from ENV.
+func prepareDB(cfg config.GeneralConfig, db *sqlx.DB, appLggr logger.Logger) (err error) {
 	// Set up the versioning ORM
 	verORM := versioning.NewORM(db, appLggr)
 
@@ -107,7 +138,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		appv, dbv, err = versioning.CheckVersion(db, appLggr, static.Version)
 		if err != nil {
 			// Exit immediately and don't touch the database if the app version is too old
-			return nil, errors.Wrap(err, "CheckVersion")
+			return errors.Wrap(err, "CheckVersion")
 		}
 
 		// Take backup if app version is newer than DB version
@@ -119,7 +150,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 				} else if strings.Contains(err.Error(), "relation \"node_versions\" does not exist") {
 					appLggr.Debugf("Failed to find any node version in the DB, the node_versions table does not exist yet: %w", err)
 				} else {
-					return nil, errors.Wrap(err, "initializeORM#FindLatestNodeVersion")
+					return errors.Wrap(err, "initializeORM#FindLatestNodeVersion")
 				}
 			}
 		}
@@ -128,7 +159,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 	// Migrate the database
 	if cfg.MigrateDatabase() {
 		if err = migrate.Migrate(db.DB, appLggr); err != nil {
-			return nil, errors.Wrap(err, "initializeORM#Migrate")
+			return errors.Wrap(err, "initializeORM#Migrate")
 		}
 	}
 
@@ -136,17 +167,36 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 	if static.Version != "unset" {
 		version := versioning.NewNodeVersion(static.Version)
 		if err = verORM.UpsertNodeVersion(version); err != nil {
-			return nil, errors.Wrap(err, "UpsertNodeVersion")
+			return errors.Wrap(err, "UpsertNodeVersion")
 		}
 	}
 
 	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility
 	if cfg.EVMEnabled() {
 		if err = evm.ClobberDBFromEnv(db, cfg, appLggr); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
+	if cfg.TerraEnabled() {
+		if err = terra.SetupNodes(db, cfg, appLggr.Named("Terra")); err != nil {
+			return errors.Wrap(err, "failed to setup Terra nodes")
+		}
+	}
+
+	if cfg.SolanaEnabled() {
+		if err = solana.SetupNodes(db, cfg, appLggr.Named("Solana")); err != nil {
+			return errors.Wrap(err, "failed to setup Solana nodes")
+		}
+	}
+	return nil
+}
+
+// newApplication returns a new instance of the node with the given config,
+// on a DB prepared by prepareDB.
+func newApplication(cfg config.GeneralConfig, db *sqlx.DB, notifier alerts.Notifier, appLggr logger.Logger, closeLggr func() error) (app chainlink.Application, err error) {
+	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
+
 	eventBroadcaster := pg.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration(), appLggr, cfg.AppID())
 	ccOpts := evm.ChainSetOpts{
 		Config:           cfg,
@@ -155,6 +205,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		ORM:              evm.NewORM(db, appLggr, cfg),
 		KeyStore:         keyStore.Eth(),
 		EventBroadcaster: eventBroadcaster,
+		AlertNotifier:    notifier,
 	}
 	var chains chainlink.Chains
 	chains.EVM, err = evm.LoadChainSet(ccOpts)
@@ -164,9 +215,6 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 
 	if cfg.TerraEnabled() {
 		terraLggr := appLggr.Named("Terra")
-		if err := terra.SetupNodes(db, cfg, terraLggr); err != nil {
-			return nil, errors.Wrap(err, "failed to setup Terra nodes")
-		}
 		chains.Terra, err = terra.NewChainSet(terra.ChainSetOpts{
 			Config:           cfg,
 			Logger:           terraLggr,
@@ -182,9 +230,6 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 
 	if cfg.SolanaEnabled() {
 		solLggr := appLggr.Named("Solana")
-		if err := solana.SetupNodes(db, cfg, solLggr); err != nil {
-			return nil, errors.Wrap(err, "failed to setup Solana nodes")
-		}
 		chains.Solana, err = solana.NewChainSet(solana.ChainSetOpts{
 			Config:           cfg,
 			Logger:           solLggr,
@@ -213,6 +258,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		Version:                  static.Version,
 		RestrictedHTTPClient:     restrictedClient,
 		UnrestrictedHTTPClient:   unrestrictedClient,
+		AlertNotifier:            notifier,
 	})
 }
 
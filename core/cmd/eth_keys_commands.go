@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -27,13 +28,15 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.EthBalance.String(),
 		p.LinkBalance.String(),
 		fmt.Sprintf("%v", p.IsFunding),
+		fmt.Sprintf("%v", p.Disabled),
+		fmt.Sprintf("%v", p.SigningEnabled),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		p.MaxGasPriceWei.String(),
 	}
 }
 
-var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated", "Max Gas Price Wei"}
+var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Disabled", "Signing enabled", "Created", "Updated", "Max Gas Price Wei"}
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
@@ -74,6 +77,61 @@ func (cli *Client) ListETHKeys(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(resp, &EthKeyPresenters{}, "🔑 ETH keys")
 }
 
+type EthKeyTopUpPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ETHKeyTopUpResource
+}
+
+var ethKeyTopUpsTableHeaders = []string{"ID", "EVM Chain ID", "From", "To", "Amount", "Balance", "Eth Tx ID", "Created"}
+
+// ToRow presents the EthKeyTopUpResource as a slice of strings.
+func (p *EthKeyTopUpPresenter) ToRow() []string {
+	ethTxID := ""
+	if p.EthTxID != nil {
+		ethTxID = strconv.FormatInt(*p.EthTxID, 10)
+	}
+
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		p.FromAddress,
+		p.ToAddress,
+		p.Amount.String(),
+		p.Balance.String(),
+		ethTxID,
+		p.CreatedAt.String(),
+	}
+}
+
+// EthKeyTopUpPresenters implements TableRenderer for a slice of
+// EthKeyTopUpPresenter.
+type EthKeyTopUpPresenters []EthKeyTopUpPresenter
+
+// RenderTable implements TableRenderer
+func (ps EthKeyTopUpPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(ethKeyTopUpsTableHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListETHKeyTopUps lists the latest top-ups of sending keys from the funding
+// key
+func (cli *Client) ListETHKeyTopUps(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("limit") {
+		query.Set("limit", strconv.Itoa(c.Int("limit")))
+	}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	return cli.getAndRender("/v2/keys/eth/top_ups?"+query.Encode(), &EthKeyTopUpPresenters{})
+}
+
 // CreateETHKey creates a new ethereum key with the same password
 // as the one used to unlock the existing key.
 func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
@@ -117,7 +175,22 @@ func (cli *Client) UpdateETHKey(c *cli.Context) (err error) {
 	query := updateUrl.Query()
 	if c.IsSet("maxGasPriceGWei") {
 		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
-	} else {
+	}
+	if c.Bool("enable") && c.Bool("disable") {
+		return cli.errorOut(errors.New("Cannot both enable and disable the key"))
+	} else if c.Bool("enable") {
+		query.Set("disabled", "false")
+	} else if c.Bool("disable") {
+		query.Set("disabled", "true")
+	}
+	if c.Bool("enable-signing") && c.Bool("disable-signing") {
+		return cli.errorOut(errors.New("Cannot both enable and disable signing"))
+	} else if c.Bool("enable-signing") {
+		query.Set("signingEnabled", "true")
+	} else if c.Bool("disable-signing") {
+		query.Set("signingEnabled", "false")
+	}
+	if len(query) == 0 {
 		return cli.errorOut(errors.New("Must pass at least one parameter to update"))
 	}
 
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type EVMBlockPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.EVMBlockResource
+}
+
+var evmBlockHeaders = []string{"Number", "Hash", "Parent Hash", "Timestamp", "Base Fee", "Chain ID"}
+
+// ToRow presents the EVMBlockResource as a slice of strings.
+func (p *EVMBlockPresenter) ToRow() []string {
+	baseFee := ""
+	if p.BaseFeePerGas != nil {
+		baseFee = p.BaseFeePerGas.String()
+	}
+
+	return []string{
+		strconv.FormatInt(p.Number, 10),
+		p.Hash.Hex(),
+		p.ParentHash.Hex(),
+		p.Timestamp.Format(time.RFC3339),
+		baseFee,
+		p.EVMChainID.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *EVMBlockPresenter) RenderTable(rt RendererTable) error {
+	renderList(evmBlockHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// EVMBlockPresenters implements TableRenderer for a slice of EVMBlockPresenter.
+type EVMBlockPresenters []EVMBlockPresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMBlockPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(evmBlockHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListBlocks lists the latest blocks saved by the node's head tracker
+func (cli *Client) ListBlocks(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("limit") {
+		query.Set("limit", strconv.Itoa(c.Int("limit")))
+	}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	return cli.getAndRender("/v2/blocks/evm?"+query.Encode(), &EVMBlockPresenters{})
+}
+
+// ShowBlock shows the block with the given number saved by the node's head
+// tracker
+func (cli *Client) ShowBlock(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the number of the block"))
+	}
+	number, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid block number"))
+	}
+
+	query := url.Values{}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	return cli.getAndRender(fmt.Sprintf("/v2/blocks/evm/%d?%s", number, query.Encode()), &EVMBlockPresenter{})
+}
+
+// getAndRender performs a GET request to the path and renders the response
+// into the presenter.
+func (cli *Client) getAndRender(path string, presenter interface{}) (err error) {
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, presenter)
+}
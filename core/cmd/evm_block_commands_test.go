@@ -0,0 +1,72 @@
+package cmd_test
+
+import (
+	"flag"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestClient_ListBlocks(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	orm := headtracker.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig(), cltest.FixtureChainID)
+	for _, n := range []int64{1000000, 1000001, 1000002} {
+		require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), cltest.Head(n)))
+	}
+
+	set := flag.NewFlagSet("test list blocks", 0)
+	set.Int("limit", 25, "")
+	require.NoError(t, set.Parse([]string{"--limit", "2"}))
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ListBlocks(c))
+
+	rendered := *r.Renders[0].(*cmd.EVMBlockPresenters)
+	require.Len(t, rendered, 2)
+	assert.Equal(t, int64(1000002), rendered[0].Number)
+	assert.Equal(t, int64(1000001), rendered[1].Number)
+}
+
+func TestClient_ShowBlock(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	orm := headtracker.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig(), cltest.FixtureChainID)
+	head := cltest.Head(1000000)
+	require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), head))
+
+	set := flag.NewFlagSet("test show block", 0)
+	require.NoError(t, set.Parse([]string{strconv.FormatInt(head.Number, 10)}))
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowBlock(c))
+
+	rendered := *r.Renders[0].(*cmd.EVMBlockPresenter)
+	assert.Equal(t, head.Number, rendered.Number)
+	assert.Equal(t, head.Hash, rendered.Hash)
+
+	// Unknown block
+	set = flag.NewFlagSet("test show block", 0)
+	require.NoError(t, set.Parse([]string{"999999999"}))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowBlock(c))
+
+	// Invalid block number
+	set = flag.NewFlagSet("test show block", 0)
+	require.NoError(t, set.Parse([]string{"latest"}))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowBlock(c))
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type EVMLogPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.EVMLogResource
+}
+
+var evmLogHeaders = []string{"Block Number", "Log Index", "Address", "Topics", "Tx Hash", "Block Hash"}
+
+// ToRow presents the EVMLogResource as a slice of strings.
+func (p *EVMLogPresenter) ToRow() []string {
+	var topics []string
+	for _, t := range p.Topics {
+		topics = append(topics, t.Hex())
+	}
+
+	return []string{
+		strconv.FormatInt(p.BlockNumber, 10),
+		strconv.FormatInt(p.LogIndex, 10),
+		p.Address.Hex(),
+		strings.Join(topics, "\n"),
+		p.TxHash.Hex(),
+		p.BlockHash.Hex(),
+	}
+}
+
+// EVMLogPresenters implements TableRenderer for a slice of EVMLogPresenter.
+type EVMLogPresenters []EVMLogPresenter
+
+// RenderTable implements TableRenderer
+func (ps EVMLogPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(evmLogHeaders, rows, rt.Writer)
+	return nil
+}
+
+// QueryLogs lists the logs saved by the node's log poller in a block range,
+// optionally filtered by address and topic
+func (cli *Client) QueryLogs(c *cli.Context) (err error) {
+	if !c.IsSet("from") || !c.IsSet("to") {
+		return cli.errorOut(errors.New("must pass the block range in '--from' and '--to'"))
+	}
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(c.Int64("from"), 10))
+	query.Set("to", strconv.FormatInt(c.Int64("to"), 10))
+	if c.IsSet("address") {
+		query.Set("address", c.String("address"))
+	}
+	if c.IsSet("topic") {
+		query.Set("topic", c.String("topic"))
+	}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	return cli.getAndRender("/v2/logs/evm?"+query.Encode(), &EVMLogPresenters{})
+}
@@ -0,0 +1,72 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestClient_QueryLogs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	addr := testutils.NewAddress()
+	topic := utils.NewHash()
+	orm := logpoller.NewORM(&cltest.FixtureChainID, app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig())
+	var logs []logpoller.Log
+	for i, a := range []common.Address{addr, addr, testutils.NewAddress()} {
+		logs = append(logs, logpoller.Log{
+			EvmChainId:  utils.NewBig(&cltest.FixtureChainID),
+			LogIndex:    int64(i),
+			BlockHash:   utils.NewHash(),
+			BlockNumber: int64(10 + i),
+			EventSig:    topic[:],
+			Topics:      [][]byte{topic[:]},
+			Address:     a,
+			TxHash:      utils.NewHash(),
+			Data:        []byte("hello"),
+		})
+	}
+	require.NoError(t, orm.InsertLogs(logs))
+
+	newSet := func(args ...string) *flag.FlagSet {
+		set := flag.NewFlagSet("test query logs", 0)
+		set.Int64("from", 0, "")
+		set.Int64("to", 0, "")
+		set.String("address", "", "")
+		set.String("topic", "", "")
+		require.NoError(t, set.Parse(args))
+		return set
+	}
+
+	c := cli.NewContext(nil, newSet("--from", "10", "--to", "20", "--address", addr.Hex()), nil)
+	require.NoError(t, client.QueryLogs(c))
+
+	rendered := *r.Renders[0].(*cmd.EVMLogPresenters)
+	require.Len(t, rendered, 2)
+	for _, l := range rendered {
+		assert.Equal(t, addr, l.Address)
+		assert.Equal(t, []common.Hash{topic}, l.Topics)
+	}
+
+	// The block range is required
+	c = cli.NewContext(nil, newSet("--from", "10"), nil)
+	assert.Error(t, client.QueryLogs(c))
+
+	// Invalid topic
+	c = cli.NewContext(nil, newSet("--from", "10", "--to", "20", "--topic", "0x1234"), nil)
+	assert.Error(t, client.QueryLogs(c))
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type EVMReceiptPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.EVMReceiptResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EVMReceiptPresenter) RenderTable(rt RendererTable) error {
+	renderList(
+		[]string{"Tx Hash", "Block Number", "Block Hash", "Tx Index", "Receipt"},
+		[][]string{{
+			p.TxHash.Hex(),
+			strconv.FormatInt(p.BlockNumber, 10),
+			p.BlockHash.Hex(),
+			strconv.FormatUint(uint64(p.TransactionIndex), 10),
+			string(p.Receipt),
+		}},
+		rt.Writer,
+	)
+	return nil
+}
+
+// ShowReceipt shows the receipt of the transaction with the given hash, as
+// saved by the node's transaction manager
+func (cli *Client) ShowReceipt(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+
+	return cli.getAndRender("/v2/receipts/evm/"+c.Args().First(), &EVMReceiptPresenter{})
+}
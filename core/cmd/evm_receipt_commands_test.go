@@ -0,0 +1,43 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestClient_ShowReceipt(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+	attempt := tx.EthTxAttempts[0]
+	receipt := cltest.MustInsertEthReceipt(t, app.TxmORM(), 1, utils.NewHash(), attempt.Hash)
+
+	set := flag.NewFlagSet("test show receipt", 0)
+	require.NoError(t, set.Parse([]string{attempt.Hash.Hex()}))
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowReceipt(c))
+
+	rendered := *r.Renders[0].(*cmd.EVMReceiptPresenter)
+	assert.Equal(t, attempt.Hash, rendered.TxHash)
+	assert.Equal(t, receipt.BlockHash, rendered.BlockHash)
+	assert.Equal(t, int64(1), rendered.BlockNumber)
+
+	// Unknown transaction hash
+	set = flag.NewFlagSet("test show receipt", 0)
+	require.NoError(t, set.Parse([]string{utils.NewHash().Hex()}))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowReceipt(c))
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type ReplayPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ReplayResource
+}
+
+var replayHeaders = []string{"ID", "From", "To", "Current", "Jobs", "Addresses", "Force", "Dry Run", "Logs Found", "Logs Delivered", "Done", "Error"}
+
+// ToRow presents the ReplayResource as a slice of strings.
+func (p *ReplayPresenter) ToRow() []string {
+	var jobIDs []string
+	for _, id := range p.JobIDs {
+		jobIDs = append(jobIDs, strconv.Itoa(int(id)))
+	}
+	var addresses []string
+	for _, addr := range p.Addresses {
+		addresses = append(addresses, addr.Hex())
+	}
+
+	return []string{
+		p.ID,
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.CurrentBlock, 10),
+		strings.Join(jobIDs, "\n"),
+		strings.Join(addresses, "\n"),
+		strconv.FormatBool(p.ForceBroadcast),
+		strconv.FormatBool(p.DryRun),
+		strconv.Itoa(p.LogsFound),
+		strconv.Itoa(p.LogsDelivered),
+		strconv.FormatBool(p.Done),
+		p.Error,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *ReplayPresenter) RenderTable(rt RendererTable) error {
+	renderList(replayHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	if p.DryRun && len(p.Logs) > 0 {
+		var rows [][]string
+		for _, l := range p.Logs {
+			consumer := "log poller"
+			if !l.LogPoller {
+				consumer = fmt.Sprintf("job %d", l.JobID)
+			}
+			rows = append(rows, []string{
+				consumer,
+				l.Address.Hex(),
+				l.Topic.Hex(),
+				strconv.FormatUint(l.BlockNumber, 10),
+				l.TxHash.Hex(),
+				strconv.FormatUint(uint64(l.LogIndex), 10),
+			})
+		}
+		renderList([]string{"Consumer", "Address", "Topic", "Block Number", "Tx Hash", "Log Index"}, rows, rt.Writer)
+	}
+	return nil
+}
+
+// isTargetedReplay returns true when the replay is restricted to a block
+// range, jobs or addresses, or is a dry run.
+func isTargetedReplay(c *cli.Context) bool {
+	return c.IsSet("to-block") || c.IsSet("job-id") || c.IsSet("address") || c.Bool("dry-run")
+}
+
+// ReplayTargeted replays the logs of a block range, restricted to the given
+// jobs and contract addresses
+func (cli *Client) ReplayTargeted(c *cli.Context) (err error) {
+	request := web.TargetedReplayRequest{
+		FromBlock:      c.Int64("block-number"),
+		JobIDs:         []int32{},
+		Addresses:      []common.Address{},
+		ForceBroadcast: c.Bool("force"),
+		DryRun:         c.Bool("dry-run"),
+	}
+	if c.IsSet("to-block") {
+		toBlock := c.Int64("to-block")
+		request.ToBlock = &toBlock
+	}
+	for _, id := range c.IntSlice("job-id") {
+		request.JobIDs = append(request.JobIDs, int32(id))
+	}
+	for _, addr := range c.StringSlice("address") {
+		if !common.IsHexAddress(addr) {
+			return cli.errorOut(errors.Errorf("invalid address: %s", addr))
+		}
+		request.Addresses = append(request.Addresses, common.HexToAddress(addr))
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	query := url.Values{}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	resp, err := cli.HTTP.Post("/v2/replays?"+query.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ReplayPresenter{})
+}
+
+// ShowReplay shows the progress of a targeted replay
+func (cli *Client) ShowReplay(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the replay"))
+	}
+
+	query := url.Values{}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	return cli.getAndRender(fmt.Sprintf("/v2/replays/%s?%s", c.Args().First(), query.Encode()), &ReplayPresenter{})
+}
@@ -5,13 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -257,3 +262,191 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI pipeline run replay resource
+// and adds rendering functionality
+type PipelineRunReplayPresenter struct {
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	var outputs []string
+	for _, output := range p.Outputs {
+		outputs = append(outputs, friendlyOptionalString(output))
+	}
+	table := rt.newTable([]string{"Run ID", "Diverged", "Outputs Diverged", "Outputs"})
+	table.Append([]string{
+		p.ID,
+		fmt.Sprintf("%v", p.Diverged),
+		fmt.Sprintf("%v", p.OutputsDiverged),
+		strings.Join(outputs, "\n"),
+	})
+	render("Pipeline Run Replay", table)
+
+	if len(p.Divergences) == 0 {
+		return nil
+	}
+	table = rt.newTable([]string{"Task", "Type", "Recorded Output", "Recorded Error", "Replayed Output", "Replayed Error"})
+	for _, d := range p.Divergences {
+		table.Append([]string{
+			d.DotID,
+			string(d.Type),
+			friendlyOptionalString(d.RecordedOutput),
+			friendlyOptionalString(d.RecordedError),
+			friendlyOptionalString(d.ReplayedOutput),
+			friendlyOptionalString(d.ReplayedError),
+		})
+	}
+	render("Divergences", table)
+	return nil
+}
+
+func friendlyOptionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ReplayPipelineRun executes a finished pipeline run again, using the
+// recorded outputs of its external tasks, and shows how it diverges from the
+// recorded run
+func (cli *Client) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the run id to replay"))
+	}
+	resp, err := cli.HTTP.Get("/v2/pipeline/runs/" + c.Args().First() + "/replay")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+}
+
+// ExportJobs saves a bundle of jobs, and the bridges, external initiators and
+// keys they depend on, to a file
+func (cli *Client) ExportJobs(c *cli.Context) (err error) {
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return cli.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	exportURL := url.URL{
+		Path: "/v2/jobs/export",
+	}
+	query := exportURL.Query()
+	for _, id := range c.Args() {
+		query.Add("id", id)
+	}
+	exportURL.RawQuery = query.Encode()
+
+	resp, err := cli.HTTP.Get(exportURL.String())
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	bundleJSON, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	var bundle job.Bundle
+	if err = json.Unmarshal(bundleJSON, &bundle); err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not parse job bundle"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, bundleJSON, 0600)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("Exported %d jobs to %s\n", len(bundle.Jobs), filepath))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return nil
+}
+
+// JobImportPresenter wraps the JSONAPI job import resource and adds rendering
+// functionality
+type JobImportPresenter struct {
+	JAID
+	presenters.JobImportResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobImportPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Type", "Name", "Action", "Error", "Incoming Token"})
+	for _, item := range p.Items {
+		errStr := item.Error
+		if item.StartError != "" {
+			errStr = "failed to start: " + item.StartError
+		}
+		table.Append([]string{item.Type, item.Name, item.Action, errStr, item.IncomingToken})
+	}
+
+	title := "Job Import"
+	switch {
+	case p.DryRun:
+		title = "Job Import (dry run)"
+	case !p.Imported:
+		title = "Job Import (nothing imported)"
+	}
+	render(title, table)
+	return nil
+}
+
+// ImportJobs validates a bundle of jobs against this node and creates all of
+// them, and the missing bridges they depend on, at once
+func (cli *Client) ImportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the filepath of the job bundle to be imported"))
+	}
+
+	bundleJSON, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	importURL := url.URL{
+		Path: "/v2/jobs/import",
+	}
+	if c.Bool("dry-run") {
+		query := importURL.Query()
+		query.Set("dryRun", "true")
+		importURL.RawQuery = query.Encode()
+	}
+
+	resp, err := cli.HTTP.Post(importURL.String(), bytes.NewReader(bundleJSON))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var report JobImportPresenter
+	if err = cli.renderAPIResponse(resp, &report); err != nil {
+		return err
+	}
+	if !report.DryRun && !report.Imported {
+		return cli.errorOut(errors.New("jobs were not imported, see the errors above"))
+	}
+	if report.HasStartErrors() {
+		return cli.errorOut(errors.New("jobs were imported, but some failed to start, see the errors above"))
+	}
+	return nil
+}
@@ -2,7 +2,10 @@ package cmd_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -83,6 +87,51 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestPipelineRunReplayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer   = bytes.NewBufferString("")
+		r        = cmd.RendererTable{Writer: buffer}
+		output   = "4200"
+		recorded = `"4300"`
+		replayed = `"4200"`
+	)
+
+	p := cmd.PipelineRunReplayPresenter{
+		PipelineRunReplayResource: presenters.PipelineRunReplayResource{
+			JAID:            presenters.NewJAID("42"),
+			Diverged:        true,
+			OutputsDiverged: true,
+			Outputs:         []*string{&output},
+			Divergences: []presenters.PipelineTaskRunDivergenceResource{{
+				DotID:          "ds1_multiply",
+				Type:           pipeline.TaskTypeMultiply,
+				RecordedOutput: &recorded,
+				ReplayedOutput: &replayed,
+			}},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	out := buffer.String()
+	assert.Contains(t, out, "42")
+	assert.Contains(t, out, "true")
+	assert.Contains(t, out, output)
+	assert.Contains(t, out, "ds1_multiply")
+	assert.Contains(t, out, "multiply")
+	assert.Contains(t, out, recorded)
+	assert.Contains(t, out, replayed)
+
+	// Divergences are only rendered if there are any
+	buffer.Reset()
+	p.Diverged = false
+	p.OutputsDiverged = false
+	p.Divergences = nil
+	require.NoError(t, p.RenderTable(r))
+	assert.NotContains(t, buffer.String(), "ds1_multiply")
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -400,6 +449,93 @@ func TestClient_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestJobImportPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.JobImportPresenter{
+		JobImportResource: presenters.JobImportResource{
+			JAID:   presenters.NewJAID("dryRun"),
+			DryRun: true,
+			Items: []presenters.JobImportItemResource{
+				{Type: presenters.JobImportItemBridge, Name: "fetcher", Action: presenters.JobImportActionCreate, IncomingToken: "abc123"},
+				{Type: presenters.JobImportItemJob, Name: "price feed", Error: "no such chain"},
+				{Type: presenters.JobImportItemJob, Name: "price feed 2", Action: presenters.JobImportActionCreate, StartError: "timeout"},
+			},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	out := buffer.String()
+	assert.Contains(t, out, "fetcher")
+	assert.Contains(t, out, presenters.JobImportActionCreate)
+	assert.Contains(t, out, "abc123")
+	assert.Contains(t, out, "price feed")
+	assert.Contains(t, out, "no such chain")
+	assert.Contains(t, out, "failed to start: timeout")
+}
+
+func TestClient_ExportImportJobs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply the output file
+	set := flag.NewFlagSet("test", 0)
+	require.Error(t, client.ExportJobs(cli.NewContext(nil, set, nil)))
+
+	bundlePath := filepath.Join(t.TempDir(), "jobs.json")
+	set = flag.NewFlagSet("test", 0)
+	set.String("output", bundlePath, "")
+	require.NoError(t, client.ExportJobs(cli.NewContext(nil, set, nil)))
+
+	bundleJSON, err := os.ReadFile(bundlePath)
+	require.NoError(t, err)
+	var bundle job.Bundle
+	require.NoError(t, json.Unmarshal(bundleJSON, &bundle))
+	require.Len(t, bundle.Jobs, 1)
+	assert.Equal(t, created.Name, bundle.Jobs[0].Name)
+
+	// The job already exists, so a dry run reports an error
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("dry-run", true, "")
+	set.Parse([]string{bundlePath})
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	report := *r.Renders[len(r.Renders)-1].(*cmd.JobImportPresenter)
+	assert.True(t, report.DryRun)
+	assert.False(t, report.Imported)
+	assert.True(t, report.HasErrors())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.DeleteJob(cli.NewContext(nil, set, nil)))
+	requireJobsCount(t, app.JobORM(), 0)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{bundlePath})
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	report = *r.Renders[len(r.Renders)-1].(*cmd.JobImportPresenter)
+	assert.True(t, report.Imported)
+	assert.False(t, report.HasErrors())
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.FindJobs(0, 1000)
 	require.NoError(t, err)
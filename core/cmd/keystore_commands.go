@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+// RotateKeystorePassword re-encrypts the node's keystore under a new
+// password. Both keystore passwords are read from files, so that they do not
+// end up in the shell history, and the password of the user is prompted for.
+func (cli *Client) RotateKeystorePassword(c *cli.Context) (err error) {
+	oldPasswordFile := c.String("oldpassword")
+	if len(oldPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --oldpassword flag"))
+	}
+	newPasswordFile := c.String("newpassword")
+	if len(newPasswordFile) == 0 {
+		return cli.errorOut(errors.New("Must specify --newpassword flag"))
+	}
+	oldPassword, err := passwordFromFile(oldPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read old password file"))
+	}
+	newPassword, err := passwordFromFile(newPasswordFile)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read new password file"))
+	}
+
+	fmt.Println("Enter your chainlink account password to confirm.")
+	request, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		Password:    cli.PasswordPrompter.Prompt(),
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/rotate_password", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. The node, and any other node sharing its database, must now be started with the new password.")
+	case http.StatusConflict:
+		return cli.errorOut(errors.New("Old password did not match the keystore password"))
+	case http.StatusUnauthorized:
+		return cli.errorOut(errors.New("Incorrect account password, or not logged in with a session"))
+	default:
+		_, err = cli.parseResponse(resp)
+		return cli.errorOut(err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+func TestClient_RotateKeystorePassword(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+
+	// Missing flags
+	set := flag.NewFlagSet("test rotate password", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	c := cli.NewContext(nil, set, nil)
+	require.Error(t, client.RotateKeystorePassword(c))
+
+	// Wrong old password
+	set = flag.NewFlagSet("test rotate password", 0)
+	set.String("oldpassword", "../internal/fixtures/new_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.RotateKeystorePassword(c))
+
+	set = flag.NewFlagSet("test rotate password", 0)
+	set.String("oldpassword", "../internal/fixtures/correct_password.txt", "")
+	set.String("newpassword", "../internal/fixtures/incorrect_password.txt", "")
+	c = cli.NewContext(nil, set, nil)
+
+	// Wrong account password
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: "wrong"}
+	require.Error(t, client.RotateKeystorePassword(c))
+
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+	require.NoError(t, client.RotateKeystorePassword(c))
+
+	// The old password no longer matches
+	require.Error(t, client.RotateKeystorePassword(c))
+}
@@ -33,6 +33,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
@@ -77,7 +80,24 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		lggr.Warn("Chainlink is running in DEVELOPMENT mode. This is a security risk if enabled in production.")
 	}
 
-	ldb := pg.NewLockedDB(cli.Config, lggr)
+	// The alert notifier is started first so that it is closed last: alerts
+	// can be raised until the DB locks are released
+	var notifier alerts.Notifier = alerts.NullNotifier{}
+	if len(cli.Config.AlertWebhookURLs()) > 0 {
+		webhookNotifier, err2 := alerts.NewWebhookNotifier(cli.Config, cli.Logger)
+		if err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "creating alert notifier"))
+		}
+		if err2 = webhookNotifier.Start(context.Background()); err2 != nil {
+			return cli.errorOut(errors.Wrap(err2, "starting alert notifier"))
+		}
+		defer lggr.ErrorIfClosing(webhookNotifier, "alert notifier")
+		notifier = webhookNotifier
+	} else {
+		lggr.Info("Alerts are disabled, set ALERT_WEBHOOK_URLS to enable them")
+	}
+
+	ldb := pg.NewLockedDB(cli.Config, lggr, notifier)
 
 	// rootCtx will be cancelled when SIGINT|SIGTERM is received
 	rootCtx, cancelRootCtx := context.WithCancel(context.Background())
@@ -117,8 +137,15 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		os.Exit(-1)
 	})
 
-	// Try opening DB connection and acquiring DB locks at once
-	if err = ldb.Open(rootCtx); err != nil {
+	standby := cli.Config.StandbyEnabled()
+	if standby {
+		// A standby only connects to DB, it must not write to it before acquiring DB locks
+		err = ldb.OpenStandby()
+	} else {
+		// Try opening DB connection and acquiring DB locks at once
+		err = ldb.Open(rootCtx)
+	}
+	if err != nil {
 		// If not successful, we know neither locks nor connection remains opened
 		return cli.errorOut(errors.Wrap(err, "opening db"))
 	}
@@ -127,18 +154,153 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	// From now on, DB locks and DB connection will be released on every return.
 	// Keep watching on logger.Fatal* calls and os.Exit(), because defer will not be executed.
 
-	app, err := cli.AppFactory.NewApplication(cli.Config, ldb.DB())
-	if err != nil {
-		return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
+	var app chainlink.Application
+	var prepareDB func() error
+	if standby {
+		app, prepareDB, err = cli.newStandbyApplication(ldb.DB(), notifier)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "fatal error instantiating standby application"))
+		}
+		if app == nil {
+			lggr.Info("Waiting for DB locks as a cold standby...")
+			if err = ldb.Lock(rootCtx); err != nil {
+				return cli.errorOut(errors.Wrap(err, "acquiring db locks"))
+			}
+			standby = false
+		}
+	}
+	if app == nil {
+		app, err = cli.AppFactory.NewApplication(cli.Config, ldb.DB(), notifier)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
+		}
 	}
 
-	sessionORM := app.SessionORM()
 	keyStore := app.GetKeyStore()
 	err = cli.KeyStoreAuthenticator.authenticate(c, keyStore)
 	if err != nil {
 		return errors.Wrap(err, "error authenticating keystore")
 	}
 
+	grp, grpCtx := errgroup.WithContext(rootCtx)
+	startApp := func() {
+		grp.Go(func() error {
+			<-grpCtx.Done()
+			if errInternal := app.Stop(); errInternal != nil {
+				return errors.Wrap(errInternal, "error stopping app")
+			}
+			return nil
+		})
+
+		grp.Go(func() error {
+			errInternal := cli.Runner.Run(grpCtx, app)
+			if errors.Is(errInternal, http.ErrServerClosed) {
+				errInternal = nil
+			}
+			// In tests we have custom runners that stop the app gracefully,
+			// therefore we need to cancel rootCtx when the Runner has quit.
+			cancelRootCtx()
+			return errInternal
+		})
+	}
+
+	startCtx := rootCtx
+	var promotionStartTime time.Time
+	var promotionTimer *time.Timer
+	if standby {
+		if err = app.StartStandby(rootCtx); err != nil {
+			return errors.Wrap(err, "error starting standby")
+		}
+		// Serve the health checks while on standby
+		startApp()
+
+		lggr.Infow(fmt.Sprintf("Chainlink standby booted in %.2fs, waiting for DB locks...", time.Since(static.InitTime).Seconds()), "appID", app.ID())
+		if err = ldb.Lock(grpCtx); err != nil {
+			if grpCtx.Err() != nil {
+				// Shutting down before being promoted
+				return grp.Wait()
+			}
+			return errors.Wrap(err, "error acquiring db locks")
+		}
+
+		lggr.Info("Acquired DB locks, promoting standby to leader...")
+		promotionStartTime = time.Now()
+		// Abort promotion when it takes too long. The services may keep using
+		// startCtx after starting, so it is only cancelled on timeout.
+		var cancelPromotion context.CancelFunc
+		startCtx, cancelPromotion = context.WithCancel(grpCtx)
+		defer cancelPromotion()
+		promotionTimer = time.AfterFunc(cli.Config.StandbyPromotionTimeout(), cancelPromotion)
+
+		if err = prepareDB(); err != nil {
+			return errors.Wrap(err, "error preparing db for promotion")
+		}
+		// The leader may have changed the keys since the keystore was unlocked
+		if err = keyStore.Reload(); err != nil {
+			return errors.Wrap(err, "error reloading keystore for promotion")
+		}
+	}
+
+	if err = cli.ensureKeysAndUser(c, app); err != nil {
+		return err
+	}
+
+	if err = app.Start(startCtx); err != nil {
+		// We do not try stopping any sub-services that might be started,
+		// because the app will exit immediately upon return.
+		// But LockedDB will be released by defer in above.
+		return errors.Wrap(err, "error starting app")
+	}
+
+	if standby {
+		if !promotionTimer.Stop() {
+			// Exit, so that another standby can take over
+			return errors.Errorf("standby promotion exceeded STANDBY_PROMOTION_TIMEOUT of %s", cli.Config.StandbyPromotionTimeout())
+		}
+		lggr.Infof("Standby promoted to leader in %s", time.Since(promotionStartTime))
+	} else {
+		startApp()
+	}
+
+	lggr.Debug("Environment variables\n", config.NewConfigPrinter(cli.Config))
+
+	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
+
+	return grp.Wait()
+}
+
+// newStandbyApplication returns the Application of a warm standby node, or nil
+// if the node can only wait for DB locks as a cold standby.
+func (cli *Client) newStandbyApplication(db *sqlx.DB, notifier alerts.Notifier) (chainlink.Application, func() error, error) {
+	factory, ok := cli.AppFactory.(StandbyAppFactory)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	// The standby must not migrate the DB under the leader's feet, nor run on an outdated schema
+	version, err := migrate.Version(db.DB)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get db version")
+	}
+	latest, err := migrate.Latest()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get latest migration")
+	}
+	if version != latest {
+		cli.Logger.Warnf("Database is at migration %d while this node expects migration %d, cannot stay warm on standby", version, latest)
+		return nil, nil, nil
+	}
+
+	return factory.NewStandbyApplication(cli.Config, db, notifier)
+}
+
+// ensureKeysAndUser migrates the keystore, creates the keys required by the
+// enabled features and the API user. This writes to DB.
+func (cli *Client) ensureKeysAndUser(c *clipkg.Context, app chainlink.Application) error {
+	lggr := cli.Logger.Named("RunNode")
+	sessionORM := app.SessionORM()
+	keyStore := app.GetKeyStore()
+
 	var vrfpwd string
 	var fileErr error
 	if len(c.String("vrfpassword")) != 0 {
@@ -161,7 +323,7 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		}
 		return def.ID(), nil
 	}
-	err = keyStore.Migrate(vrfpwd, DefaultEVMChainIDFunc)
+	err := keyStore.Migrate(vrfpwd, DefaultEVMChainIDFunc)
 
 	if cli.Config.EVMEnabled() {
 		if err != nil {
@@ -228,40 +390,7 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	}
 
 	lggr.Info("API exposed for user ", user.Email)
-
-	if err = app.Start(rootCtx); err != nil {
-		// We do not try stopping any sub-services that might be started,
-		// because the app will exit immediately upon return.
-		// But LockedDB will be released by defer in above.
-		return errors.Wrap(err, "error starting app")
-	}
-
-	grp, grpCtx := errgroup.WithContext(rootCtx)
-
-	grp.Go(func() error {
-		<-grpCtx.Done()
-		if errInternal := app.Stop(); errInternal != nil {
-			return errors.Wrap(errInternal, "error stopping app")
-		}
-		return nil
-	})
-
-	lggr.Debug("Environment variables\n", config.NewConfigPrinter(cli.Config))
-
-	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
-
-	grp.Go(func() error {
-		errInternal := cli.Runner.Run(grpCtx, app)
-		if errors.Is(errInternal, http.ErrServerClosed) {
-			errInternal = nil
-		}
-		// In tests we have custom runners that stop the app gracefully,
-		// therefore we need to cancel rootCtx when the Runner has quit.
-		cancelRootCtx()
-		return errInternal
-	})
-
-	return grp.Wait()
+	return nil
 }
 
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
@@ -363,7 +492,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	}
 	defer lggr.ErrorIfClosing(db, "db")
 
-	app, err := cli.AppFactory.NewApplication(cli.Config, db)
+	app, err := cli.AppFactory.NewApplication(cli.Config, db, alerts.NullNotifier{})
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
 	}
@@ -400,7 +529,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	if err != nil {
 		return cli.errorOut(err)
 	}
-	ec := txmgr.NewEthConfirmer(app.GetSqlxDB(), ethClient, chain.Config(), keyStore.Eth(), keyStates, nil, nil, chain.Logger())
+	ec := txmgr.NewEthConfirmer(app.GetSqlxDB(), ethClient, chain.Config(), keyStore.Eth(), keyStates, nil, nil, chain.Logger(), alerts.NullNotifier{})
 	err = ec.ForceRebroadcast(beginningNonce, endingNonce, gasPriceWei, address, overrideGasLimit)
 	return cli.errorOut(err)
 }
@@ -410,21 +539,9 @@ type HealthCheckPresenter struct {
 }
 
 func (p *HealthCheckPresenter) ToRow() []string {
-	red := color.New(color.FgRed).SprintFunc()
-	green := color.New(color.FgGreen).SprintFunc()
-
-	var status string
-
-	switch p.Status {
-	case services.StatusFailing:
-		status = red(p.Status)
-	case services.StatusPassing:
-		status = green(p.Status)
-	}
-
 	return []string{
 		p.Name,
-		status,
+		colorStatus(p.Status),
 		p.Output,
 	}
 }
@@ -460,6 +577,105 @@ func (cli *Client) Status(c *clipkg.Context) error {
 	return cli.renderAPIResponse(resp, &HealthCheckPresenters{})
 }
 
+// HealthReportPresenter renders the health of the node's chains, jobs and
+// external dependencies.
+type HealthReportPresenter struct {
+	webPresenters.HealthReportResource
+}
+
+func colorStatus(s services.Status) string {
+	switch s {
+	case services.StatusFailing:
+		return color.New(color.FgRed).Sprint(s)
+	case services.StatusPassing:
+		return color.New(color.FgGreen).Sprint(s)
+	}
+	return string(s)
+}
+
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.String()
+}
+
+// RenderTable implements TableRenderer
+func (p *HealthReportPresenter) RenderTable(rt RendererTable) error {
+	chains := rt.newTable([]string{"EVM Chain ID", "Status", "Head", "Head Age", "Live Nodes", "Log Broadcaster", "Unstarted Txs", "Unconfirmed Txs", "Output"})
+	for _, h := range p.Chains {
+		var head, headAge string
+		if h.HeadNumber.Valid {
+			head = strconv.FormatInt(h.HeadNumber.Int64, 10)
+		}
+		if h.HeadAge != nil {
+			headAge = h.HeadAge.String()
+		}
+		broadcaster := "connected"
+		if !h.LogBroadcasterConnected {
+			broadcaster = "disconnected"
+		}
+		chains.Append([]string{
+			h.EVMChainID,
+			colorStatus(h.Status),
+			head,
+			headAge,
+			fmt.Sprintf("%d/%d", h.LiveNodeCount, h.NodeCount),
+			broadcaster,
+			strconv.FormatInt(h.UnstartedTxCount, 10),
+			strconv.FormatInt(h.UnconfirmedTxCount, 10),
+			h.Output,
+		})
+	}
+	render("Chains", chains)
+
+	jobs := rt.newTable([]string{"ID", "Name", "Type", "Status", "Last Run", "Last Run State", "Last Error", "Last Error At"})
+	for _, h := range p.Jobs {
+		jobs.Append([]string{
+			strconv.Itoa(int(h.ID)),
+			h.Name.ValueOrZero(),
+			h.Type,
+			colorStatus(h.Status),
+			formatNullTime(h.LastRunCreatedAt),
+			h.LastRunState.ValueOrZero(),
+			h.LastError.ValueOrZero(),
+			formatNullTime(h.LastErrorAt),
+		})
+	}
+	render("Jobs", jobs)
+
+	deps := rt.newTable([]string{"Type", "Name", "Status", "Output"})
+	for _, h := range p.Dependencies {
+		deps.Append([]string{
+			string(h.Type),
+			h.Name,
+			colorStatus(h.Status),
+			h.Output,
+		})
+	}
+	render("Dependencies", deps)
+
+	fmt.Println("Role:", p.Role)
+	fmt.Println("Status:", colorStatus(p.Status))
+	return nil
+}
+
+// Health displays the health of the node's chains, jobs and external
+// dependencies
+func (cli *Client) Health(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/health")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &HealthReportPresenter{})
+}
+
 // ResetDatabase drops, creates and migrates the database specified by DATABASE_URL
 // This is useful to setup the database for testing
 func (cli *Client) ResetDatabase(c *clipkg.Context) error {
@@ -612,6 +828,56 @@ func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// RestoreDatabase restores a backup taken by the node into DATABASE_URL,
+// after checking its integrity and that this node can run against it.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("You must specify the path of the backup to restore"))
+	}
+	path := c.Args().First()
+
+	key, err := periodicbackup.EncryptionKey(cli.Config)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	manifest, err := periodicbackup.ValidateBackup(path, key)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "backup cannot be restored"))
+	}
+	dbURL := cli.Config.DatabaseURL()
+	cli.Logger.Infow("Backup is valid",
+		"filePath", path,
+		"nodeVersion", manifest.NodeVersion,
+		"migrationVersion", manifest.MigrationVersion,
+		"mode", manifest.Mode,
+		"createdAt", manifest.CreatedAt,
+		"encrypted", manifest.Encrypted,
+	)
+	fmt.Printf("This will replace the contents of the database at %s with the backup.\n", dbURL.Redacted())
+	if !confirmAction(c) {
+		return nil
+	}
+
+	// Take the database lock, so that no node runs against the database while
+	// it is restored. A running node holds it, so this times out.
+	if cli.Config.DatabaseLockingMode() == "none" {
+		return cli.errorOut(errors.New("DATABASE_LOCKING_MODE is none; set it to the mode the nodes use, so that the database is locked while it is restored"))
+	}
+	ldb := pg.NewLockedDB(cli.Config, cli.Logger, alerts.NullNotifier{})
+	ctx, cancel := context.WithTimeout(context.Background(), cli.Config.LeaseLockDuration()+cli.Config.LeaseLockRefreshInterval())
+	defer cancel()
+	if err = ldb.Open(ctx); err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to lock the database; stop any node using it before restoring"))
+	}
+	defer cli.Logger.ErrorIfClosing(ldb, "database")
+
+	if err = periodicbackup.Restore(cli.Config, path, manifest, cli.Logger); err != nil {
+		return cli.errorOut(err)
+	}
+	cli.Logger.Infow("Database restored; it will be migrated to the latest version when the node starts", "filePath", path)
+	return nil
+}
+
 // CreateMigration displays the database migration status
 func (cli *Client) CreateMigration(c *clipkg.Context) error {
 	if !c.Args().Present() {
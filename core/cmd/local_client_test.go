@@ -18,11 +18,16 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -30,11 +35,13 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/kylelemons/godebug/diff"
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestClient_RunNodeShowsEnv(t *testing.T) {
@@ -126,6 +133,8 @@ CLIENT_NODE_URL: http://localhost:6688
 DATABASE_BACKUP_FREQUENCY: 1h0m0s
 DATABASE_BACKUP_MODE: none
 DATABASE_BACKUP_ON_VERSION_UPGRADE: true
+DATABASE_BACKUP_RETENTION_AGE: 
+DATABASE_BACKUP_RETENTION_COUNT: 0
 DATABASE_LOCKING_MODE: dual
 ETH_CHAIN_ID: <nil>
 DEFAULT_HTTP_LIMIT: 32768
@@ -141,6 +150,11 @@ FM_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 FEATURE_EXTERNAL_INITIATORS: false
 FEATURE_OFFCHAIN_REPORTING: false
 GAS_ESTIMATOR_MODE: 
+HTTP_CIRCUIT_BREAKER_THRESHOLD: 0
+HTTP_CIRCUIT_BREAKER_TIMEOUT: 30s
+HEALTH_BRIDGE_TIMEOUT: 5s
+HEALTH_HEAD_AGE_THRESHOLD: 5m0s
+HEALTH_TX_BACKLOG_THRESHOLD: 100
 INSECURE_FAST_SCRYPT: false
 JSON_CONSOLE: false
 JOB_PIPELINE_REAPER_INTERVAL: 1h0m0s
@@ -159,6 +173,8 @@ KEEPER_TURN_LOOK_BACK: 1000
 KEEPER_TURN_FLAG_ENABLED: false
 LEASE_LOCK_DURATION: 10s
 LEASE_LOCK_REFRESH_INTERVAL: 1s
+STANDBY_ENABLED: false
+STANDBY_PROMOTION_TIMEOUT: 30s
 FLAGS_CONTRACT_ADDRESS: 
 LINK_CONTRACT_ADDRESS: 
 LOG_FILE_DIR: %[1]s
@@ -168,6 +184,10 @@ LOG_FILE_MAX_SIZE: 5.12gb
 LOG_FILE_MAX_AGE: 0
 LOG_FILE_MAX_BACKUPS: 1
 TRIGGER_FALLBACK_DB_POLL_INTERVAL: 30s
+TRACING_ENABLED: false
+TRACING_COLLECTOR_TARGET: localhost:4318
+TRACING_SAMPLING_RATIO: 1
+ALERT_DEDUPE_INTERVAL: 1h0m0s
 OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT: 
 OCR_DATABASE_TIMEOUT: 
 OCR_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
@@ -322,6 +342,131 @@ func TestClient_RunNode_CreateFundingKeyIfNotExists(t *testing.T) {
 	assert.NotEmpty(t, keyState.ID, "expected a new funding key")
 }
 
+// standbyAppFactory returns App both as the application of a warm standby,
+// and as the application of a leader.
+type standbyAppFactory struct {
+	cltest.InstanceAppFactory
+}
+
+func (f standbyAppFactory) NewStandbyApplication(config.GeneralConfig, *sqlx.DB, alerts.Notifier) (chainlink.Application, func() error, error) {
+	return f.App, func() error { return nil }, nil
+}
+
+func TestClient_RunNode_Standby(t *testing.T) {
+	t.Parallel()
+
+	type standby struct {
+		client         cmd.Client
+		app            *mocks.Application
+		standbyStarted chan struct{}
+		db             *sqlx.DB
+		cfg            *configtest.TestGeneralConfig
+		// leader holds the DB locks
+		leader         pg.LockedDB
+		leaderKeyStore keystore.Master
+	}
+	newStandby := func(t *testing.T, name string) (s standby) {
+		var db *sqlx.DB
+		s.cfg, db = heavyweight.FullTestDB(t, name)
+		s.db = db
+		s.cfg.Overrides.DatabaseLockingMode = null.StringFrom("dual")
+		s.cfg.Overrides.StandbyEnabled = null.BoolFrom(true)
+		lggr := logger.TestLogger(t)
+
+		s.leader = pg.NewLockedDB(s.cfg, lggr, alerts.NullNotifier{})
+		require.NoError(t, s.leader.Open(testutils.Context(t)))
+		s.leaderKeyStore = cltest.NewKeyStore(t, s.leader.DB(), s.cfg)
+
+		// Unlocked by RunNode
+		keyStore := keystore.New(db, utils.FastScryptParams, lggr, s.cfg)
+		s.standbyStarted = make(chan struct{})
+		s.app = new(mocks.Application)
+		s.app.On("SessionORM").Return(sessions.NewORM(db, time.Minute, lggr)).Maybe()
+		s.app.On("GetKeyStore").Return(keyStore)
+		s.app.On("GetChains").Return(chainlink.Chains{EVM: cltest.NewChainSetMockWithOneChain(t, cltest.NewEthClientMock(t), evmtest.NewChainScopedConfig(t, s.cfg))}).Maybe()
+		s.app.On("StartStandby", mock.Anything).Run(func(mock.Arguments) { close(s.standbyStarted) }).Return(nil).Once()
+		s.app.On("Stop").Return(nil)
+		s.app.On("ID").Maybe().Return(uuid.NewV4())
+
+		s.client = cmd.Client{
+			Config:                 s.cfg,
+			AppFactory:             standbyAppFactory{cltest.InstanceAppFactory{App: s.app}},
+			FallbackAPIInitializer: cltest.NewMockAPIInitializer(t),
+			Runner:                 cltest.BlockedRunner{Done: make(chan struct{})},
+			Logger:                 lggr,
+			CloseLogger:            lggr.Sync,
+		}
+		return
+	}
+	runNode := func(client cmd.Client) chan error {
+		set := flag.NewFlagSet("test", 0)
+		set.String("password", "../internal/fixtures/correct_password.txt", "")
+		c := cli.NewContext(nil, set, nil)
+		errs := make(chan error, 1)
+		go func() { errs <- client.RunNode(c) }()
+		return errs
+	}
+	await := func(t *testing.T, ch chan struct{}) {
+		select {
+		case <-ch:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("Timed out")
+		}
+	}
+
+	t.Run("promotes the standby with the keys added by the leader", func(t *testing.T) {
+		s := newStandby(t, "standby_promote")
+		started := make(chan struct{})
+		s.app.On("Start", mock.Anything).Run(func(mock.Arguments) { close(started) }).Return(nil).Once()
+
+		errs := runNode(s.client)
+		await(t, s.standbyStarted)
+
+		// The standby has unlocked its keystore, then the leader adds a key
+		// before going away
+		leaderKey, _ := cltest.MustAddRandomKeyToKeystore(t, s.leaderKeyStore.Eth())
+		require.NoError(t, s.leader.Close())
+
+		await(t, started)
+		s.client.Runner.(cltest.BlockedRunner).Done <- struct{}{}
+		require.NoError(t, <-errs)
+
+		_, err := s.app.GetKeyStore().Eth().Get(leaderKey.ID())
+		require.NoError(t, err)
+		// Saving the keys of the promoted node did not drop the leader's key
+		_, err = cltest.NewKeyStore(t, s.db, s.cfg).Eth().Get(leaderKey.ID())
+		require.NoError(t, err)
+	})
+
+	t.Run("fails promotion if the key ring does not decrypt", func(t *testing.T) {
+		s := newStandby(t, "standby_reload")
+
+		errs := runNode(s.client)
+		await(t, s.standbyStarted)
+
+		require.NoError(t, s.leaderKeyStore.RotatePassword(cltest.Password, "Rotated-K3yst0re-P4SS!", utils.FastScryptParams))
+		require.NoError(t, s.leader.Close())
+
+		err := <-errs
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error reloading keystore for promotion")
+		s.app.AssertNotCalled(t, "Start", mock.Anything)
+	})
+
+	t.Run("shuts down before being promoted", func(t *testing.T) {
+		s := newStandby(t, "standby_shutdown")
+		t.Cleanup(func() { assert.NoError(t, s.leader.Close()) })
+
+		errs := runNode(s.client)
+		await(t, s.standbyStarted)
+
+		s.client.Runner.(cltest.BlockedRunner).Done <- struct{}{}
+		require.NoError(t, <-errs)
+		s.app.AssertNotCalled(t, "Start", mock.Anything)
+		s.app.AssertCalled(t, "Stop")
+	})
+}
+
 func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
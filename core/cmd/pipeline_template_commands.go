@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type PipelineTemplatePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineTemplateResource
+}
+
+var pipelineTemplateHeaders = []string{"Name", "Version", "Description", "Parameters", "Created At"}
+
+// ToRow presents the PipelineTemplateResource as a slice of strings.
+func (p *PipelineTemplatePresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		strconv.Itoa(int(p.Version)),
+		p.Description,
+		strings.Join(p.Parameters, "\n"),
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineTemplatePresenter) RenderTable(rt RendererTable) error {
+	renderList(pipelineTemplateHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	table := rt.newTable([]string{"Observation Source"})
+	table.Append([]string{strings.TrimSpace(p.DotDagSource)})
+	render("Pipeline Template", table)
+	return nil
+}
+
+type PipelineTemplatePresenters []PipelineTemplatePresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineTemplatePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(pipelineTemplateHeaders, rows, rt.Writer)
+	return nil
+}
+
+// IndexPipelineTemplates lists the latest version of every pipeline template.
+func (cli *Client) IndexPipelineTemplates(c *cli.Context) (err error) {
+	return cli.getAndRender("/v2/pipeline_templates", &PipelineTemplatePresenters{})
+}
+
+// ShowPipelineTemplate shows the latest version of a pipeline template, or
+// the version given by --version.
+func (cli *Client) ShowPipelineTemplate(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the pipeline template"))
+	}
+
+	query := url.Values{}
+	if c.IsSet("version") {
+		query.Set("version", strconv.Itoa(c.Int("version")))
+	}
+
+	return cli.getAndRender(fmt.Sprintf("/v2/pipeline_templates/%s?%s", url.PathEscape(c.Args().First()), query.Encode()), &PipelineTemplatePresenter{})
+}
+
+// ListPipelineTemplateVersions lists every version of a pipeline template.
+func (cli *Client) ListPipelineTemplateVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the pipeline template"))
+	}
+
+	return cli.getAndRender(fmt.Sprintf("/v2/pipeline_templates/%s/versions", url.PathEscape(c.Args().First())), &PipelineTemplatePresenters{})
+}
+
+// CreatePipelineTemplate creates a pipeline template from a TOML spec, or a
+// new version of it if a template with the same name already exists.
+func (cli *Client) CreatePipelineTemplate(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreatePipelineTemplateRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/pipeline_templates", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplatePresenter{}, "Pipeline template created")
+}
+
+// DeletePipelineTemplate deletes every version of a pipeline template.
+func (cli *Client) DeletePipelineTemplate(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the pipeline template"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/pipeline_templates/" + url.PathEscape(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Pipeline template %v deleted\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,105 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestPipelineTemplatePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.PipelineTemplatePresenter{
+		PipelineTemplateResource: presenters.PipelineTemplateResource{
+			Name:         "fetch",
+			Version:      3,
+			Description:  "Fetches a price",
+			Parameters:   []string{"symbol"},
+			DotDagSource: `ds [type=memo value="$(symbol)"]`,
+			CreatedAt:    time.Now(),
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "fetch")
+	assert.Contains(t, output, "Fetches a price")
+	assert.Contains(t, output, "symbol")
+	// Long lines of the observation source are wrapped
+	assert.Contains(t, output, `ds [type=memo`)
+	assert.Contains(t, output, `value="$(symbol)"]`)
+}
+
+func TestClient_PipelineTemplates(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	for _, description := range []string{"v1", "v2"} {
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse([]string{`
+name = "fetch"
+description = "` + description + `"
+parameters = ["symbol"]
+observationSource = """
+ds [type=memo value="$(symbol)"]
+"""
+`}))
+		require.NoError(t, client.CreatePipelineTemplate(cli.NewContext(nil, set, nil)))
+	}
+	require.Len(t, r.Renders, 2)
+	assert.Equal(t, int32(2), r.Renders[1].(*cmd.PipelineTemplatePresenter).Version)
+
+	t.Run("list", func(t *testing.T) {
+		r.Renders = nil
+		require.NoError(t, client.IndexPipelineTemplates(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+		require.Len(t, r.Renders, 1)
+		templates := *r.Renders[0].(*cmd.PipelineTemplatePresenters)
+		require.Len(t, templates, 1)
+		assert.Equal(t, int32(2), templates[0].Version)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		r.Renders = nil
+		set := flag.NewFlagSet("test", 0)
+		set.Int("version", 0, "")
+		require.NoError(t, set.Parse([]string{"--version", "1", "fetch"}))
+		require.NoError(t, client.ShowPipelineTemplate(cli.NewContext(nil, set, nil)))
+		require.Len(t, r.Renders, 1)
+		assert.Equal(t, "v1", r.Renders[0].(*cmd.PipelineTemplatePresenter).Description)
+	})
+
+	t.Run("versions", func(t *testing.T) {
+		r.Renders = nil
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse([]string{"fetch"}))
+		require.NoError(t, client.ListPipelineTemplateVersions(cli.NewContext(nil, set, nil)))
+		require.Len(t, r.Renders, 1)
+		assert.Len(t, *r.Renders[0].(*cmd.PipelineTemplatePresenters), 2)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse([]string{"fetch"}))
+		require.NoError(t, client.DeletePipelineTemplate(cli.NewContext(nil, set, nil)))
+
+		_, err := app.PipelineORM().FindTemplate("fetch", 0)
+		require.Error(t, err)
+		require.Error(t, client.DeletePipelineTemplate(cli.NewContext(nil, set, nil)))
+	})
+}
@@ -132,14 +132,24 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
+	if isTargetedReplay(c) {
+		return cli.ReplayTargeted(c)
+	}
+
 	forceBroadcast := c.Bool("force")
 
+	query := url.Values{}
+	query.Set("force", strconv.FormatBool(forceBroadcast))
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
 	buf := bytes.NewBufferString("{}")
 	resp, err := cli.HTTP.Post(
 		fmt.Sprintf(
-			"/v2/replay_from_block/%v?force=%s",
+			"/v2/replay_from_block/%v?%s",
 			blockNumber,
-			strconv.FormatBool(forceBroadcast),
+			query.Encode(),
 		), buf)
 	if err != nil {
 		return cli.errorOut(err)
@@ -145,6 +145,45 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	assert.NoError(t, client.ReplayFromBlock(c))
 }
 
+func TestClient_ReplayBlocks_Targeted(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t,
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMEnabled = null.BoolFrom(true)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+			c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+		}))
+	client, r := app.NewClientAndRenderer()
+
+	set := flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.Int64("to-block", 50, "")
+	set.Var(&cli.StringSlice{"0x5431F5F973781809D18643b87B44921b11355d81"}, "address", "")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ReplayFromBlock(c))
+
+	require.Len(t, r.Renders, 1)
+	replay := r.Renders[0].(*cmd.ReplayPresenter)
+	assert.Equal(t, int64(42), replay.FromBlock)
+	assert.Equal(t, int64(50), replay.ToBlock)
+	require.Len(t, replay.Addresses, 1)
+	assert.Equal(t, "0x5431F5F973781809D18643b87B44921b11355d81", replay.Addresses[0].Hex())
+
+	set = flag.NewFlagSet("flagset", 0)
+	require.NoError(t, set.Parse([]string{replay.ID}))
+	c = cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowReplay(c))
+	require.Len(t, r.Renders, 2)
+	assert.Equal(t, replay.ID, r.Renders[1].(*cmd.ReplayPresenter).ID)
+
+	set = flag.NewFlagSet("flagset", 0)
+	require.NoError(t, set.Parse([]string{"unknown"}))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowReplay(c))
+}
+
 func TestClient_CreateExternalInitiator(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type SecretPresenter struct {
+	JAID
+	presenters.SecretResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SecretPresenter) RenderTable(rt RendererTable) error {
+	if _, err := rt.Write([]byte("🔒 Secrets\n")); err != nil {
+		return err
+	}
+	renderList([]string{"Name"}, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+func (p *SecretPresenter) ToRow() []string {
+	return []string{p.Name}
+}
+
+type SecretPresenters []SecretPresenter
+
+// RenderTable implements TableRenderer
+func (ps SecretPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("🔒 Secrets\n")); err != nil {
+		return err
+	}
+	renderList([]string{"Name"}, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListSecrets lists the names of the node's secrets
+func (cli *Client) ListSecrets(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/keys/secrets", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenters{})
+}
+
+// SetSecret sets a secret to the contents of a file, so that the value does
+// not end up in the shell history
+func (cli *Client) SetSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret"))
+	}
+	file := c.String("file")
+	if file == "" {
+		return cli.errorOut(errors.New("must specify --file flag"))
+	}
+	value, err := ioutil.ReadFile(file)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "could not read secret file"))
+	}
+
+	request, err := json.Marshal(web.SetSecretRequest{
+		Name:  c.Args().First(),
+		Value: strings.TrimRight(string(value), "\r\n"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/secrets", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Secret set")
+}
+
+// DeleteSecret deletes a secret
+func (cli *Client) DeleteSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/keys/secrets/" + url.PathEscape(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Secret %v deleted\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,61 @@
+package cmd_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+)
+
+func TestClient_Secrets(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, ioutil.WriteFile(secretFile, []byte("s3cr3t\n"), 0600))
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("file", "", "")
+	require.NoError(t, set.Parse([]string{"--file", secretFile, "apiKey"}))
+	require.NoError(t, client.SetSecret(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+	assert.Equal(t, "apiKey", r.Renders[0].(*cmd.SecretPresenter).Name)
+
+	value, err := app.GetKeyStore().Secrets().Get("apiKey")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+
+	t.Run("requires a file", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		set.String("file", "", "")
+		require.NoError(t, set.Parse([]string{"apiKey"}))
+		require.Error(t, client.SetSecret(cli.NewContext(nil, set, nil)))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		r.Renders = nil
+		require.NoError(t, client.ListSecrets(cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)))
+		require.Len(t, r.Renders, 1)
+		secrets := *r.Renders[0].(*cmd.SecretPresenters)
+		require.Len(t, secrets, 1)
+		assert.Equal(t, "apiKey", secrets[0].Name)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse([]string{"apiKey"}))
+		require.NoError(t, client.DeleteSecret(cli.NewContext(nil, set, nil)))
+
+		_, err := app.GetKeyStore().Secrets().Get("apiKey")
+		require.Error(t, err)
+		require.Error(t, client.DeleteSecret(cli.NewContext(nil, set, nil)))
+	})
+}
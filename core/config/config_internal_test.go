@@ -56,6 +56,34 @@ func TestGeneralConfig_GlobalOCRContractTransmitterTransmitTimeout(t *testing.T)
 	require.Equal(t, 3*time.Second, timeout)
 }
 
+func TestGeneralConfig_AlertWebhookURLs(t *testing.T) {
+	t.Setenv(envvar.Name("AlertWebhookURLs"), "https://hooks.example.com/a, http://localhost:9000/b")
+	config := NewGeneralConfig(logger.TestLogger(t)).(*generalConfig)
+
+	urls, err := config.alertWebhookURLs()
+	require.NoError(t, err)
+	require.Len(t, urls, 2)
+	assert.Equal(t, "https://hooks.example.com/a", urls[0].String())
+	assert.Equal(t, "http://localhost:9000/b", urls[1].String())
+
+	t.Setenv(envvar.Name("AlertWebhookURLs"), "ftp://example.com")
+	_, err = config.alertWebhookURLs()
+	require.EqualError(t, err, `ALERT_WEBHOOK_URLS must be http(s) URLs, got scheme "ftp"`)
+
+	assert.Equal(t, time.Hour, config.AlertDedupeInterval())
+}
+
+func TestGeneralConfig_ValidateStandby(t *testing.T) {
+	t.Setenv(envvar.Name("StandbyEnabled"), "true")
+	t.Setenv(envvar.Name("DatabaseLockingMode"), "none")
+	config := NewGeneralConfig(logger.TestLogger(t))
+	assert.EqualError(t, config.Validate(), "STANDBY_ENABLED requires a DATABASE_LOCKING_MODE other than 'none', otherwise the standby would never wait for the leader")
+
+	t.Setenv(envvar.Name("DatabaseLockingMode"), "lease")
+	t.Setenv(envvar.Name("StandbyPromotionTimeout"), "0s")
+	assert.EqualError(t, config.Validate(), "STANDBY_PROMOTION_TIMEOUT must be greater than 0 (got 0s)")
+}
+
 func TestGeneralConfig_sessionSecret(t *testing.T) {
 	t.Parallel()
 	config := NewGeneralConfig(logger.TestLogger(t))
@@ -20,8 +20,16 @@ var (
 	AutoPprofPollInterval             = NewDuration("AutoPprofPollInterval")
 	AutoPprofGatherDuration           = NewDuration("AutoPprofGatherDuration")
 	AutoPprofGatherTraceDuration      = NewDuration("AutoPprofGatherTraceDuration")
+	AutoPprofContinuousInterval       = NewDuration("AutoPprofContinuousInterval")
 	BlockBackfillDepth                = NewUint64("BlockBackfillDepth")
+	HTTPCircuitBreakerThreshold       = NewUint32("HTTPCircuitBreakerThreshold")
+	HTTPCircuitBreakerTimeout         = NewDuration("HTTPCircuitBreakerTimeout")
 	HTTPServerWriteTimeout            = NewDuration("HTTPServerWriteTimeout")
+	HealthBridgeTimeout               = NewDuration("HealthBridgeTimeout")
+	HealthHeadAgeThreshold            = NewDuration("HealthHeadAgeThreshold")
+	HealthTxBacklogThreshold          = NewUint32("HealthTxBacklogThreshold")
+	StandbyEnabled                    = NewBool("StandbyEnabled")
+	StandbyPromotionTimeout           = NewDuration("StandbyPromotionTimeout")
 	JobPipelineMaxRunDuration         = NewDuration("JobPipelineMaxRunDuration")
 	JobPipelineResultWriteQueueDepth  = NewUint64("JobPipelineResultWriteQueueDepth")
 	JobPipelineReaperInterval         = NewDuration("JobPipelineReaperInterval")
@@ -72,11 +72,17 @@ type ConfigSchema struct {
 	DatabaseLockingMode       string        `env:"DATABASE_LOCKING_MODE" default:"dual"`
 	LeaseLockDuration         time.Duration `env:"LEASE_LOCK_DURATION" default:"10s"`
 	LeaseLockRefreshInterval  time.Duration `env:"LEASE_LOCK_REFRESH_INTERVAL" default:"1s"`
+	// Database Standby
+	StandbyEnabled          bool          `env:"STANDBY_ENABLED" default:"false"`
+	StandbyPromotionTimeout time.Duration `env:"STANDBY_PROMOTION_TIMEOUT" default:"30s"`
 	// Database Autobackups
 	DatabaseBackupDir              string        `env:"DATABASE_BACKUP_DIR"`
+	DatabaseBackupEncryptionKey    string        `env:"DATABASE_BACKUP_ENCRYPTION_KEY"`
 	DatabaseBackupFrequency        time.Duration `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode             string        `env:"DATABASE_BACKUP_MODE" default:"none"`
 	DatabaseBackupOnVersionUpgrade bool          `env:"DATABASE_BACKUP_ON_VERSION_UPGRADE" default:"true"`
+	DatabaseBackupRetentionAge     time.Duration `env:"DATABASE_BACKUP_RETENTION_AGE" default:"0s"`
+	DatabaseBackupRetentionCount   uint32        `env:"DATABASE_BACKUP_RETENTION_COUNT" default:"0"`
 	DatabaseBackupURL              *url.URL      `env:"DATABASE_BACKUP_URL"`
 
 	// Logging
@@ -113,8 +119,9 @@ type ConfigSchema struct {
 	TLSRedirect bool   `env:"CHAINLINK_TLS_REDIRECT" default:"false"`
 
 	// Feeds manager
-	FeatureFeedsManager bool `env:"FEATURE_FEEDS_MANAGER" default:"false"` //nodoc
-	FeatureUICSAKeys    bool `env:"FEATURE_UI_CSA_KEYS" default:"false"`   //nodoc
+	FeatureFeedsManager              bool          `env:"FEATURE_FEEDS_MANAGER" default:"false"`             //nodoc
+	FeatureUICSAKeys                 bool          `env:"FEATURE_UI_CSA_KEYS" default:"false"`               //nodoc
+	FeedsManagerHealthReportInterval time.Duration `env:"FEEDS_MANAGER_HEALTH_REPORT_INTERVAL" default:"1m"` //nodoc
 
 	// LogPoller
 	FeatureLogPoller bool `env:"FEATURE_LOG_POLLER" default:"false"` //nodoc
@@ -187,10 +194,20 @@ type ConfigSchema struct {
 	EvmMaxQueuedTransactions   uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmNonceAutoSync           bool   `env:"ETH_NONCE_AUTO_SYNC"`
 	EvmUseForwarders           bool   `env:"ETH_USE_FORWARDERS"`
+	// Sending key top-ups
+	EvmTopUpEnabled       bool     `env:"ETH_TOP_UP_ENABLED"`
+	EvmTopUpThresholdWei  *big.Int `env:"ETH_TOP_UP_THRESHOLD_WEI"`
+	EvmTopUpTargetWei     *big.Int `env:"ETH_TOP_UP_TARGET_WEI"`
+	EvmTopUpDailyLimitWei *big.Int `env:"ETH_TOP_UP_DAILY_LIMIT_WEI"`
+	// Alerts
+	EvmBalanceAlertThresholdWei *big.Int `env:"ETH_BALANCE_ALERT_THRESHOLD_WEI"`
+	EvmTxStuckAlertBlocks       uint32   `env:"ETH_TX_STUCK_ALERT_BLOCKS"`
 
 	// Job Pipeline and tasks
 	DefaultHTTPLimit                 int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
 	DefaultHTTPTimeout               models.Duration `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
+	HTTPCircuitBreakerThreshold      uint32          `env:"HTTP_CIRCUIT_BREAKER_THRESHOLD" default:"0"`
+	HTTPCircuitBreakerTimeout        time.Duration   `env:"HTTP_CIRCUIT_BREAKER_TIMEOUT" default:"30s"`
 	FeatureExternalInitiators        bool            `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
 	JobPipelineMaxRunDuration        time.Duration   `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
 	JobPipelineReaperInterval        time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
@@ -283,18 +300,38 @@ type ConfigSchema struct {
 	InsecureSkipVerify   bool   `env:"INSECURE_SKIP_VERIFY" default:"false"`
 
 	// Debugging
-	AutoPprofEnabled              bool            `env:"AUTO_PPROF_ENABLED" default:"false"`            //nodoc
-	AutoPprofProfileRoot          string          `env:"AUTO_PPROF_PROFILE_ROOT"`                       //nodoc (defaults to $CHAINLINK_ROOT)
-	AutoPprofPollInterval         models.Duration `env:"AUTO_PPROF_POLL_INTERVAL" default:"10s"`        //nodoc
-	AutoPprofGatherDuration       models.Duration `env:"AUTO_PPROF_GATHER_DURATION" default:"10s"`      //nodoc
-	AutoPprofGatherTraceDuration  models.Duration `env:"AUTO_PPROF_GATHER_TRACE_DURATION" default:"5s"` //nodoc
-	AutoPprofMaxProfileSize       utils.FileSize  `env:"AUTO_PPROF_MAX_PROFILE_SIZE" default:"100mb"`   //nodoc
-	AutoPprofCPUProfileRate       int             `env:"AUTO_PPROF_CPU_PROFILE_RATE" default:"1"`       //nodoc
-	AutoPprofMemProfileRate       int             `env:"AUTO_PPROF_MEM_PROFILE_RATE" default:"1"`       //nodoc
-	AutoPprofBlockProfileRate     int             `env:"AUTO_PPROF_BLOCK_PROFILE_RATE" default:"1"`     //nodoc
-	AutoPprofMutexProfileFraction int             `env:"AUTO_PPROF_MUTEX_PROFILE_FRACTION" default:"1"` //nodoc
-	AutoPprofMemThreshold         utils.FileSize  `env:"AUTO_PPROF_MEM_THRESHOLD" default:"4gb"`        //nodoc
-	AutoPprofGoroutineThreshold   int             `env:"AUTO_PPROF_GOROUTINE_THRESHOLD" default:"5000"` //nodoc
+	AutoPprofEnabled                        bool            `env:"AUTO_PPROF_ENABLED" default:"false"`                         //nodoc
+	AutoPprofProfileRoot                    string          `env:"AUTO_PPROF_PROFILE_ROOT"`                                    //nodoc (defaults to $CHAINLINK_ROOT)
+	AutoPprofPollInterval                   models.Duration `env:"AUTO_PPROF_POLL_INTERVAL" default:"10s"`                     //nodoc
+	AutoPprofGatherDuration                 models.Duration `env:"AUTO_PPROF_GATHER_DURATION" default:"10s"`                   //nodoc
+	AutoPprofGatherTraceDuration            models.Duration `env:"AUTO_PPROF_GATHER_TRACE_DURATION" default:"5s"`              //nodoc
+	AutoPprofMaxProfileSize                 utils.FileSize  `env:"AUTO_PPROF_MAX_PROFILE_SIZE" default:"100mb"`                //nodoc
+	AutoPprofCPUProfileRate                 int             `env:"AUTO_PPROF_CPU_PROFILE_RATE" default:"1"`                    //nodoc
+	AutoPprofMemProfileRate                 int             `env:"AUTO_PPROF_MEM_PROFILE_RATE" default:"1"`                    //nodoc
+	AutoPprofBlockProfileRate               int             `env:"AUTO_PPROF_BLOCK_PROFILE_RATE" default:"1"`                  //nodoc
+	AutoPprofMutexProfileFraction           int             `env:"AUTO_PPROF_MUTEX_PROFILE_FRACTION" default:"1"`              //nodoc
+	AutoPprofMemThreshold                   utils.FileSize  `env:"AUTO_PPROF_MEM_THRESHOLD" default:"4gb"`                     //nodoc
+	AutoPprofGoroutineThreshold             int             `env:"AUTO_PPROF_GOROUTINE_THRESHOLD" default:"5000"`              //nodoc
+	AutoPprofContinuousEnabled              bool            `env:"AUTO_PPROF_CONTINUOUS_ENABLED" default:"false"`              //nodoc
+	AutoPprofContinuousInterval             models.Duration `env:"AUTO_PPROF_CONTINUOUS_INTERVAL" default:"1m"`                //nodoc
+	AutoPprofContinuousRetention            int             `env:"AUTO_PPROF_CONTINUOUS_RETENTION" default:"60"`               //nodoc
+	AutoPprofContinuousBlockProfileRate     int             `env:"AUTO_PPROF_CONTINUOUS_BLOCK_PROFILE_RATE" default:"10000"`   //nodoc
+	AutoPprofContinuousMutexProfileFraction int             `env:"AUTO_PPROF_CONTINUOUS_MUTEX_PROFILE_FRACTION" default:"100"` //nodoc
+
+	// Tracing
+	TracingEnabled         bool    `env:"TRACING_ENABLED" default:"false"`
+	TracingCollectorTarget string  `env:"TRACING_COLLECTOR_TARGET" default:"localhost:4318"`
+	TracingSamplingRatio   float64 `env:"TRACING_SAMPLING_RATIO" default:"1"`
+
+	// Alerts
+	AlertWebhookURLs     string        `env:"ALERT_WEBHOOK_URLS"`
+	AlertWebhookTemplate string        `env:"ALERT_WEBHOOK_TEMPLATE"`
+	AlertDedupeInterval  time.Duration `env:"ALERT_DEDUPE_INTERVAL" default:"1h"`
+
+	// Health
+	HealthBridgeTimeout      time.Duration `env:"HEALTH_BRIDGE_TIMEOUT" default:"5s"`
+	HealthHeadAgeThreshold   time.Duration `env:"HEALTH_HEAD_AGE_THRESHOLD" default:"5m"`
+	HealthTxBacklogThreshold uint32        `env:"HEALTH_TX_BACKLOG_THRESHOLD" default:"100"`
 }
 
 // Name gets the environment variable Name for a config schema field
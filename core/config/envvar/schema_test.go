@@ -14,11 +14,19 @@ func TestConfigSchema(t *testing.T) {
 		"AdminCredentialsFile":                           "ADMIN_CREDENTIALS_FILE",
 		"AdvisoryLockCheckInterval":                      "ADVISORY_LOCK_CHECK_INTERVAL",
 		"AdvisoryLockID":                                 "ADVISORY_LOCK_ID",
+		"AlertDedupeInterval":                            "ALERT_DEDUPE_INTERVAL",
+		"AlertWebhookTemplate":                           "ALERT_WEBHOOK_TEMPLATE",
+		"AlertWebhookURLs":                               "ALERT_WEBHOOK_URLS",
 		"AllowOrigins":                                   "ALLOW_ORIGINS",
 		"AuthenticatedRateLimit":                         "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":                   "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"AutoPprofBlockProfileRate":                      "AUTO_PPROF_BLOCK_PROFILE_RATE",
 		"AutoPprofCPUProfileRate":                        "AUTO_PPROF_CPU_PROFILE_RATE",
+		"AutoPprofContinuousBlockProfileRate":            "AUTO_PPROF_CONTINUOUS_BLOCK_PROFILE_RATE",
+		"AutoPprofContinuousEnabled":                     "AUTO_PPROF_CONTINUOUS_ENABLED",
+		"AutoPprofContinuousInterval":                    "AUTO_PPROF_CONTINUOUS_INTERVAL",
+		"AutoPprofContinuousMutexProfileFraction":        "AUTO_PPROF_CONTINUOUS_MUTEX_PROFILE_FRACTION",
+		"AutoPprofContinuousRetention":                   "AUTO_PPROF_CONTINUOUS_RETENTION",
 		"AutoPprofEnabled":                               "AUTO_PPROF_ENABLED",
 		"AutoPprofGatherDuration":                        "AUTO_PPROF_GATHER_DURATION",
 		"AutoPprofGatherTraceDuration":                   "AUTO_PPROF_GATHER_TRACE_DURATION",
@@ -42,9 +50,12 @@ func TestConfigSchema(t *testing.T) {
 		"ChainType":                                      "CHAIN_TYPE",
 		"ClientNodeURL":                                  "CLIENT_NODE_URL",
 		"DatabaseBackupDir":                              "DATABASE_BACKUP_DIR",
+		"DatabaseBackupEncryptionKey":                    "DATABASE_BACKUP_ENCRYPTION_KEY",
 		"DatabaseBackupFrequency":                        "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                             "DATABASE_BACKUP_MODE",
 		"DatabaseBackupOnVersionUpgrade":                 "DATABASE_BACKUP_ON_VERSION_UPGRADE",
+		"DatabaseBackupRetentionAge":                     "DATABASE_BACKUP_RETENTION_AGE",
+		"DatabaseBackupRetentionCount":                   "DATABASE_BACKUP_RETENTION_COUNT",
 		"DatabaseBackupURL":                              "DATABASE_BACKUP_URL",
 		"DatabaseListenerMaxReconnectDuration":           "DATABASE_LISTENER_MAX_RECONNECT_DURATION",
 		"DatabaseListenerMinReconnectInterval":           "DATABASE_LISTENER_MIN_RECONNECT_INTERVAL",
@@ -90,6 +101,12 @@ func TestConfigSchema(t *testing.T) {
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
 		"EvmRPCDefaultBatchSize":                         "ETH_RPC_DEFAULT_BATCH_SIZE",
+		"EvmTopUpDailyLimitWei":                          "ETH_TOP_UP_DAILY_LIMIT_WEI",
+		"EvmTopUpEnabled":                                "ETH_TOP_UP_ENABLED",
+		"EvmTopUpTargetWei":                              "ETH_TOP_UP_TARGET_WEI",
+		"EvmTopUpThresholdWei":                           "ETH_TOP_UP_THRESHOLD_WEI",
+		"EvmBalanceAlertThresholdWei":                    "ETH_BALANCE_ALERT_THRESHOLD_WEI",
+		"EvmTxStuckAlertBlocks":                          "ETH_TX_STUCK_ALERT_BLOCKS",
 		"ExplorerAccessKey":                              "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                                 "EXPLORER_SECRET",
 		"ExplorerURL":                                    "EXPLORER_URL",
@@ -101,13 +118,19 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureOffchainReporting":                       "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureOffchainReporting2":                      "FEATURE_OFFCHAIN_REPORTING2",
 		"FeatureUICSAKeys":                               "FEATURE_UI_CSA_KEYS",
+		"FeedsManagerHealthReportInterval":               "FEEDS_MANAGER_HEALTH_REPORT_INTERVAL",
 		"FlagsContractAddress":                           "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                               "GAS_ESTIMATOR_MODE",
 		"GasUpdaterBatchSize":                            "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                           "GAS_UPDATER_BLOCK_DELAY",
 		"GasUpdaterBlockHistorySize":                     "GAS_UPDATER_BLOCK_HISTORY_SIZE",
 		"GasUpdaterTransactionPercentile":                "GAS_UPDATER_TRANSACTION_PERCENTILE",
+		"HTTPCircuitBreakerThreshold":                    "HTTP_CIRCUIT_BREAKER_THRESHOLD",
+		"HTTPCircuitBreakerTimeout":                      "HTTP_CIRCUIT_BREAKER_TIMEOUT",
 		"HTTPServerWriteTimeout":                         "HTTP_SERVER_WRITE_TIMEOUT",
+		"HealthBridgeTimeout":                            "HEALTH_BRIDGE_TIMEOUT",
+		"HealthHeadAgeThreshold":                         "HEALTH_HEAD_AGE_THRESHOLD",
+		"HealthTxBacklogThreshold":                       "HEALTH_TX_BACKLOG_THRESHOLD",
 		"InsecureFastScrypt":                             "INSECURE_FAST_SCRYPT",
 		"InsecureSkipVerify":                             "INSECURE_SKIP_VERIFY",
 		"JSONConsole":                                    "JSON_CONSOLE",
@@ -160,6 +183,8 @@ func TestConfigSchema(t *testing.T) {
 		"ShutdownGracePeriod":                            "SHUTDOWN_GRACE_PERIOD",
 		"SolanaEnabled":                                  "SOLANA_ENABLED",
 		"SolanaNodes":                                    "SOLANA_NODES",
+		"StandbyEnabled":                                 "STANDBY_ENABLED",
+		"StandbyPromotionTimeout":                        "STANDBY_PROMOTION_TIMEOUT",
 		"TerraNodes":                                     "TERRA_NODES",
 		"TLSCertPath":                                    "TLS_CERT_PATH",
 		"TLSHost":                                        "CHAINLINK_TLS_HOST",
@@ -176,6 +201,9 @@ func TestConfigSchema(t *testing.T) {
 		"TelemetryIngressURL":                            "TELEMETRY_INGRESS_URL",
 		"TelemetryIngressUseBatchSend":                   "TELEMETRY_INGRESS_USE_BATCH_SEND",
 		"TerraEnabled":                                   "TERRA_ENABLED",
+		"TracingCollectorTarget":                         "TRACING_COLLECTOR_TARGET",
+		"TracingEnabled":                                 "TRACING_ENABLED",
+		"TracingSamplingRatio":                           "TRACING_SAMPLING_RATIO",
 		"TriggerFallbackDBPollInterval":                  "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
 		"UnAuthenticatedRateLimit":                       "UNAUTHENTICATED_RATE_LIMIT",
 		"UnAuthenticatedRateLimitPeriod":                 "UNAUTHENTICATED_RATE_LIMIT_PERIOD",
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -24,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	"github.com/smartcontractkit/chainlink/core/config/parse"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -72,12 +74,20 @@ type GeneralOnlyConfig interface {
 	AdminCredentialsFile() string
 	AdvisoryLockCheckInterval() time.Duration
 	AdvisoryLockID() int64
+	AlertDedupeInterval() time.Duration
+	AlertWebhookTemplate() string
+	AlertWebhookURLs() []url.URL
 	AllowOrigins() string
 	AppID() uuid.UUID
 	AuthenticatedRateLimit() int64
 	AuthenticatedRateLimitPeriod() models.Duration
 	AutoPprofBlockProfileRate() int
 	AutoPprofCPUProfileRate() int
+	AutoPprofContinuousBlockProfileRate() int
+	AutoPprofContinuousEnabled() bool
+	AutoPprofContinuousInterval() models.Duration
+	AutoPprofContinuousMutexProfileFraction() int
+	AutoPprofContinuousRetention() int
 	AutoPprofGatherDuration() models.Duration
 	AutoPprofGatherTraceDuration() models.Duration
 	AutoPprofGoroutineThreshold() int
@@ -93,9 +103,12 @@ type GeneralOnlyConfig interface {
 	CertFile() string
 	ClientNodeURL() string
 	DatabaseBackupDir() string
+	DatabaseBackupEncryptionKey() string
 	DatabaseBackupFrequency() time.Duration
 	DatabaseBackupMode() DatabaseBackupMode
 	DatabaseBackupOnVersionUpgrade() bool
+	DatabaseBackupRetentionAge() time.Duration
+	DatabaseBackupRetentionCount() uint32
 	DatabaseBackupURL() *url.URL
 	DatabaseListenerMaxReconnectDuration() time.Duration
 	DatabaseListenerMinReconnectInterval() time.Duration
@@ -116,9 +129,15 @@ type GeneralOnlyConfig interface {
 	ExplorerURL() *url.URL
 	FMDefaultTransactionQueueDepth() uint32
 	FMSimulateTransactions() bool
+	FeedsManagerHealthReportInterval() time.Duration
 	GetAdvisoryLockIDConfiguredOrDefault() int64
 	GetDatabaseDialectConfiguredOrDefault() dialects.DialectName
+	HTTPCircuitBreakerThreshold() uint32
+	HTTPCircuitBreakerTimeout() time.Duration
 	HTTPServerWriteTimeout() time.Duration
+	HealthBridgeTimeout() time.Duration
+	HealthHeadAgeThreshold() time.Duration
+	HealthTxBacklogThreshold() uint32
 	InsecureFastScrypt() bool
 	InsecureSkipVerify() bool
 	JSONConsole() bool
@@ -160,6 +179,8 @@ type GeneralOnlyConfig interface {
 	SessionSecret() ([]byte, error)
 	SessionTimeout() models.Duration
 	SolanaNodes() string
+	StandbyEnabled() bool
+	StandbyPromotionTimeout() time.Duration
 	TerraNodes() string
 	TLSCertPath() string
 	TLSDir() string
@@ -176,6 +197,9 @@ type GeneralOnlyConfig interface {
 	TelemetryIngressSendInterval() time.Duration
 	TelemetryIngressSendTimeout() time.Duration
 	TelemetryIngressUseBatchSend() bool
+	TracingCollectorTarget() string
+	TracingEnabled() bool
+	TracingSamplingRatio() float64
 	TriggerFallbackDBPollInterval() time.Duration
 	UnAuthenticatedRateLimit() int64
 	UnAuthenticatedRateLimitPeriod() models.Duration
@@ -220,6 +244,12 @@ type GlobalConfig interface {
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
 	GlobalEvmUseForwarders() (bool, bool)
+	GlobalEvmTopUpEnabled() (bool, bool)
+	GlobalEvmTopUpThresholdWei() (*big.Int, bool)
+	GlobalEvmTopUpTargetWei() (*big.Int, bool)
+	GlobalEvmTopUpDailyLimitWei() (*big.Int, bool)
+	GlobalEvmBalanceAlertThresholdWei() (*big.Int, bool)
+	GlobalEvmTxStuckAlertBlocks() (uint32, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
@@ -343,6 +373,23 @@ EVM_ENABLED=false
 `)
 	}
 
+	if key := c.DatabaseBackupEncryptionKey(); key != "" {
+		if b, err := hex.DecodeString(key); err != nil || len(b) != 32 {
+			return errors.New("DATABASE_BACKUP_ENCRYPTION_KEY must be a hex encoded 32 byte key")
+		}
+	}
+
+	if ratio := c.TracingSamplingRatio(); ratio < 0 || ratio > 1 {
+		return errors.Errorf("TRACING_SAMPLING_RATIO must be between 0 and 1, got %v", ratio)
+	}
+
+	if _, err := c.alertWebhookURLs(); err != nil {
+		return err
+	}
+	if _, err := alerts.ParseTemplate(c.AlertWebhookTemplate()); err != nil {
+		return err
+	}
+
 	if _, err := c.OCRKeyBundleID(); errors.Is(errors.Cause(err), ErrInvalid) {
 		return err
 	}
@@ -402,10 +449,31 @@ EVM_ENABLED=false
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
 
+	if c.StandbyEnabled() {
+		if c.DatabaseLockingMode() == "none" {
+			return errors.New("STANDBY_ENABLED requires a DATABASE_LOCKING_MODE other than 'none', otherwise the standby would never wait for the leader")
+		}
+		if c.StandbyPromotionTimeout() <= 0 {
+			return errors.Errorf("STANDBY_PROMOTION_TIMEOUT must be greater than 0 (got %s)", c.StandbyPromotionTimeout())
+		}
+	}
+
 	if c.viper.GetString(envvar.Name("LogFileDir")) != "" && c.LogFileMaxSize() <= 0 {
 		c.lggr.Warn("LOG_FILE_DIR is ignored and has no effect when LOG_FILE_MAX_SIZE is not set to a value greater than zero")
 	}
 
+	if c.AutoPprofContinuousEnabled() {
+		if !c.AutoPprofEnabled() {
+			c.lggr.Warn("AUTO_PPROF_CONTINUOUS_ENABLED is ignored and has no effect when AUTO_PPROF_ENABLED is not set to true")
+		}
+		if c.AutoPprofContinuousInterval().Duration() <= 0 {
+			return errors.Errorf("AUTO_PPROF_CONTINUOUS_INTERVAL must be greater than 0 (got %s)", c.AutoPprofContinuousInterval())
+		}
+		if c.AutoPprofContinuousRetention() < 2 {
+			return errors.Errorf("AUTO_PPROF_CONTINUOUS_RETENTION must be at least 2 to compute diffs (got %d)", c.AutoPprofContinuousRetention())
+		}
+	}
+
 	if !c.Dev() {
 		if err := validateDBURL(c.DatabaseURL()); err != nil {
 			// TODO: Make this a hard error in some future version of Chainlink > 1.4.x
@@ -439,6 +507,47 @@ func (c *generalConfig) GetDatabaseDialectConfiguredOrDefault() dialects.Dialect
 	return c.dialect
 }
 
+// AlertWebhookURLs are the http(s) URLs that alerts about critical node
+// events are POSTed to, separated by commas. Alerts are disabled if empty.
+func (c *generalConfig) AlertWebhookURLs() []url.URL {
+	urls, err := c.alertWebhookURLs()
+	if err != nil {
+		c.lggr.Errorw("Invalid ALERT_WEBHOOK_URLS", "err", err)
+	}
+	return urls
+}
+
+func (c *generalConfig) alertWebhookURLs() ([]url.URL, error) {
+	var urls []url.URL
+	for _, s := range regexp.MustCompile(`\s*[;,]\s*`).Split(c.viper.GetString(envvar.Name("AlertWebhookURLs")), -1) {
+		if s == "" {
+			continue
+		}
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid ALERT_WEBHOOK_URLS")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, errors.Errorf("ALERT_WEBHOOK_URLS must be http(s) URLs, got scheme %q", u.Scheme)
+		}
+		urls = append(urls, *u)
+	}
+	return urls, nil
+}
+
+// AlertWebhookTemplate is a Go text/template which renders the body of the
+// requests sent to ALERT_WEBHOOK_URLS from an alert. If empty, the alert is
+// sent as JSON.
+func (c *generalConfig) AlertWebhookTemplate() string {
+	return c.viper.GetString(envvar.Name("AlertWebhookTemplate"))
+}
+
+// AlertDedupeInterval is how long alerts with the same type and subject are
+// suppressed after one has been sent.
+func (c *generalConfig) AlertDedupeInterval() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("AlertDedupeInterval"))
+}
+
 // AllowOrigins returns the CORS hosts used by the frontend.
 func (c *generalConfig) AllowOrigins() string {
 	return c.viper.GetString(envvar.Name("AllowOrigins"))
@@ -525,6 +634,37 @@ func (c *generalConfig) AutoPprofGoroutineThreshold() int {
 	return c.viper.GetInt(envvar.Name("AutoPprofGoroutineThreshold"))
 }
 
+// AutoPprofContinuousEnabled makes the Nurse sample profiles on an interval,
+// in addition to gathering vitals when a threshold is exceeded.
+func (c *generalConfig) AutoPprofContinuousEnabled() bool {
+	return c.viper.GetBool(envvar.Name("AutoPprofContinuousEnabled"))
+}
+
+// AutoPprofContinuousInterval is how often continuous profiles are sampled.
+func (c *generalConfig) AutoPprofContinuousInterval() models.Duration {
+	return models.MustMakeDuration(getEnvWithFallback(c, envvar.AutoPprofContinuousInterval))
+}
+
+// AutoPprofContinuousRetention is the number of continuous profile samples
+// kept on disk, older samples are deleted.
+func (c *generalConfig) AutoPprofContinuousRetention() int {
+	return c.viper.GetInt(envvar.Name("AutoPprofContinuousRetention"))
+}
+
+// AutoPprofContinuousBlockProfileRate is the block profile rate used while
+// continuous profiling is enabled. It is much coarser than
+// AUTO_PPROF_BLOCK_PROFILE_RATE, since it stays set for the lifetime of the node.
+func (c *generalConfig) AutoPprofContinuousBlockProfileRate() int {
+	return c.viper.GetInt(envvar.Name("AutoPprofContinuousBlockProfileRate"))
+}
+
+// AutoPprofContinuousMutexProfileFraction is the mutex profile fraction used
+// while continuous profiling is enabled. It is much coarser than
+// AUTO_PPROF_MUTEX_PROFILE_FRACTION, since it stays set for the lifetime of the node.
+func (c *generalConfig) AutoPprofContinuousMutexProfileFraction() int {
+	return c.viper.GetInt(envvar.Name("AutoPprofContinuousMutexProfileFraction"))
+}
+
 // BlockBackfillDepth specifies the number of blocks before the current HEAD that the
 // log broadcaster will try to re-consume logs from
 func (c *generalConfig) BlockBackfillDepth() uint64 {
@@ -595,6 +735,24 @@ func (c *generalConfig) DatabaseBackupDir() string {
 	return c.viper.GetString(envvar.Name("DatabaseBackupDir"))
 }
 
+// DatabaseBackupEncryptionKey is the hex encoded AES-256 key which backups are
+// encrypted with. Backups are not encrypted if it is empty.
+func (c *generalConfig) DatabaseBackupEncryptionKey() string {
+	return c.viper.GetString(envvar.Name("DatabaseBackupEncryptionKey"))
+}
+
+// DatabaseBackupRetentionCount is the number of most recent backups which are
+// kept, or 0 to keep all of them
+func (c *generalConfig) DatabaseBackupRetentionCount() uint32 {
+	return getEnvWithFallback(c, envvar.NewUint32("DatabaseBackupRetentionCount"))
+}
+
+// DatabaseBackupRetentionAge is how long backups are kept, or 0 to keep them
+// regardless of their age
+func (c *generalConfig) DatabaseBackupRetentionAge() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("DatabaseBackupRetentionAge"))
+}
+
 // DatabaseURL configures the URL for chainlink to connect to. This must be
 // a properly formatted URL, with a valid scheme (postgres://)
 func (c *generalConfig) DatabaseURL() url.URL {
@@ -627,6 +785,38 @@ func (c *generalConfig) DefaultHTTPTimeout() models.Duration {
 	return models.MustMakeDuration(getEnvWithFallback(c, envvar.NewDuration("DefaultHTTPTimeout")))
 }
 
+// HTTPCircuitBreakerThreshold is the number of consecutive failed requests
+// of the http and bridge tasks to a host after which further requests to the
+// host fail immediately. 0 disables the circuit breaker.
+func (c *generalConfig) HTTPCircuitBreakerThreshold() uint32 {
+	return getEnvWithFallback(c, envvar.HTTPCircuitBreakerThreshold)
+}
+
+// HTTPCircuitBreakerTimeout is how long the circuit breaker of a host stays
+// open, before a request is let through to probe whether the host recovered.
+func (c *generalConfig) HTTPCircuitBreakerTimeout() time.Duration {
+	return getEnvWithFallback(c, envvar.HTTPCircuitBreakerTimeout)
+}
+
+// HealthBridgeTimeout is how long the health report waits for a bridge to
+// respond before reporting it as failing.
+func (c *generalConfig) HealthBridgeTimeout() time.Duration {
+	return getEnvWithFallback(c, envvar.HealthBridgeTimeout)
+}
+
+// HealthHeadAgeThreshold is the age of the latest head of a chain above
+// which the health report reports the chain as failing.
+func (c *generalConfig) HealthHeadAgeThreshold() time.Duration {
+	return getEnvWithFallback(c, envvar.HealthHeadAgeThreshold)
+}
+
+// HealthTxBacklogThreshold is the number of unstarted and unconfirmed
+// transactions of a chain above which the health report reports the chain as
+// failing.
+func (c *generalConfig) HealthTxBacklogThreshold() uint32 {
+	return getEnvWithFallback(c, envvar.HealthTxBacklogThreshold)
+}
+
 // Dev configures "development" mode for chainlink.
 func (c *generalConfig) Dev() bool {
 	return c.viper.GetBool(envvar.Name("Dev"))
@@ -648,6 +838,12 @@ func (c *generalConfig) FeatureFeedsManager() bool {
 	return c.viper.GetBool(envvar.Name("FeatureFeedsManager"))
 }
 
+// FeedsManagerHealthReportInterval is the cadence on which job and chain
+// health is pushed to the feeds manager. Set to 0 to disable.
+func (c *generalConfig) FeedsManagerHealthReportInterval() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("FeedsManagerHealthReportInterval"))
+}
+
 func (c *generalConfig) FeatureLogPoller() bool {
 	return c.viper.GetBool(envvar.Name("FeatureLogPoller"))
 }
@@ -942,6 +1138,26 @@ func (c *generalConfig) TelemetryIngressUseBatchSend() bool {
 	return c.viper.GetBool(envvar.Name("TelemetryIngressUseBatchSend"))
 }
 
+// TracingEnabled enables the export of tracing spans for pipeline runs, task
+// runs, EVM RPC calls, transaction broadcasts and bridge requests to an
+// OpenTelemetry collector.
+func (c *generalConfig) TracingEnabled() bool {
+	return c.viper.GetBool(envvar.Name("TracingEnabled"))
+}
+
+// TracingCollectorTarget is the host:port of the OTLP/HTTP endpoint of the
+// local OpenTelemetry collector which tracing spans are exported to.
+func (c *generalConfig) TracingCollectorTarget() string {
+	return c.viper.GetString(envvar.Name("TracingCollectorTarget"))
+}
+
+// TracingSamplingRatio is the fraction of traces which are sampled, between 0
+// and 1. Traces continued from an incoming request follow its sampling
+// decision.
+func (c *generalConfig) TracingSamplingRatio() float64 {
+	return c.viper.GetFloat64(envvar.Name("TracingSamplingRatio"))
+}
+
 // TelemetryIngressLogging toggles very verbose logging of raw telemetry messages for the TelemetryIngressClient
 func (c *generalConfig) TelemetryIngressLogging() bool {
 	return getEnvWithFallback(c, envvar.NewBool("TelemetryIngressLogging"))
@@ -1293,6 +1509,24 @@ func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 func (c *generalConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmUseForwarders"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmTopUpEnabled() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmTopUpEnabled"), strconv.ParseBool)
+}
+func (c *generalConfig) GlobalEvmTopUpThresholdWei() (*big.Int, bool) {
+	return lookupEnv(c, envvar.Name("EvmTopUpThresholdWei"), parse.BigInt)
+}
+func (c *generalConfig) GlobalEvmTopUpTargetWei() (*big.Int, bool) {
+	return lookupEnv(c, envvar.Name("EvmTopUpTargetWei"), parse.BigInt)
+}
+func (c *generalConfig) GlobalEvmTopUpDailyLimitWei() (*big.Int, bool) {
+	return lookupEnv(c, envvar.Name("EvmTopUpDailyLimitWei"), parse.BigInt)
+}
+func (c *generalConfig) GlobalEvmBalanceAlertThresholdWei() (*big.Int, bool) {
+	return lookupEnv(c, envvar.Name("EvmBalanceAlertThresholdWei"), parse.BigInt)
+}
+func (c *generalConfig) GlobalEvmTxStuckAlertBlocks() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmTxStuckAlertBlocks"), parse.Uint32)
+}
 func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmRPCDefaultBatchSize"), parse.Uint32)
 }
@@ -1360,6 +1594,20 @@ func (c *generalConfig) LeaseLockDuration() time.Duration {
 	return c.getDuration("LeaseLockDuration")
 }
 
+// StandbyEnabled makes the node start as a warm standby: it keeps its keystore
+// unlocked, its RPC nodes dialed and tracks heads without writing to the
+// database until it acquires the DB locks, at which point it is promoted to leader.
+func (c *generalConfig) StandbyEnabled() bool {
+	return getEnvWithFallback(c, envvar.StandbyEnabled)
+}
+
+// StandbyPromotionTimeout is the maximum time a standby node may take to be
+// promoted to leader after acquiring the DB locks. The node exits if promotion
+// takes longer, releasing the locks for another standby.
+func (c *generalConfig) StandbyPromotionTimeout() time.Duration {
+	return getEnvWithFallback(c, envvar.StandbyPromotionTimeout)
+}
+
 // AdvisoryLockID is the application advisory lock ID. Should match all other
 // chainlink applications that might access this database
 func (c *generalConfig) AdvisoryLockID() int64 {
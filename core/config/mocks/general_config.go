@@ -85,6 +85,50 @@ func (_m *GeneralConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertDedupeInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AlertDedupeInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertWebhookTemplate provides a mock function with given fields:
+func (_m *GeneralConfig) AlertWebhookTemplate() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertWebhookURLs provides a mock function with given fields:
+func (_m *GeneralConfig) AlertWebhookURLs() []url.URL {
+	ret := _m.Called()
+
+	var r0 []url.URL
+	if rf, ok := ret.Get(0).(func() []url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]url.URL)
+		}
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *GeneralConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -171,6 +215,76 @@ func (_m *GeneralConfig) AutoPprofCPUProfileRate() int {
 	return r0
 }
 
+// AutoPprofContinuousBlockProfileRate provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofContinuousBlockProfileRate() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofContinuousEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofContinuousInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousMutexProfileFraction provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofContinuousMutexProfileFraction() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// AutoPprofContinuousRetention provides a mock function with given fields:
+func (_m *GeneralConfig) AutoPprofContinuousRetention() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // AutoPprofEnabled provides a mock function with given fields:
 func (_m *GeneralConfig) AutoPprofEnabled() bool {
 	ret := _m.Called()
@@ -397,6 +511,20 @@ func (_m *GeneralConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -439,6 +567,34 @@ func (_m *GeneralConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupRetentionAge provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupRetentionAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseBackupRetentionCount provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupRetentionCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -841,6 +997,20 @@ func (_m *GeneralConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeedsManagerHealthReportInterval provides a mock function with given fields:
+func (_m *GeneralConfig) FeedsManagerHealthReportInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // GetAdvisoryLockIDConfiguredOrDefault provides a mock function with given fields:
 func (_m *GeneralConfig) GetAdvisoryLockIDConfiguredOrDefault() int64 {
 	ret := _m.Called()
@@ -1100,6 +1270,29 @@ func (_m *GeneralConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	return r0, r1
 }
 
+// GlobalEvmBalanceAlertThresholdWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmBalanceAlertThresholdWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	ret := _m.Called()
@@ -1618,6 +1811,117 @@ func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmTopUpDailyLimitWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTopUpDailyLimitWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTopUpEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpTargetWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTopUpTargetWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTopUpThresholdWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTopUpThresholdWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmTxStuckAlertBlocks provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmTxStuckAlertBlocks() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmUseForwarders provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmUseForwarders() (bool, bool) {
 	ret := _m.Called()
@@ -1914,6 +2218,34 @@ func (_m *GeneralConfig) GlobalOCRObservationGracePeriod() (time.Duration, bool)
 	return r0, r1
 }
 
+// HTTPCircuitBreakerThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) HTTPCircuitBreakerThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// HTTPCircuitBreakerTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) HTTPCircuitBreakerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // HTTPServerWriteTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) HTTPServerWriteTimeout() time.Duration {
 	ret := _m.Called()
@@ -1928,6 +2260,48 @@ func (_m *GeneralConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HealthBridgeTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) HealthBridgeTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// HealthHeadAgeThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) HealthHeadAgeThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// HealthTxBacklogThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) HealthTxBacklogThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *GeneralConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -3286,6 +3660,34 @@ func (_m *GeneralConfig) SolanaNodes() string {
 	return r0
 }
 
+// StandbyEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) StandbyEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// StandbyPromotionTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) StandbyPromotionTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TerraNodes provides a mock function with given fields:
 func (_m *GeneralConfig) TerraNodes() string {
 	ret := _m.Called()
@@ -3526,6 +3928,48 @@ func (_m *GeneralConfig) TerraEnabled() bool {
 	return r0
 }
 
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *GeneralConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingSamplingRatio provides a mock function with given fields:
+func (_m *GeneralConfig) TracingSamplingRatio() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
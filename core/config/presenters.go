@@ -41,6 +41,8 @@ type EnvPrinter struct {
 	DatabaseBackupFrequency                    time.Duration   `json:"DATABASE_BACKUP_FREQUENCY"`
 	DatabaseBackupMode                         string          `json:"DATABASE_BACKUP_MODE"`
 	DatabaseBackupOnVersionUpgrade             bool            `json:"DATABASE_BACKUP_ON_VERSION_UPGRADE"`
+	DatabaseBackupRetentionAge                 time.Duration   `json:"DATABASE_BACKUP_RETENTION_AGE"`
+	DatabaseBackupRetentionCount               uint32          `json:"DATABASE_BACKUP_RETENTION_COUNT"`
 	DatabaseLockingMode                        string          `json:"DATABASE_LOCKING_MODE"`
 	DefaultChainID                             string          `json:"ETH_CHAIN_ID"`
 	DefaultHTTPLimit                           int64           `json:"DEFAULT_HTTP_LIMIT"`
@@ -56,6 +58,11 @@ type EnvPrinter struct {
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
 	FeatureOffchainReporting                   bool            `json:"FEATURE_OFFCHAIN_REPORTING"`
 	GasEstimatorMode                           string          `json:"GAS_ESTIMATOR_MODE"`
+	HTTPCircuitBreakerThreshold                uint32          `json:"HTTP_CIRCUIT_BREAKER_THRESHOLD"`
+	HTTPCircuitBreakerTimeout                  time.Duration   `json:"HTTP_CIRCUIT_BREAKER_TIMEOUT"`
+	HealthBridgeTimeout                        time.Duration   `json:"HEALTH_BRIDGE_TIMEOUT"`
+	HealthHeadAgeThreshold                     time.Duration   `json:"HEALTH_HEAD_AGE_THRESHOLD"`
+	HealthTxBacklogThreshold                   uint32          `json:"HEALTH_TX_BACKLOG_THRESHOLD"`
 	InsecureFastScrypt                         bool            `json:"INSECURE_FAST_SCRYPT"`
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
@@ -74,6 +81,8 @@ type EnvPrinter struct {
 	KeeperTurnFlagEnabled                      bool            `json:"KEEPER_TURN_FLAG_ENABLED"`
 	LeaseLockDuration                          time.Duration   `json:"LEASE_LOCK_DURATION"`
 	LeaseLockRefreshInterval                   time.Duration   `json:"LEASE_LOCK_REFRESH_INTERVAL"`
+	StandbyEnabled                             bool            `json:"STANDBY_ENABLED"`
+	StandbyPromotionTimeout                    time.Duration   `json:"STANDBY_PROMOTION_TIMEOUT"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
 	LinkContractAddress                        string          `json:"LINK_CONTRACT_ADDRESS"`
 	LogFileDir                                 string          `json:"LOG_FILE_DIR"`
@@ -83,6 +92,10 @@ type EnvPrinter struct {
 	LogFileMaxAge                              int64           `json:"LOG_FILE_MAX_AGE"`
 	LogFileMaxBackups                          int64           `json:"LOG_FILE_MAX_BACKUPS"`
 	TriggerFallbackDBPollInterval              time.Duration   `json:"JOB_PIPELINE_DB_POLL_INTERVAL"`
+	TracingEnabled                             bool            `json:"TRACING_ENABLED"`
+	TracingCollectorTarget                     string          `json:"TRACING_COLLECTOR_TARGET"`
+	TracingSamplingRatio                       float64         `json:"TRACING_SAMPLING_RATIO"`
+	AlertDedupeInterval                        time.Duration   `json:"ALERT_DEDUPE_INTERVAL"`
 
 	// OCR1
 	OCRContractTransmitterTransmitTimeout time.Duration `json:"OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT"`
@@ -156,6 +169,8 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			DatabaseBackupFrequency:                 cfg.DatabaseBackupFrequency(),
 			DatabaseBackupMode:                      string(cfg.DatabaseBackupMode()),
 			DatabaseBackupOnVersionUpgrade:          cfg.DatabaseBackupOnVersionUpgrade(),
+			DatabaseBackupRetentionAge:              cfg.DatabaseBackupRetentionAge(),
+			DatabaseBackupRetentionCount:            cfg.DatabaseBackupRetentionCount(),
 			DatabaseLockingMode:                     cfg.DatabaseLockingMode(),
 			DefaultChainID:                          cfg.DefaultChainID().String(),
 			DefaultHTTPLimit:                        cfg.DefaultHTTPLimit(),
@@ -170,6 +185,11 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			FMDefaultTransactionQueueDepth:          cfg.FMDefaultTransactionQueueDepth(),
 			FeatureExternalInitiators:               cfg.FeatureExternalInitiators(),
 			FeatureOffchainReporting:                cfg.FeatureOffchainReporting(),
+			HTTPCircuitBreakerThreshold:             cfg.HTTPCircuitBreakerThreshold(),
+			HTTPCircuitBreakerTimeout:               cfg.HTTPCircuitBreakerTimeout(),
+			HealthBridgeTimeout:                     cfg.HealthBridgeTimeout(),
+			HealthHeadAgeThreshold:                  cfg.HealthHeadAgeThreshold(),
+			HealthTxBacklogThreshold:                cfg.HealthTxBacklogThreshold(),
 			InsecureFastScrypt:                      cfg.InsecureFastScrypt(),
 			JSONConsole:                             cfg.JSONConsole(),
 			JobPipelineReaperInterval:               cfg.JobPipelineReaperInterval(),
@@ -183,6 +203,8 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			KeeperBaseFeeBufferPercent:              cfg.KeeperBaseFeeBufferPercent(),
 			LeaseLockDuration:                       cfg.LeaseLockDuration(),
 			LeaseLockRefreshInterval:                cfg.LeaseLockRefreshInterval(),
+			StandbyEnabled:                          cfg.StandbyEnabled(),
+			StandbyPromotionTimeout:                 cfg.StandbyPromotionTimeout(),
 			LogFileDir:                              cfg.LogFileDir(),
 			LogFileMaxSize:                          cfg.LogFileMaxSize(),
 			LogFileMaxAge:                           cfg.LogFileMaxAge(),
@@ -229,6 +251,10 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			TelemetryIngressServerPubKey:  cfg.TelemetryIngressServerPubKey(),
 			TelemetryIngressURL:           telemetryIngressURL,
 			TriggerFallbackDBPollInterval: cfg.TriggerFallbackDBPollInterval(),
+			TracingEnabled:                cfg.TracingEnabled(),
+			TracingCollectorTarget:        cfg.TracingCollectorTarget(),
+			TracingSamplingRatio:          cfg.TracingSamplingRatio(),
+			AlertDedupeInterval:           cfg.AlertDedupeInterval(),
 		},
 	}
 }
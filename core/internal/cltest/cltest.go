@@ -59,6 +59,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/keystest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -70,6 +71,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/terrakey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
@@ -174,8 +176,8 @@ type JobPipelineV2TestHelper struct {
 func NewJobPipelineV2(t testing.TB, cfg config.GeneralConfig, cc evm.ChainSet, db *sqlx.DB, keyStore keystore.Master, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) JobPipelineV2TestHelper {
 	lggr := logger.TestLogger(t)
 	prm := pipeline.NewORM(db, lggr, cfg)
-	jrm := job.NewORM(db, cc, prm, keyStore, lggr, cfg)
-	pr := pipeline.NewRunner(prm, cfg, cc, keyStore.Eth(), keyStore.VRF(), lggr, restrictedHTTPClient, unrestrictedHTTPClient)
+	jrm := job.NewORM(db, cc, prm, keyStore, lggr, cfg, alerts.NullNotifier{})
+	pr := pipeline.NewRunner(prm, cfg, cc, nil, nil, keyStore.Eth(), keyStore.VRF(), keyStore.Solana(), keyStore.Secrets(), lggr, restrictedHTTPClient, unrestrictedHTTPClient, nil)
 	return JobPipelineV2TestHelper{
 		prm,
 		jrm,
@@ -205,7 +207,7 @@ func NewEthConfirmer(t testing.TB, db *sqlx.DB, ethClient evmclient.Client, conf
 	t.Helper()
 	lggr := logger.TestLogger(t)
 	ec := txmgr.NewEthConfirmer(db, ethClient, config, ks, keyStates,
-		gas.NewFixedPriceEstimator(config, lggr), fn, lggr)
+		gas.NewFixedPriceEstimator(config, lggr), fn, lggr, alerts.NullNotifier{})
 	return ec
 }
 
@@ -323,10 +325,13 @@ func NewApplicationWithConfig(t testing.TB, cfg *configtest.TestGeneralConfig, f
 	externalInitiatorManager = &webhook.NullExternalInitiatorManager{}
 	var useRealExternalInitiatorManager bool
 	var chainORM evmtypes.ORM
+	var peerNetwork ocrcommon.PeerNetwork
 	for _, flag := range flagsAndDeps {
 		switch dep := flag.(type) {
 		case evmclient.Client:
 			ethClient = dep
+		case ocrcommon.PeerNetwork:
+			peerNetwork = dep
 		case webhook.ExternalInitiatorManager:
 			externalInitiatorManager = dep
 		case evmtypes.DBChain:
@@ -411,6 +416,8 @@ func NewApplicationWithConfig(t testing.TB, cfg *configtest.TestGeneralConfig, f
 		ExternalInitiatorManager: externalInitiatorManager,
 		RestrictedHTTPClient:     c,
 		UnrestrictedHTTPClient:   c,
+		AlertNotifier:            alerts.NullNotifier{},
+		PeerNetwork:              peerNetwork,
 	})
 	require.NoError(t, err)
 	app := appInstance.(*chainlink.ChainlinkApplication)
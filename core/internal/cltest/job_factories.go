@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -45,7 +46,7 @@ func MustInsertWebhookSpec(t *testing.T, db *sqlx.DB) (job.Job, job.WebhookSpec)
 	require.NoError(t, jobORM.InsertWebhookSpec(&webhookSpec))
 
 	pSpec := pipeline.Pipeline{}
-	pipelineSpecID, err := pipelineORM.CreateSpec(pSpec, 0)
+	pipelineSpecID, err := pipelineORM.CreateSpec(pSpec, 0, nil)
 	require.NoError(t, err)
 
 	job := job.Job{WebhookSpecID: &webhookSpec.ID, WebhookSpec: &webhookSpec, SchemaVersion: 1, Type: "webhook", ExternalJobID: uuid.NewV4(), PipelineSpecID: pipelineSpecID}
@@ -59,7 +60,7 @@ func getORMs(t *testing.T, db *sqlx.DB) (jobORM job.ORM, pipelineORM pipeline.OR
 	keyStore := NewKeyStore(t, db, config)
 	pipelineORM = pipeline.NewORM(db, logger.TestLogger(t), config)
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
-	jobORM = job.NewORM(db, cc, pipelineORM, keyStore, logger.TestLogger(t), config)
+	jobORM = job.NewORM(db, cc, pipelineORM, keyStore, logger.TestLogger(t), config, alerts.NullNotifier{})
 	t.Cleanup(func() { jobORM.Close() })
 	return
 }
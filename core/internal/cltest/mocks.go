@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web"
@@ -103,7 +104,7 @@ type InstanceAppFactory struct {
 }
 
 // NewApplication creates a new application with specified config
-func (f InstanceAppFactory) NewApplication(config.GeneralConfig, *sqlx.DB) (chainlink.Application, error) {
+func (f InstanceAppFactory) NewApplication(config.GeneralConfig, *sqlx.DB, alerts.Notifier) (chainlink.Application, error) {
 	return f.App, nil
 }
 
@@ -111,7 +112,7 @@ type seededAppFactory struct {
 	Application chainlink.Application
 }
 
-func (s seededAppFactory) NewApplication(config.GeneralConfig, *sqlx.DB) (chainlink.Application, error) {
+func (s seededAppFactory) NewApplication(config.GeneralConfig, *sqlx.DB, alerts.Notifier) (chainlink.Application, error) {
 	return noopStopApplication{s.Application}, nil
 }
 
@@ -40,6 +40,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 
+	log "github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	healthreport "github.com/smartcontractkit/chainlink/core/services/healthreport"
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -122,6 +124,22 @@ func (_m *Application) GetChains() chainlink.Chains {
 	return r0
 }
 
+// GetCircuitBreakers provides a mock function with given fields:
+func (_m *Application) GetCircuitBreakers() *pipeline.HostCircuitBreakers {
+	ret := _m.Called()
+
+	var r0 *pipeline.HostCircuitBreakers
+	if rf, ok := ret.Get(0).(func() *pipeline.HostCircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.HostCircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() config.GeneralConfig {
 	ret := _m.Called()
@@ -202,6 +220,22 @@ func (_m *Application) GetHealthChecker() services.Checker {
 	return r0
 }
 
+// GetHealthReporter provides a mock function with given fields:
+func (_m *Application) GetHealthReporter() healthreport.Reporter {
+	ret := _m.Called()
+
+	var r0 healthreport.Reporter
+	if rf, ok := ret.Get(0).(func() healthreport.Reporter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(healthreport.Reporter)
+		}
+	}
+
+	return r0
+}
+
 // GetKeyStore provides a mock function with given fields:
 func (_m *Application) GetKeyStore() keystore.Master {
 	ret := _m.Called()
@@ -234,6 +268,36 @@ func (_m *Application) GetLogger() logger.Logger {
 	return r0
 }
 
+// GetNurse provides a mock function with given fields:
+func (_m *Application) GetNurse() *services.Nurse {
+	ret := _m.Called()
+
+	var r0 *services.Nurse
+	if rf, ok := ret.Get(0).(func() *services.Nurse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.Nurse)
+		}
+	}
+
+	return r0
+}
+
+// GetRole provides a mock function with given fields:
+func (_m *Application) GetRole() chainlink.Role {
+	ret := _m.Called()
+
+	var r0 chainlink.Role
+	if rf, ok := ret.Get(0).(func() chainlink.Role); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.Role)
+	}
+
+	return r0
+}
+
 // GetSqlxDB provides a mock function with given fields:
 func (_m *Application) GetSqlxDB() *sqlx.DB {
 	ret := _m.Called()
@@ -280,6 +344,29 @@ func (_m *Application) ID() uuid.UUID {
 	return r0
 }
 
+// ImportJobs provides a mock function with given fields: ctx, bts, jbs
+func (_m *Application) ImportJobs(ctx context.Context, bts []*bridges.BridgeType, jbs []*job.Job) ([]error, error) {
+	ret := _m.Called(ctx, bts, jbs)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func(context.Context, []*bridges.BridgeType, []*job.Job) []error); ok {
+		r0 = rf(ctx, bts, jbs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*bridges.BridgeType, []*job.Job) error); ok {
+		r1 = rf(ctx, bts, jbs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -342,6 +429,48 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayJobRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) ReplayJobRunV2(ctx context.Context, runID int64) (pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, runID)
+
+	var r0 pipeline.ReplayResult
+	if rf, ok := ret.Get(0).(func(context.Context, int64) pipeline.ReplayResult); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(pipeline.ReplayResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplayTargeted provides a mock function with given fields: chainID, req
+func (_m *Application) ReplayTargeted(chainID *big.Int, req log.ReplayRequest) (log.ReplayProgress, error) {
+	ret := _m.Called(chainID, req)
+
+	var r0 log.ReplayProgress
+	if rf, ok := ret.Get(0).(func(*big.Int, log.ReplayRequest) log.ReplayProgress); ok {
+		r0 = rf(chainID, req)
+	} else {
+		r0 = ret.Get(0).(log.ReplayProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, log.ReplayRequest) error); ok {
+		r1 = rf(chainID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -456,6 +585,20 @@ func (_m *Application) Start(ctx context.Context) error {
 	return r0
 }
 
+// StartStandby provides a mock function with given fields: ctx
+func (_m *Application) StartStandby(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Stop provides a mock function with given fields:
 func (_m *Application) Stop() error {
 	ret := _m.Called()
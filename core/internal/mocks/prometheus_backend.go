@@ -15,6 +15,26 @@ type PrometheusBackend struct {
 	mock.Mock
 }
 
+// AddJobGasFee provides a mock function with given fields: jobType, externalJobID, feeWei
+func (_m *PrometheusBackend) AddJobGasFee(jobType string, externalJobID string, feeWei *big.Int) {
+	_m.Called(jobType, externalJobID, feeWei)
+}
+
+// AddJobGasUsed provides a mock function with given fields: jobType, externalJobID, gasUsed
+func (_m *PrometheusBackend) AddJobGasUsed(jobType string, externalJobID string, gasUsed uint64) {
+	_m.Called(jobType, externalJobID, gasUsed)
+}
+
+// ObserveJobRun provides a mock function with given fields: jobType, externalJobID, status, seconds
+func (_m *PrometheusBackend) ObserveJobRun(jobType string, externalJobID string, status string, seconds float64) {
+	_m.Called(jobType, externalJobID, status, seconds)
+}
+
+// ObserveJobTxInclusion provides a mock function with given fields: jobType, externalJobID, seconds
+func (_m *PrometheusBackend) ObserveJobTxInclusion(jobType string, externalJobID string, seconds float64) {
+	_m.Called(jobType, externalJobID, seconds)
+}
+
 // SetMaxUnconfirmedAge provides a mock function with given fields: _a0, _a1
 func (_m *PrometheusBackend) SetMaxUnconfirmedAge(_a0 *big.Int, _a1 float64) {
 	_m.Called(_a0, _a1)
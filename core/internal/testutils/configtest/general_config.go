@@ -99,6 +99,8 @@ type GeneralConfigOverrides struct {
 	LogFileMaxAge                           null.Int
 	LogFileMaxBackups                       null.Int
 	SecretGenerator                         config.SecretGenerator
+	StandbyEnabled                          null.Bool
+	StandbyPromotionTimeout                 *time.Duration
 	TriggerFallbackDBPollInterval           *time.Duration
 	KeySpecific                             map[string]types.ChainCfg
 	LinkContractAddress                     null.String
@@ -737,6 +739,20 @@ func (c *TestGeneralConfig) LeaseLockDuration() time.Duration {
 	return c.GeneralConfig.LeaseLockDuration()
 }
 
+func (c *TestGeneralConfig) StandbyEnabled() bool {
+	if c.Overrides.StandbyEnabled.Valid {
+		return c.Overrides.StandbyEnabled.Bool
+	}
+	return c.GeneralConfig.StandbyEnabled()
+}
+
+func (c *TestGeneralConfig) StandbyPromotionTimeout() time.Duration {
+	if c.Overrides.StandbyPromotionTimeout != nil {
+		return *c.Overrides.StandbyPromotionTimeout
+	}
+	return c.GeneralConfig.StandbyPromotionTimeout()
+}
+
 func (c *TestGeneralConfig) AdvisoryLockCheckInterval() time.Duration {
 	if c.Overrides.AdvisoryLockCheckInterval != nil {
 		return *c.Overrides.AdvisoryLockCheckInterval
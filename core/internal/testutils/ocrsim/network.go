@@ -0,0 +1,195 @@
+package ocrsim
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+// endpointBufferSize is the number of messages which are buffered for an
+// endpoint before further messages to it are dropped, as a real endpoint
+// drops messages once its buffer is full.
+const endpointBufferSize = 1000
+
+var _ ocrcommon.PeerNetwork = (*network)(nil)
+
+// network is an in-memory network which the nodes of a simulation send OCR
+// and OCR2 messages over, in place of the libocr networking stack. Messages
+// are delivered to the endpoint of the peer with the same config digest, so
+// no bootstrap nodes are needed for peers to find each other.
+type network struct {
+	mu        sync.RWMutex
+	endpoints map[endpointKey]*endpoint
+}
+
+type endpointKey struct {
+	configDigest string
+	peerID       string
+}
+
+func newNetwork() *network {
+	return &network{endpoints: make(map[endpointKey]*endpoint)}
+}
+
+// OCR1Peer returns the OCR endpoint and bootstrapper factories of the peer.
+func (n *network) OCR1Peer(peerID string) (ocrtypes.BinaryNetworkEndpointFactory, ocrtypes.BootstrapperFactory) {
+	p := &ocr1Peer{n, peerID}
+	return p, p
+}
+
+// OCR2Peer returns the OCR2 endpoint and bootstrapper factories of the peer.
+func (n *network) OCR2Peer(peerID string) (ocr2types.BinaryNetworkEndpointFactory, ocr2types.BootstrapperFactory) {
+	p := &ocr2Peer{n, peerID}
+	return p, p
+}
+
+func (n *network) newEndpoint(configDigest string, peerID string, peerIDs []string) (*endpoint, error) {
+	for i, id := range peerIDs {
+		if id == peerID {
+			return &endpoint{
+				network: n,
+				key:     endpointKey{configDigest, peerID},
+				peerIDs: peerIDs,
+				id:      commontypes.OracleID(i),
+				chRecv:  make(chan commontypes.BinaryMessageWithSender, endpointBufferSize),
+			}, nil
+		}
+	}
+
+	return nil, errors.Errorf("peer %s is not one of the oracles of config %s", peerID, configDigest)
+}
+
+func (n *network) register(e *endpoint) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, exists := n.endpoints[e.key]; exists {
+		return errors.Errorf("peer %s already has an endpoint for config %s", e.key.peerID, e.key.configDigest)
+	}
+	n.endpoints[e.key] = e
+
+	return nil
+}
+
+func (n *network) unregister(e *endpoint) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.endpoints[e.key] == e {
+		delete(n.endpoints, e.key)
+	}
+}
+
+// send delivers the message to the endpoint of the peer, if it has one. The
+// message is dropped if the endpoint's buffer is full, so that send never
+// blocks.
+func (n *network) send(key endpointKey, msg commontypes.BinaryMessageWithSender) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	e, ok := n.endpoints[key]
+	if !ok {
+		return
+	}
+	select {
+	case e.chRecv <- msg:
+	default:
+	}
+}
+
+// endpoint implements commontypes.BinaryNetworkEndpoint on the network.
+type endpoint struct {
+	network *network
+	key     endpointKey
+	peerIDs []string
+	id      commontypes.OracleID
+	chRecv  chan commontypes.BinaryMessageWithSender
+}
+
+func (e *endpoint) SendTo(payload []byte, to commontypes.OracleID) {
+	if int(to) < 0 || int(to) >= len(e.peerIDs) {
+		return
+	}
+
+	e.network.send(endpointKey{e.key.configDigest, e.peerIDs[to]}, commontypes.BinaryMessageWithSender{
+		Msg:    append([]byte(nil), payload...),
+		Sender: e.id,
+	})
+}
+
+// Broadcast sends the payload to every oracle, including this one, as the
+// libocr endpoints do.
+func (e *endpoint) Broadcast(payload []byte) {
+	for i := range e.peerIDs {
+		e.SendTo(payload, commontypes.OracleID(i))
+	}
+}
+
+func (e *endpoint) Receive() <-chan commontypes.BinaryMessageWithSender {
+	return e.chRecv
+}
+
+func (e *endpoint) Start() error {
+	return e.network.register(e)
+}
+
+func (e *endpoint) Close() error {
+	e.network.unregister(e)
+	return nil
+}
+
+// bootstrapper does nothing, as peers need no help to find each other on the
+// network.
+type bootstrapper struct{}
+
+func (bootstrapper) Start() error { return nil }
+func (bootstrapper) Close() error { return nil }
+
+type ocr1Peer struct {
+	network *network
+	peerID  string
+}
+
+func (p *ocr1Peer) NewEndpoint(cd ocrtypes.ConfigDigest, peerIDs []string, _ []string, _ []commontypes.BootstrapperLocator, _ int, _ float64, _ int) (commontypes.BinaryNetworkEndpoint, error) {
+	e, err := p.network.newEndpoint(cd.Hex(), p.peerID, peerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (p *ocr1Peer) NewBootstrapper(ocrtypes.ConfigDigest, []string, []string, []commontypes.BootstrapperLocator, int) (commontypes.Bootstrapper, error) {
+	return bootstrapper{}, nil
+}
+
+func (p *ocr1Peer) PeerID() string {
+	return p.peerID
+}
+
+type ocr2Peer struct {
+	network *network
+	peerID  string
+}
+
+func (p *ocr2Peer) NewEndpoint(cd ocr2types.ConfigDigest, peerIDs []string, _ []commontypes.BootstrapperLocator, _ int, _ ocr2types.BinaryNetworkEndpointLimits) (commontypes.BinaryNetworkEndpoint, error) {
+	e, err := p.network.newEndpoint(cd.Hex(), p.peerID, peerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (p *ocr2Peer) NewBootstrapper(ocr2types.ConfigDigest, []string, []commontypes.BootstrapperLocator, int) (commontypes.Bootstrapper, error) {
+	return bootstrapper{}, nil
+}
+
+func (p *ocr2Peer) PeerID() string {
+	return p.peerID
+}
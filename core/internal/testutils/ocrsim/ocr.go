@@ -0,0 +1,174 @@
+package ocrsim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
+	"github.com/smartcontractkit/libocr/gethwrappers/testoffchainaggregator"
+	"github.com/smartcontractkit/libocr/offchainreporting/confighelper"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/ocr"
+)
+
+// OCRSimulation runs OCR oracles against an OffchainAggregator deployed to
+// the simulated backend.
+type OCRSimulation struct {
+	*Simulation
+	ContractAddress common.Address
+	Contract        *offchainaggregator.OffchainAggregator
+
+	keys []ocrkey.KeyV2
+}
+
+// NewOCR creates the nodes of an OCR simulation and deploys and configures
+// the aggregator. The nodes are started, but run no jobs until Start is called.
+func NewOCR(t *testing.T, opts Options) *OCRSimulation {
+	s := &OCRSimulation{Simulation: newSimulation(t, opts)}
+	s.deployContracts(t)
+
+	configure := func(cfg *configtest.TestGeneralConfig) {
+		cfg.Overrides.FeatureOffchainReporting = null.BoolFrom(true)
+		// GracePeriod < ObservationTimeout
+		cfg.Overrides.GlobalOCRObservationGracePeriod = 100 * time.Millisecond
+	}
+
+	s.Bootstrap = s.newNode(t, bootstrapIndex, configure)
+	for i := 0; i < s.opts.NumOracles; i++ {
+		node := s.newNode(t, i, func(cfg *configtest.TestGeneralConfig) {
+			configure(cfg)
+			cfg.Overrides.P2PV2Bootstrappers = s.bootstrappers()
+		})
+
+		key, err := node.App.GetKeyStore().OCR().Create()
+		require.NoError(t, err)
+
+		s.Oracles = append(s.Oracles, node)
+		s.keys = append(s.keys, key)
+	}
+
+	s.setConfig(t)
+
+	return s
+}
+
+func (s *OCRSimulation) deployContracts(t *testing.T) {
+	linkTokenAddress, _, linkContract, err := link_token_interface.DeployLinkToken(s.Owner, s.Backend)
+	require.NoError(t, err)
+	accessAddress, _, _, err := testoffchainaggregator.DeploySimpleWriteAccessController(s.Owner, s.Backend)
+	require.NoError(t, err, "failed to deploy test access controller contract")
+	s.Backend.Commit()
+
+	min, max := new(big.Int), new(big.Int)
+	min.Exp(big.NewInt(-2), big.NewInt(191), nil)
+	max.Exp(big.NewInt(2), big.NewInt(191), nil)
+	max.Sub(max, big.NewInt(1))
+	s.ContractAddress, _, s.Contract, err = offchainaggregator.DeployOffchainAggregator(s.Owner, s.Backend,
+		1000,             // _maximumGasPrice uint32,
+		200,              //_reasonableGasPrice uint32,
+		3.6e7,            // 3.6e7 microLINK, or 36 LINK
+		1e8,              // _linkGweiPerObservation uint32,
+		4e8,              // _linkGweiPerTransmission uint32,
+		linkTokenAddress, //_link common.Address,
+		min,              // -2**191
+		max,              // 2**191 - 1
+		accessAddress,
+		accessAddress,
+		0,
+		"TEST")
+	require.NoError(t, err)
+	_, err = linkContract.Transfer(s.Owner, s.ContractAddress, big.NewInt(1000))
+	require.NoError(t, err)
+	s.Backend.Commit()
+}
+
+func (s *OCRSimulation) setConfig(t *testing.T) {
+	var oracles []confighelper.OracleIdentityExtra
+	for i, o := range s.Oracles {
+		oracles = append(oracles, confighelper.OracleIdentityExtra{
+			OracleIdentity: confighelper.OracleIdentity{
+				OnChainSigningAddress: ocrtypes.OnChainSigningAddress(s.keys[i].OnChainSigning.Address()),
+				TransmitAddress:       o.Transmitter,
+				OffchainPublicKey:     ocrtypes.OffchainPublicKey(s.keys[i].PublicKeyOffChain()),
+				PeerID:                o.PeerID,
+			},
+			SharedSecretEncryptionPublicKey: ocrtypes.SharedSecretEncryptionPublicKey(s.keys[i].PublicKeyConfig()),
+		})
+	}
+
+	transmitters := s.transmitters()
+	_, err := s.Contract.SetPayees(s.Owner, transmitters, transmitters)
+	require.NoError(t, err)
+
+	signers, transmitters, threshold, encodedConfigVersion, encodedConfig, err := confighelper.ContractSetConfigArgsForIntegrationTest(
+		oracles,
+		1,
+		1000000000/100, // threshold PPB
+	)
+	require.NoError(t, err)
+	_, err = s.Contract.SetConfig(s.Owner, signers, transmitters, threshold, encodedConfigVersion, encodedConfig)
+	require.NoError(t, err)
+	s.Backend.Commit()
+}
+
+// Start adds the bootstrap and oracle jobs and begins
+// committing blocks, which causes the oracles to run rounds.
+func (s *OCRSimulation) Start(t *testing.T) {
+	jb, err := ocr.ValidatedOracleSpecToml(s.Bootstrap.App.GetChains().EVM, fmt.Sprintf(`
+type               = "offchainreporting"
+schemaVersion      = 1
+name               = "bootstrap"
+contractAddress    = "%s"
+isBootstrapPeer    = true
+`, s.ContractAddress))
+	require.NoError(t, err)
+	require.NoError(t, s.Bootstrap.App.AddJobV2(context.Background(), &jb))
+	s.Bootstrap.JobID = jb.ID
+
+	for i, o := range s.Oracles {
+		// Note we need: observationTimeout + observationGracePeriod + DeltaGrace (500ms) < DeltaRound (1s)
+		// So 100ms + 100ms + 500ms < 1s
+		jb, err := ocr.ValidatedOracleSpecToml(o.App.GetChains().EVM, fmt.Sprintf(`
+type               = "offchainreporting"
+schemaVersion      = 1
+name               = "oracle%d"
+contractAddress    = "%s"
+isBootstrapPeer    = false
+p2pBootstrapPeers  = [
+    "/ip4/127.0.0.1/tcp/6690/p2p/%s"
+]
+keyBundleID        = "%s"
+transmitterAddress = "%s"
+observationTimeout = "100ms"
+contractConfigConfirmations = 1
+contractConfigTrackerPollInterval = "1s"
+observationSource = """
+%s
+"""
+`, i, s.ContractAddress, s.Bootstrap.PeerID, s.keys[i].ID(), o.Transmitter, s.opts.ObservationSource(i)))
+		require.NoError(t, err)
+		require.NoError(t, o.App.AddJobV2(context.Background(), &jb))
+		o.JobID = jb.ID
+	}
+
+	s.startBlocks(t)
+}
+
+// WaitForAnswer waits until the aggregator reports the wanted answer.
+func (s *OCRSimulation) WaitForAnswer(t *testing.T, want *big.Int) {
+	t.Helper()
+
+	waitForAnswer(t, want, func() (*big.Int, error) {
+		return s.Contract.LatestAnswer(nil)
+	})
+}
@@ -0,0 +1,175 @@
+package ocrsim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
+	"github.com/smartcontractkit/libocr/gethwrappers2/testocr2aggregator"
+	"github.com/smartcontractkit/libocr/offchainreporting2/confighelper"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/link_token_interface"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+)
+
+// OCR2Simulation runs OCR2 median oracles against an OCR2Aggregator deployed
+// to the simulated backend.
+type OCR2Simulation struct {
+	*Simulation
+	ContractAddress common.Address
+	Contract        *ocr2aggregator.OCR2Aggregator
+
+	keyBundles []ocr2key.KeyBundle
+}
+
+// NewOCR2 creates the nodes of an OCR2 simulation and deploys and configures
+// the aggregator. The nodes are started, but run no jobs until Start is called.
+func NewOCR2(t *testing.T, opts Options) *OCR2Simulation {
+	s := &OCR2Simulation{Simulation: newSimulation(t, opts)}
+	s.deployContracts(t)
+
+	configure := func(cfg *configtest.TestGeneralConfig) {
+		cfg.Overrides.FeatureOffchainReporting = null.BoolFrom(false)
+		cfg.Overrides.FeatureOffchainReporting2 = null.BoolFrom(true)
+	}
+
+	s.Bootstrap = s.newNode(t, bootstrapIndex, configure)
+	for i := 0; i < s.opts.NumOracles; i++ {
+		node := s.newNode(t, i, func(cfg *configtest.TestGeneralConfig) {
+			configure(cfg)
+			cfg.Overrides.P2PV2Bootstrappers = s.bootstrappers()
+		})
+
+		kb, err := node.App.GetKeyStore().OCR2().Create("evm")
+		require.NoError(t, err)
+
+		s.Oracles = append(s.Oracles, node)
+		s.keyBundles = append(s.keyBundles, kb)
+	}
+
+	s.setConfig(t)
+
+	return s
+}
+
+func (s *OCR2Simulation) deployContracts(t *testing.T) {
+	linkTokenAddress, _, linkContract, err := link_token_interface.DeployLinkToken(s.Owner, s.Backend)
+	require.NoError(t, err)
+	accessAddress, _, _, err := testocr2aggregator.DeploySimpleWriteAccessController(s.Owner, s.Backend)
+	require.NoError(t, err, "failed to deploy test access controller contract")
+	s.Backend.Commit()
+
+	minAnswer, maxAnswer := new(big.Int), new(big.Int)
+	minAnswer.Exp(big.NewInt(-2), big.NewInt(191), nil)
+	maxAnswer.Exp(big.NewInt(2), big.NewInt(191), nil)
+	maxAnswer.Sub(maxAnswer, big.NewInt(1))
+	s.ContractAddress, _, s.Contract, err = ocr2aggregator.DeployOCR2Aggregator(
+		s.Owner,
+		s.Backend,
+		linkTokenAddress, //_link common.Address,
+		minAnswer,        // -2**191
+		maxAnswer,        // 2**191 - 1
+		accessAddress,
+		accessAddress,
+		9,
+		"TEST",
+	)
+	require.NoError(t, err)
+	_, err = linkContract.Transfer(s.Owner, s.ContractAddress, big.NewInt(1000))
+	require.NoError(t, err)
+	s.Backend.Commit()
+}
+
+func (s *OCR2Simulation) setConfig(t *testing.T) {
+	var oracles []confighelper.OracleIdentityExtra
+	for i, o := range s.Oracles {
+		oracles = append(oracles, confighelper.OracleIdentityExtra{
+			OracleIdentity: confighelper.OracleIdentity{
+				OnchainPublicKey:  s.keyBundles[i].PublicKey(),
+				TransmitAccount:   ocrtypes.Account(o.Transmitter.String()),
+				OffchainPublicKey: s.keyBundles[i].OffchainPublicKey(),
+				PeerID:            o.PeerID,
+			},
+			ConfigEncryptionPublicKey: s.keyBundles[i].ConfigEncryptionPublicKey(),
+		})
+	}
+
+	transmitters := s.transmitters()
+	_, err := s.Contract.SetPayees(s.Owner, transmitters, transmitters)
+	require.NoError(t, err)
+
+	signers, transmitters, threshold, onchainConfig, encodedConfigVersion, encodedConfig, err := confighelper.ContractSetConfigArgsForEthereumIntegrationTest(
+		oracles,
+		1,
+		1000000000/100, // threshold PPB
+	)
+	require.NoError(t, err)
+	_, err = s.Contract.SetConfig(s.Owner, signers, transmitters, threshold, onchainConfig, encodedConfigVersion, encodedConfig)
+	require.NoError(t, err)
+	s.Backend.Commit()
+}
+
+// Start adds the bootstrap and oracle jobs and begins
+// committing blocks, which causes the oracles to run rounds.
+func (s *OCR2Simulation) Start(t *testing.T) {
+	jb, err := ocrbootstrap.ValidatedBootstrapSpecToml(fmt.Sprintf(`
+type				= "bootstrap"
+name				= "bootstrap"
+relay				= "evm"
+schemaVersion		= 1
+contractID			= "%s"
+[relayConfig]
+chainID 			= %d
+`, s.ContractAddress, s.Backend.Blockchain().Config().ChainID))
+	require.NoError(t, err)
+	require.NoError(t, s.Bootstrap.App.AddJobV2(context.Background(), &jb))
+	s.Bootstrap.JobID = jb.ID
+
+	for i, o := range s.Oracles {
+		source := s.opts.ObservationSource(i)
+		jb, err := validate.ValidatedOracleSpecToml(o.App.Config, fmt.Sprintf(`
+type               = "offchainreporting2"
+relay              = "evm"
+schemaVersion      = 1
+pluginType         = "median"
+name               = "oracle%d"
+contractID         = "%s"
+ocrKeyBundleID     = "%s"
+transmitterID      = "%s"
+contractConfigConfirmations = 1
+contractConfigTrackerPollInterval = "1s"
+observationSource  = """
+%s
+"""
+[relayConfig]
+chainID = %d
+[pluginConfig]
+juelsPerFeeCoinSource = """
+%s
+"""
+`, i, s.ContractAddress, s.keyBundles[i].ID(), o.Transmitter, source, s.Backend.Blockchain().Config().ChainID, source))
+		require.NoError(t, err)
+		require.NoError(t, o.App.AddJobV2(context.Background(), &jb))
+		o.JobID = jb.ID
+	}
+
+	s.startBlocks(t)
+}
+
+// WaitForAnswer waits until the aggregator reports the wanted answer.
+func (s *OCR2Simulation) WaitForAnswer(t *testing.T, want *big.Int) {
+	t.Helper()
+
+	waitForAnswer(t, want, func() (*big.Int, error) {
+		return s.Contract.LatestAnswer(nil)
+	})
+}
@@ -0,0 +1,259 @@
+// Package ocrsim provides a harness which runs OCR and OCR2 oracles in-process
+// against a simulated backend.
+//
+// Every node of a simulation is a full chainlink application with its own
+// database, and the nodes communicate with each other over an in-memory
+// network in place of the libocr networking stack. This allows custom
+// pipelines and config changes to be exercised end-to-end, both locally and in
+// CI, without a real peer network or any external services other than the
+// test database.
+//
+// A simulation is created with NewOCR or NewOCR2, started with Start and then
+// asserted on with WaitForRuns and WaitForAnswer. Simulations must not be run
+// in parallel, as the databases of their nodes are named by node index.
+package ocrsim
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/onsi/gomega"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrnetworking "github.com/smartcontractkit/libocr/networking"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// DefaultNumOracles is the number of oracles which are run when the number
+	// is not specified in the options.
+	DefaultNumOracles = 4
+	// DefaultBlockTime is the interval at which blocks are committed to the
+	// simulated backend when it is not specified in the options.
+	DefaultBlockTime = time.Second
+	// bootstrapIndex is the index passed to Options.ConfigureNode for the
+	// bootstrap node.
+	bootstrapIndex = -1
+	// p2pAddress is the P2P address of every node. It is required by the
+	// config and job specs, but is never listened on as the nodes communicate
+	// over an in-memory network.
+	p2pAddress = "127.0.0.1:6690"
+)
+
+// Options configures a simulation.
+type Options struct {
+	// NumOracles is the number of oracles, excluding the bootstrap node.
+	NumOracles int
+	// ObservationSource returns the pipeline which the oracle at index i uses
+	// to make observations. Defaults to DefaultObservationSource.
+	ObservationSource func(i int) string
+	// ConfigureNode is called with the config of every node before the node is
+	// started, and can be used to test config changes. The bootstrap node is
+	// passed an index of -1.
+	ConfigureNode func(i int, cfg *configtest.TestGeneralConfig)
+	// BlockTime is the interval at which blocks are committed to the
+	// simulated backend once the simulation is started.
+	BlockTime time.Duration
+}
+
+func (o *Options) setDefaults() {
+	if o.NumOracles == 0 {
+		o.NumOracles = DefaultNumOracles
+	}
+	if o.ObservationSource == nil {
+		o.ObservationSource = DefaultObservationSource
+	}
+	if o.BlockTime == 0 {
+		o.BlockTime = DefaultBlockTime
+	}
+}
+
+// DefaultObservationSource returns a pipeline which observes 10*i for the
+// oracle at index i, without depending on any external data source. With the
+// default of 4 oracles the median answer is 20.
+func DefaultObservationSource(i int) string {
+	return fmt.Sprintf(`
+    ds          [type=memo value=10];
+    ds_multiply [type=multiply times=%d];
+
+    ds -> ds_multiply;
+`, i)
+}
+
+// Node is a chainlink node which takes part in a simulation.
+type Node struct {
+	App         *cltest.TestApplication
+	Config      *configtest.TestGeneralConfig
+	PeerID      string
+	Transmitter common.Address
+	// JobID is the id of the OCR job which the node runs. It is set once the
+	// simulation is started.
+	JobID int32
+}
+
+// Simulation holds the state which is shared by the OCR and OCR2
+// simulations.
+type Simulation struct {
+	Owner     *bind.TransactOpts
+	Backend   *backends.SimulatedBackend
+	Bootstrap *Node
+	Oracles   []*Node
+
+	opts       Options
+	network    *network
+	stopBlocks func()
+}
+
+func newSimulation(t *testing.T, opts Options) *Simulation {
+	testutils.SkipShort(t, "ocrsim runs full nodes")
+	opts.setDefaults()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "failed to generate ethereum identity")
+	owner := cltest.MustNewSimulatedBackendKeyedTransactor(t, key)
+	balance, _ := new(big.Int).SetString("100000000000000000000000", 10) // 1000 eth
+	genesisData := core.GenesisAlloc{owner.From: {Balance: balance}}
+	gasLimit := ethconfig.Defaults.Miner.GasCeil * 2
+
+	return &Simulation{
+		Owner:   owner,
+		Backend: cltest.NewSimulatedBackend(t, genesisData, gasLimit),
+		opts:    opts,
+		network: newNetwork(),
+	}
+}
+
+// newNode creates and starts a node on the simulated backend. configure is
+// called with the config of the node before Options.ConfigureNode.
+func (s *Simulation) newNode(t *testing.T, i int, configure func(cfg *configtest.TestGeneralConfig)) *Node {
+	cfg, _ := heavyweight.FullTestDB(t, fmt.Sprintf("ocrsim_%d", i-bootstrapIndex))
+	// Disables OCR spec validation so that the simulation can poll quickly
+	cfg.Overrides.Dev = null.BoolFrom(true)
+	cfg.Overrides.P2PEnabled = null.BoolFrom(true)
+	cfg.Overrides.P2PNetworkingStack = ocrnetworking.NetworkingStackV2
+	cfg.Overrides.P2PListenPort = null.NewInt(0, true)
+	cfg.Overrides.P2PV2ListenAddresses = []string{p2pAddress}
+	configure(cfg)
+
+	app := cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t, cfg, s.Backend, s.network)
+	p2pKey, err := app.GetKeyStore().P2P().Create()
+	require.NoError(t, err)
+	cfg.Overrides.P2PPeerID = p2pKey.PeerID()
+
+	if s.opts.ConfigureNode != nil {
+		s.opts.ConfigureNode(i, cfg)
+	}
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	sendingKeys, err := app.KeyStore.Eth().SendingKeys(nil)
+	require.NoError(t, err)
+	require.Len(t, sendingKeys, 1)
+
+	node := &Node{
+		App:         app,
+		Config:      cfg,
+		PeerID:      p2pKey.PeerID().Raw(),
+		Transmitter: sendingKeys[0].Address.Address(),
+	}
+	s.fund(t, node.Transmitter)
+
+	return node
+}
+
+// fund sends ETH from the owner to the address.
+func (s *Simulation) fund(t *testing.T, address common.Address) {
+	n, err := s.Backend.NonceAt(context.Background(), s.Owner.From, nil)
+	require.NoError(t, err)
+
+	tx := types.NewTransaction(n, address, assets.Ether(100), 21000, big.NewInt(1000000000), nil)
+	signedTx, err := s.Owner.Signer(s.Owner.From, tx)
+	require.NoError(t, err)
+	require.NoError(t, s.Backend.SendTransaction(context.Background(), signedTx))
+	s.Backend.Commit()
+}
+
+// bootstrappers returns the locator of the bootstrap node.
+func (s *Simulation) bootstrappers() []commontypes.BootstrapperLocator {
+	return []commontypes.BootstrapperLocator{
+		{
+			PeerID: s.Bootstrap.PeerID,
+			Addrs:  []string{p2pAddress},
+		},
+	}
+}
+
+// transmitters returns the transmitter addresses of the oracles.
+func (s *Simulation) transmitters() []common.Address {
+	var transmitters []common.Address
+	for _, o := range s.Oracles {
+		transmitters = append(transmitters, o.Transmitter)
+	}
+
+	return transmitters
+}
+
+// startBlocks commits a block to the simulated backend every block time until
+// the test completes.
+func (s *Simulation) startBlocks(t *testing.T) {
+	s.stopBlocks = utils.FiniteTicker(s.opts.BlockTime, s.Backend.Commit)
+	t.Cleanup(s.stopBlocks)
+}
+
+// WaitForRuns waits until every oracle has completed at least n pipeline runs
+// and returns the completed runs, indexed by oracle.
+func (s *Simulation) WaitForRuns(t *testing.T, n int) [][]pipeline.Run {
+	t.Helper()
+
+	runs := make([][]pipeline.Run, len(s.Oracles))
+	for i, o := range s.Oracles {
+		jobID := o.JobID
+		gomega.NewWithT(t).Eventually(func() int {
+			prs, _, err := o.App.JobORM().PipelineRuns(&jobID, 0, 1000)
+			require.NoError(t, err)
+
+			runs[i] = nil
+			for _, pr := range prs {
+				if pr.State == pipeline.RunStatusCompleted {
+					runs[i] = append(runs[i], pr)
+				}
+			}
+
+			return len(runs[i])
+		}, cltest.WaitTimeout(t), cltest.DBPollingInterval).Should(
+			gomega.BeNumerically(">=", n),
+			fmt.Sprintf("expected at least %d completed runs on oracle %d", n, i),
+		)
+	}
+
+	return runs
+}
+
+// waitForAnswer waits until latestAnswer returns the wanted answer.
+func waitForAnswer(t *testing.T, want *big.Int, latestAnswer func() (*big.Int, error)) {
+	t.Helper()
+
+	gomega.NewWithT(t).Eventually(func() string {
+		answer, err := latestAnswer()
+		require.NoError(t, err)
+		return answer.String()
+	}, cltest.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(want.String()))
+}
@@ -0,0 +1,26 @@
+package ocrsim_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/ocrsim"
+)
+
+func TestOCRSimulation(t *testing.T) {
+	s := ocrsim.NewOCR(t, ocrsim.Options{})
+	s.Start(t)
+
+	s.WaitForRuns(t, 2)
+	// 4 oracles observing 0, 10, 20, 30. Answer should be 20 (results[4/2]).
+	s.WaitForAnswer(t, big.NewInt(20))
+}
+
+func TestOCR2Simulation(t *testing.T) {
+	s := ocrsim.NewOCR2(t, ocrsim.Options{})
+	s.Start(t)
+
+	s.WaitForRuns(t, 2)
+	// 4 oracles observing 0, 10, 20, 30. Answer should be 20 (results[4/2]).
+	s.WaitForAnswer(t, big.NewInt(20))
+}
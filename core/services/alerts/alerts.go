@@ -0,0 +1,67 @@
+package alerts
+
+import (
+	"context"
+	"time"
+)
+
+// Type is the kind of event an alert is raised for.
+type Type string
+
+const (
+	// TypeNoLiveRPCNodes is raised when none of the primary RPC nodes of a
+	// chain are alive.
+	TypeNoLiveRPCNodes Type = "no_live_rpc_nodes"
+	// TypeLowKeyBalance is raised when the balance of a key drops below
+	// ETH_BALANCE_ALERT_THRESHOLD_WEI.
+	TypeLowKeyBalance Type = "low_key_balance"
+	// TypeStuckTransaction is raised when a transaction has been unconfirmed
+	// for ETH_TX_STUCK_ALERT_BLOCKS.
+	TypeStuckTransaction Type = "stuck_transaction"
+	// TypeJobSpecError is raised when an error is recorded for a job.
+	TypeJobSpecError Type = "job_spec_error"
+	// TypeLeaseLockLost is raised when another node takes the database lease
+	// lock, right before this node exits.
+	TypeLeaseLockLost Type = "lease_lock_lost"
+)
+
+// Severity is the severity of an alert.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityWarning  Severity = "warning"
+)
+
+// Alert is a structured notification of a node event which needs the
+// attention of an operator.
+type Alert struct {
+	Type     Type     `json:"type"`
+	Severity Severity `json:"severity"`
+	// Key identifies the subject of the alert, e.g. a chain or an address.
+	// Alerts with the same Type and Key are deduplicated.
+	Key     string            `json:"key"`
+	Summary string            `json:"summary"`
+	Details map[string]string `json:"details,omitempty"`
+	Time    time.Time         `json:"time"`
+}
+
+// Notifier sends alerts.
+type Notifier interface {
+	// Notify queues alert to be sent. It does not block.
+	Notify(alert Alert)
+	// Flush waits for the queued alerts to be sent, or for ctx to be done.
+	Flush(ctx context.Context)
+}
+
+// NullNotifier drops all alerts. It is used when no alert webhook is
+// configured.
+type NullNotifier struct{}
+
+var _ Notifier = NullNotifier{}
+
+// Notify implements Notifier.
+func (NullNotifier) Notify(Alert) {}
+
+// Flush implements Notifier.
+func (NullNotifier) Flush(context.Context) {}
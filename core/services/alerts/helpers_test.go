@@ -0,0 +1,11 @@
+package alerts
+
+import "time"
+
+func SetBackoff(w *WebhookNotifier, min, max time.Duration) {
+	w.backoff.Min = min
+	w.backoff.Max = max
+	w.backoff.Jitter = false
+}
+
+const QueueSize = queueSize
@@ -0,0 +1,313 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+const (
+	// queueSize is the number of alerts which can wait to be sent to each
+	// webhook, further alerts are dropped.
+	queueSize = 100
+	// maxAttempts is the number of times an alert is sent to a webhook before
+	// giving up, if the webhook fails with a network error, a 429 or a 5xx.
+	maxAttempts    = 5
+	requestTimeout = 10 * time.Second
+	// closeTimeout is how long Close waits for the queued alerts to be sent.
+	closeTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the webhook notifier.
+type Config interface {
+	AlertWebhookURLs() []url.URL
+	AlertWebhookTemplate() string
+	AlertDedupeInterval() time.Duration
+}
+
+// WebhookNotifier POSTs alerts to the webhooks in ALERT_WEBHOOK_URLS. Each
+// webhook has its own queue and worker, so that a slow webhook does not delay
+// the others.
+type WebhookNotifier struct {
+	utils.StartStopOnce
+	cfg      Config
+	lggr     logger.Logger
+	client   *http.Client
+	tmpl     *template.Template
+	backoff  backoff.Backoff
+	webhooks []webhook
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+
+	// pending counts the queued alerts which have not been sent yet. chIdle
+	// is closed whenever pending is zero, for Flush to wait on. Once stopping
+	// is set by Close, no more alerts are queued.
+	pendingMu sync.Mutex
+	pending   int
+	chIdle    chan struct{}
+	stopping  bool
+
+	lastSentMu sync.Mutex
+	lastSent   map[string]time.Time
+}
+
+// webhook is the queue of alerts to send to a webhook URL.
+type webhook struct {
+	url      url.URL
+	chAlerts chan Alert
+}
+
+var _ Notifier = &WebhookNotifier{}
+
+// NewWebhookNotifier returns a new WebhookNotifier.
+func NewWebhookNotifier(cfg Config, lggr logger.Logger) (*WebhookNotifier, error) {
+	tmpl, err := ParseTemplate(cfg.AlertWebhookTemplate())
+	if err != nil {
+		return nil, err
+	}
+	var webhooks []webhook
+	for _, u := range cfg.AlertWebhookURLs() {
+		webhooks = append(webhooks, webhook{u, make(chan Alert, queueSize)})
+	}
+	chIdle := make(chan struct{})
+	close(chIdle)
+	return &WebhookNotifier{
+		cfg:    cfg,
+		lggr:   lggr.Named("AlertWebhookNotifier"),
+		client: clhttp.NewUnrestrictedHTTPClient(),
+		tmpl:   tmpl,
+		backoff: backoff.Backoff{
+			Min:    1 * time.Second,
+			Max:    30 * time.Second,
+			Factor: 2,
+			Jitter: true,
+		},
+		webhooks: webhooks,
+		chStop:   make(chan struct{}),
+		chIdle:   chIdle,
+		lastSent: make(map[string]time.Time),
+	}, nil
+}
+
+// ParseTemplate parses an ALERT_WEBHOOK_TEMPLATE. The template is executed
+// with an Alert to render the body of the requests, and its json function
+// encodes a value as JSON. An empty template returns nil, in which case the
+// body is the Alert encoded as JSON.
+func ParseTemplate(s string) (*template.Template, error) {
+	if s == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("alert").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Option("missingkey=zero").Parse(s)
+	return tmpl, errors.Wrap(err, "invalid ALERT_WEBHOOK_TEMPLATE")
+}
+
+// Start starts sending alerts.
+func (w *WebhookNotifier) Start(context.Context) error {
+	return w.StartOnce("AlertWebhookNotifier", func() error {
+		for _, hook := range w.webhooks {
+			w.wgDone.Add(1)
+			go w.run(hook)
+		}
+		w.lggr.Infow("Sending alerts to webhooks", "webhooks", len(w.webhooks), "dedupeInterval", w.cfg.AlertDedupeInterval())
+		return nil
+	})
+}
+
+// Close stops sending alerts, after waiting up to closeTimeout for the queued
+// alerts to be sent. Alerts which have not been sent by then are dropped, as
+// are alerts raised once Close is called.
+func (w *WebhookNotifier) Close() error {
+	return w.StopOnce("AlertWebhookNotifier", func() error {
+		w.pendingMu.Lock()
+		w.stopping = true
+		w.pendingMu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+		defer cancel()
+		w.Flush(ctx)
+		close(w.chStop)
+		w.wgDone.Wait()
+		return nil
+	})
+}
+
+// Notify implements Notifier. Alerts with the same type and key as an alert
+// queued less than ALERT_DEDUPE_INTERVAL ago are dropped.
+func (w *WebhookNotifier) Notify(alert Alert) {
+	if alert.Time.IsZero() {
+		alert.Time = time.Now()
+	}
+	key := string(alert.Type) + "/" + alert.Key
+
+	// The lock is held until the alert is queued, so that an alert raised
+	// concurrently is either queued or seen as a duplicate
+	w.lastSentMu.Lock()
+	defer w.lastSentMu.Unlock()
+	if w.isDuplicate(key, alert.Time) {
+		w.lggr.Debugw("Dropping duplicate alert", "type", alert.Type, "key", alert.Key)
+		return
+	}
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	if w.stopping {
+		w.lggr.Debugw("Notifier is stopping, dropping alert", "type", alert.Type, "key", alert.Key)
+		return
+	}
+	var queued bool
+	for _, hook := range w.webhooks {
+		select {
+		case hook.chAlerts <- alert:
+			queued = true
+			if w.pending == 0 {
+				w.chIdle = make(chan struct{})
+			}
+			w.pending++
+		default:
+			w.lggr.Warnw("Alert queue is full, dropping alert", "host", hook.url.Host, "type", alert.Type, "key", alert.Key, "summary", alert.Summary)
+		}
+	}
+	// An alert dropped by every queue can be raised again right away
+	if queued {
+		w.lastSent[key] = alert.Time
+	}
+}
+
+// Flush implements Notifier.
+func (w *WebhookNotifier) Flush(ctx context.Context) {
+	w.pendingMu.Lock()
+	chIdle := w.chIdle
+	w.pendingMu.Unlock()
+	select {
+	case <-chIdle:
+	case <-ctx.Done():
+	}
+}
+
+// sent marks a queued alert as sent or dropped.
+func (w *WebhookNotifier) sent() {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+	w.pending--
+	if w.pending == 0 {
+		close(w.chIdle)
+	}
+}
+
+// isDuplicate returns whether an alert with key was queued less than
+// ALERT_DEDUPE_INTERVAL before t. lastSentMu must be held.
+func (w *WebhookNotifier) isDuplicate(key string, t time.Time) bool {
+	interval := w.cfg.AlertDedupeInterval()
+	for k, sent := range w.lastSent {
+		if t.Sub(sent) >= interval {
+			delete(w.lastSent, k)
+		}
+	}
+	_, exists := w.lastSent[key]
+	return exists
+}
+
+func (w *WebhookNotifier) run(hook webhook) {
+	defer w.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(w.chStop)
+	defer cancel()
+	for {
+		select {
+		case <-w.chStop:
+			// Release Flush for the alerts which are dropped
+			for {
+				select {
+				case <-hook.chAlerts:
+					w.sent()
+				default:
+					return
+				}
+			}
+		case alert := <-hook.chAlerts:
+			w.send(ctx, hook.url, alert)
+			w.sent()
+		}
+	}
+}
+
+func (w *WebhookNotifier) send(ctx context.Context, u url.URL, alert Alert) {
+	body, err := w.payload(alert)
+	if err != nil {
+		w.lggr.Errorw("Failed to render alert", "type", alert.Type, "key", alert.Key, "err", err)
+		return
+	}
+	// Webhook URLs often contain a secret, so only the host is logged
+	if err := w.post(ctx, u, body); err != nil && ctx.Err() == nil {
+		w.lggr.Errorw("Failed to send alert to webhook", "host", u.Host, "type", alert.Type, "key", alert.Key, "err", err)
+	}
+}
+
+func (w *WebhookNotifier) payload(alert Alert) ([]byte, error) {
+	if w.tmpl == nil {
+		return json.Marshal(alert)
+	}
+	var buf bytes.Buffer
+	if err := w.tmpl.Execute(&buf, alert); err != nil {
+		return nil, errors.Wrap(err, "failed to execute ALERT_WEBHOOK_TEMPLATE")
+	}
+	return buf.Bytes(), nil
+}
+
+// post sends body to the webhook at u, backing off between attempts.
+func (w *WebhookNotifier) post(ctx context.Context, u url.URL, body []byte) error {
+	b := w.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := w.postOnce(ctx, u, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == maxAttempts {
+			return errors.Wrapf(err, "giving up after %d attempt(s)", attempt)
+		}
+		w.lggr.Debugw("Failed to send alert to webhook, retrying", "host", u.Host, "attempt", attempt, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(b.Duration()):
+		}
+	}
+}
+
+func (w *WebhookNotifier) postOnce(ctx context.Context, u url.URL, body []byte) (retry bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer w.lggr.ErrorIfClosing(resp.Body, "alert webhook response body")
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<12))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, errors.Errorf("webhook responded with status %d", resp.StatusCode)
+}
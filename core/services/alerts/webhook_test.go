@@ -0,0 +1,284 @@
+package alerts_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+)
+
+type testConfig struct {
+	urls           []url.URL
+	template       string
+	dedupeInterval time.Duration
+}
+
+func (c testConfig) AlertWebhookURLs() []url.URL        { return c.urls }
+func (c testConfig) AlertWebhookTemplate() string       { return c.template }
+func (c testConfig) AlertDedupeInterval() time.Duration { return c.dedupeInterval }
+
+// webhook is a local HTTP server which records the bodies of the requests it
+// receives, and responds with the given status codes in turn.
+type webhook struct {
+	*httptest.Server
+	mu       sync.Mutex
+	bodies   []string
+	statuses []int
+	// release, if set, blocks the responses until it is closed
+	release  chan struct{}
+	received chan struct{}
+}
+
+func newWebhook(t *testing.T, statuses ...int) *webhook {
+	w := &webhook{statuses: statuses}
+	w.start(t)
+	return w
+}
+
+// newSlowWebhook returns a webhook which does not respond until release is
+// closed, and which signals received on the first request.
+func newSlowWebhook(t *testing.T) *webhook {
+	w := &webhook{release: make(chan struct{}), received: make(chan struct{})}
+	w.start(t)
+	return w
+}
+
+func (w *webhook) start(t *testing.T) {
+	var receivedOnce sync.Once
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.mu.Lock()
+		w.bodies = append(w.bodies, string(b))
+		status := http.StatusOK
+		if len(w.statuses) > 0 {
+			status, w.statuses = w.statuses[0], w.statuses[1:]
+		}
+		w.mu.Unlock()
+		if w.release != nil {
+			receivedOnce.Do(func() { close(w.received) })
+			<-w.release
+		}
+		rw.WriteHeader(status)
+	}))
+	t.Cleanup(w.Close)
+}
+
+func (w *webhook) URL() url.URL {
+	u, err := url.Parse(w.Server.URL)
+	if err != nil {
+		panic(err)
+	}
+	return *u
+}
+
+func (w *webhook) Bodies() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.bodies...)
+}
+
+func newNotifier(t *testing.T, cfg testConfig) *alerts.WebhookNotifier {
+	n, err := alerts.NewWebhookNotifier(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+	alerts.SetBackoff(n, time.Millisecond, 10*time.Millisecond)
+	require.NoError(t, n.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, n.Close()) })
+	return n
+}
+
+func lowBalanceAlert() alerts.Alert {
+	return alerts.Alert{
+		Type:     alerts.TypeLowKeyBalance,
+		Severity: alerts.SeverityWarning,
+		Key:      "0/0xabc",
+		Summary:  "low balance",
+		Details:  map[string]string{"balanceWei": "42"},
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("sends alerts as JSON to every webhook", func(t *testing.T) {
+		w1, w2 := newWebhook(t), newWebhook(t)
+		n := newNotifier(t, testConfig{urls: []url.URL{w1.URL(), w2.URL()}, dedupeInterval: time.Hour})
+
+		n.Notify(lowBalanceAlert())
+		n.Flush(testutils.Context(t))
+
+		for _, w := range []*webhook{w1, w2} {
+			bodies := w.Bodies()
+			require.Len(t, bodies, 1)
+			var got alerts.Alert
+			require.NoError(t, json.Unmarshal([]byte(bodies[0]), &got))
+			assert.Equal(t, alerts.TypeLowKeyBalance, got.Type)
+			assert.Equal(t, alerts.SeverityWarning, got.Severity)
+			assert.Equal(t, "0/0xabc", got.Key)
+			assert.Equal(t, "low balance", got.Summary)
+			assert.Equal(t, map[string]string{"balanceWei": "42"}, got.Details)
+			assert.False(t, got.Time.IsZero())
+		}
+	})
+
+	t.Run("renders the template", func(t *testing.T) {
+		w := newWebhook(t)
+		tmpl := `{"text": {{json (printf "[%s] %s (%s)" .Severity .Summary (index .Details "balanceWei"))}}}`
+		n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, template: tmpl, dedupeInterval: time.Hour})
+
+		n.Notify(lowBalanceAlert())
+		n.Flush(testutils.Context(t))
+
+		assert.Equal(t, []string{`{"text": "[warning] low balance (42)"}`}, w.Bodies())
+	})
+
+	t.Run("deduplicates alerts with the same type and key", func(t *testing.T) {
+		w := newWebhook(t)
+		n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour})
+
+		a := lowBalanceAlert()
+		n.Notify(a)
+		n.Notify(a)
+		other := a
+		other.Key = "0/0xdef"
+		n.Notify(other)
+		stuck := a
+		stuck.Type = alerts.TypeStuckTransaction
+		n.Notify(stuck)
+		n.Flush(testutils.Context(t))
+		require.Len(t, w.Bodies(), 3)
+
+		// Once the interval has passed, the alert is sent again
+		later := a
+		later.Time = time.Now().Add(time.Hour)
+		n.Notify(later)
+		n.Flush(testutils.Context(t))
+		require.Len(t, w.Bodies(), 4)
+	})
+
+	t.Run("retries server errors with back-off", func(t *testing.T) {
+		w := newWebhook(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK)
+		n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour})
+
+		n.Notify(lowBalanceAlert())
+		n.Flush(testutils.Context(t))
+
+		assert.Len(t, w.Bodies(), 3)
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		statuses := make([]int, 10)
+		for i := range statuses {
+			statuses[i] = http.StatusInternalServerError
+		}
+		w := newWebhook(t, statuses...)
+		n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour})
+
+		n.Notify(lowBalanceAlert())
+		n.Flush(testutils.Context(t))
+
+		assert.Len(t, w.Bodies(), 5)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		w := newWebhook(t, http.StatusBadRequest)
+		n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour})
+
+		n.Notify(lowBalanceAlert())
+		n.Flush(testutils.Context(t))
+
+		assert.Len(t, w.Bodies(), 1)
+	})
+}
+
+func TestWebhookNotifier_SlowWebhook(t *testing.T) {
+	slow, fast := newSlowWebhook(t), newWebhook(t)
+	n := newNotifier(t, testConfig{urls: []url.URL{slow.URL(), fast.URL()}, dedupeInterval: time.Hour})
+	defer close(slow.release)
+
+	n.Notify(lowBalanceAlert())
+	<-slow.received
+	other := lowBalanceAlert()
+	other.Key = "0/0xdef"
+	n.Notify(other)
+
+	// The fast webhook receives both alerts while the slow one is stuck on the first
+	assert.Eventually(t, func() bool { return len(fast.Bodies()) == 2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	assert.Len(t, slow.Bodies(), 1)
+}
+
+func TestWebhookNotifier_QueueFull(t *testing.T) {
+	w := newSlowWebhook(t)
+	n := newNotifier(t, testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour})
+
+	// The first alert is in flight, the next ones fill the queue
+	n.Notify(lowBalanceAlert())
+	<-w.received
+	for i := 0; i < alerts.QueueSize; i++ {
+		a := lowBalanceAlert()
+		a.Key = fmt.Sprintf("queued %d", i)
+		n.Notify(a)
+	}
+	dropped := lowBalanceAlert()
+	dropped.Key = "dropped"
+	n.Notify(dropped)
+
+	close(w.release)
+	n.Flush(testutils.Context(t))
+	require.Len(t, w.Bodies(), 1+alerts.QueueSize)
+
+	// The dropped alert is not deduplicated, so it is sent when raised again
+	n.Notify(dropped)
+	n.Flush(testutils.Context(t))
+	bodies := w.Bodies()
+	require.Len(t, bodies, 2+alerts.QueueSize)
+	assert.Contains(t, bodies[len(bodies)-1], `"key":"dropped"`)
+}
+
+func TestWebhookNotifier_Close(t *testing.T) {
+	w := newWebhook(t)
+	n, err := alerts.NewWebhookNotifier(testConfig{urls: []url.URL{w.URL()}, dedupeInterval: time.Hour}, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, n.Start(testutils.Context(t)))
+
+	// Close sends the queued alerts
+	n.Notify(lowBalanceAlert())
+	require.NoError(t, n.Close())
+	assert.Len(t, w.Bodies(), 1)
+
+	// Alerts raised after Close are dropped rather than left in the queue,
+	// so Flush does not wait for them
+	other := lowBalanceAlert()
+	other.Key = "0/0xdef"
+	n.Notify(other)
+	ctx, cancel := context.WithTimeout(testutils.Context(t), time.Second)
+	defer cancel()
+	n.Flush(ctx)
+	assert.NoError(t, ctx.Err())
+	assert.Len(t, w.Bodies(), 1)
+}
+
+func TestParseTemplate(t *testing.T) {
+	tmpl, err := alerts.ParseTemplate("")
+	require.NoError(t, err)
+	assert.Nil(t, tmpl)
+
+	_, err = alerts.ParseTemplate(`{"text": {{json .Summary}}}`)
+	require.NoError(t, err)
+
+	_, err = alerts.ParseTemplate(`{"text": {{.Summary}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ALERT_WEBHOOK_TEMPLATE")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
+	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
 
@@ -22,6 +23,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/chains/solana"
@@ -29,11 +31,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/healthreport"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -50,20 +54,39 @@ import (
 	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// Role is the role of a node in an active/standby deployment.
+type Role string
+
+const (
+	// RoleLeader is the role of the node holding the DB locks and running jobs.
+	RoleLeader Role = "leader"
+	// RoleStandby is the role of a node kept warm while waiting for the DB locks.
+	RoleStandby Role = "standby"
+)
+
 //go:generate mockery --name Application --output ../../internal/mocks/ --case=underscore
 
 // Application implements the common functions used in the core node.
 type Application interface {
 	Start(ctx context.Context) error
+	StartStandby(ctx context.Context) error
 	Stop() error
+	GetRole() Role
 	GetLogger() logger.Logger
 	GetHealthChecker() services.Checker
+	GetHealthReporter() healthreport.Reporter
+	// GetCircuitBreakers returns the circuit breakers of the hosts which
+	// pipeline tasks make HTTP requests to
+	GetCircuitBreakers() *pipeline.HostCircuitBreakers
+	// GetNurse returns nil unless AUTO_PPROF_ENABLED is set
+	GetNurse() *services.Nurse
 	GetSqlxDB() *sqlx.DB
 	GetConfig() config.GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
@@ -84,9 +107,14 @@ type Application interface {
 	SessionORM() sessions.ORM
 	TxmORM() txmgr.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
+	// ImportJobs creates the bridges and jobs in a single transaction, and
+	// starts the jobs once it has committed. Jobs that fail to start stay
+	// created, startErrs holds the error of each job by index.
+	ImportJobs(ctx context.Context, bts []*bridges.BridgeType, jbs []*job.Job) (startErrs []error, err error)
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	ReplayJobRunV2(ctx context.Context, runID int64) (pipeline.ReplayResult, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -97,6 +125,10 @@ type Application interface {
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
+	// ReplayTargeted replays the logs of a block range, restricted to the jobs and contract
+	// addresses of the request. When no jobs are given and the log poller is enabled, the
+	// block range is also replayed into the log poller.
+	ReplayTargeted(chainID *big.Int, req log.ReplayRequest) (log.ReplayProgress, error)
 
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
@@ -125,12 +157,15 @@ type ChainlinkApplication struct {
 	explorerClient           synchronization.ExplorerClient
 	subservices              []services.ServiceCtx
 	HealthChecker            services.Checker
+	HealthReporter           healthreport.Reporter
+	CircuitBreakers          *pipeline.HostCircuitBreakers
 	Nurse                    *services.Nurse
 	logger                   logger.Logger
 	closeLogger              func() error
 	sqlxDB                   *sqlx.DB
 
 	started     bool
+	standby     atomic.Bool
 	startStopMu sync.Mutex
 }
 
@@ -146,6 +181,9 @@ type ApplicationOpts struct {
 	Version                  string
 	RestrictedHTTPClient     *http.Client
 	UnrestrictedHTTPClient   *http.Client
+	AlertNotifier            alerts.Notifier
+	// PeerNetwork replaces the OCR networking stack if set
+	PeerNetwork ocrcommon.PeerNetwork
 }
 
 // Chains holds a ChainSet for each type of chain.
@@ -197,12 +235,20 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
 	}
 
+	// Tracing is started first so that it is closed last, after the services
+	// which record spans
+	if cfg.TracingEnabled() {
+		subservices = append(subservices, tracing.NewProvider(cfg, globalLogger))
+	}
+
 	healthChecker := services.NewChecker()
 
 	telemetryIngressClient := synchronization.TelemetryIngressClient(&synchronization.NoopTelemetryIngressClient{})
 	telemetryIngressBatchClient := synchronization.TelemetryIngressBatchClient(&synchronization.NoopTelemetryIngressBatchClient{})
 	explorerClient := synchronization.ExplorerClient(&synchronization.NoopExplorerClient{})
 	monitoringEndpointGen := telemetry.MonitoringEndpointGenerator(&telemetry.NoopAgent{})
+	// telemetryIngress is the telemetry ingress client in use, if any
+	var telemetryIngress services.Checkable
 
 	if cfg.ExplorerURL() != nil && cfg.TelemetryIngressURL() != nil {
 		globalLogger.Warn("Both EXPLORER_URL and TELEMETRY_INGRESS_URL are set, defaulting to Explorer")
@@ -219,11 +265,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			telemetryIngressBatchClient = synchronization.NewTelemetryIngressBatchClient(cfg.TelemetryIngressURL(),
 				cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging(), globalLogger, cfg.TelemetryIngressBufferSize(), cfg.TelemetryIngressMaxBatchSize(), cfg.TelemetryIngressSendInterval(), cfg.TelemetryIngressSendTimeout(), cfg.TelemetryIngressUniConn())
 			monitoringEndpointGen = telemetry.NewIngressAgentBatchWrapper(telemetryIngressBatchClient)
+			telemetryIngress = telemetryIngressBatchClient
 
 		} else {
 			telemetryIngressClient = synchronization.NewTelemetryIngressClient(cfg.TelemetryIngressURL(),
 				cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging(), globalLogger)
 			monitoringEndpointGen = telemetry.NewIngressAgentWrapper(telemetryIngressClient)
+			telemetryIngress = telemetryIngressClient
 		}
 	}
 	subservices = append(subservices, explorerClient, telemetryIngressClient, telemetryIngressBatchClient)
@@ -246,12 +294,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	subservices = append(subservices, promReporter)
 
 	var (
-		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
-		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
-		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
-		txmORM         = txmgr.NewORM(db, globalLogger, cfg)
+		pipelineORM     = pipeline.NewORM(db, globalLogger, cfg)
+		bridgeORM       = bridges.NewORM(db, globalLogger, cfg)
+		sessionORM      = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
+		circuitBreakers = pipeline.NewHostCircuitBreakers(cfg.HTTPCircuitBreakerThreshold(), cfg.HTTPCircuitBreakerTimeout())
+		pipelineRunner  = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, chains.Solana, chains.Terra, keyStore.Eth(), keyStore.VRF(), keyStore.Solana(), keyStore.Secrets(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient, circuitBreakers)
+		jobORM          = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg, opts.AlertNotifier)
+		txmORM          = txmgr.NewORM(db, globalLogger, cfg)
 	)
 
 	for _, chain := range chains.EVM.Chains() {
@@ -316,6 +365,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return nil, err
 		}
 		peerWrapper = ocrcommon.NewSingletonPeerWrapper(keyStore, cfg, db, globalLogger)
+		peerWrapper.Network = opts.PeerNetwork
 		subservices = append(subservices, peerWrapper)
 	} else {
 		globalLogger.Debug("P2P stack disabled")
@@ -410,6 +460,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		feedsService = &feeds.NullService{}
 	}
 
+	// The health reporter reports on the chains and the feeds service, so it
+	// is started after them
+	healthReporter := healthreport.NewReporter(db, cfg, globalLogger, chains.EVM, bridgeORM, feedsService, telemetryIngress)
+	subservices = append(subservices, healthReporter)
+
 	app := &ChainlinkApplication{
 		Chains:                   chains,
 		EventBroadcaster:         eventBroadcaster,
@@ -428,6 +483,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		ExternalInitiatorManager: externalInitiatorManager,
 		explorerClient:           explorerClient,
 		HealthChecker:            healthChecker,
+		HealthReporter:           healthReporter,
+		CircuitBreakers:          circuitBreakers,
 		Nurse:                    nurse,
 		logger:                   globalLogger,
 		closeLogger:              opts.CloseLogger,
@@ -509,10 +566,37 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 	}
 
 	app.started = true
+	app.standby.Store(false)
 
 	return nil
 }
 
+// StartStandby keeps the application warm while the node waits for the DB
+// locks: the EVM chains are dialed and track heads, but nothing is written to
+// the DB and no jobs are run. Start promotes the application to leader.
+func (app *ChainlinkApplication) StartStandby(ctx context.Context) error {
+	app.startStopMu.Lock()
+	defer app.startStopMu.Unlock()
+	if app.started {
+		panic("application is already started")
+	}
+
+	app.standby.Store(true)
+	app.logger.Info("Starting as standby")
+	if app.Chains.EVM == nil {
+		return nil
+	}
+	return app.Chains.EVM.StartStandby(ctx)
+}
+
+// GetRole returns whether the application is the leader or on standby.
+func (app *ChainlinkApplication) GetRole() Role {
+	if app.standby.Load() {
+		return RoleStandby
+	}
+	return RoleLeader
+}
+
 func (app *ChainlinkApplication) StopIfStarted() error {
 	app.startStopMu.Lock()
 	defer app.startStopMu.Unlock()
@@ -531,6 +615,17 @@ func (app *ChainlinkApplication) Stop() error {
 }
 
 func (app *ChainlinkApplication) stop() (err error) {
+	if !app.started && app.standby.Load() {
+		// A standby that was never promoted only has its chains to close
+		app.shutdownOnce.Do(func() {
+			app.logger.Info("Exiting standby...")
+			if app.Chains.EVM != nil {
+				err = app.Chains.EVM.Close()
+			}
+			err = multierr.Append(err, app.closeLogger())
+		})
+		return err
+	}
 	if !app.started {
 		panic("application is already stopped")
 	}
@@ -585,6 +680,18 @@ func (app *ChainlinkApplication) GetHealthChecker() services.Checker {
 	return app.HealthChecker
 }
 
+func (app *ChainlinkApplication) GetHealthReporter() healthreport.Reporter {
+	return app.HealthReporter
+}
+
+func (app *ChainlinkApplication) GetCircuitBreakers() *pipeline.HostCircuitBreakers {
+	return app.CircuitBreakers
+}
+
+func (app *ChainlinkApplication) GetNurse() *services.Nurse {
+	return app.Nurse
+}
+
 func (app *ChainlinkApplication) JobSpawner() job.Spawner {
 	return app.jobSpawner
 }
@@ -626,6 +733,34 @@ func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error
 	return app.jobSpawner.CreateJob(j, pg.WithParentCtx(ctx))
 }
 
+func (app *ChainlinkApplication) ImportJobs(ctx context.Context, bts []*bridges.BridgeType, jbs []*job.Job) (startErrs []error, err error) {
+	q := pg.NewQ(app.sqlxDB, app.logger, app.Config, pg.WithParentCtx(ctx))
+	err = q.Transaction(func(tx pg.Queryer) error {
+		for _, bt := range bts {
+			if err := app.bridgeORM.CreateBridgeType(bt, pg.WithQueryer(tx)); err != nil {
+				return errors.Wrapf(err, "failed to create bridge %s", bt.Name)
+			}
+		}
+		for _, jb := range jbs {
+			if err := app.jobORM.CreateJob(jb, pg.WithQueryer(tx)); err != nil {
+				return errors.Wrapf(err, "failed to create job %s", jb.ExternalJobID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	startErrs = make([]error, len(jbs))
+	for i, jb := range jbs {
+		if startErrs[i] = app.jobSpawner.StartCreatedJob(ctx, *jb); startErrs[i] != nil {
+			app.logger.Errorw("Imported job failed to start", "jobID", jb.ID, "err", startErrs[i])
+		}
+	}
+	return startErrs, nil
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	// Do not allow the job to be deleted if it is managed by the Feeds Manager
 	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
@@ -716,6 +851,15 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// ReplayJobRunV2 replays a finished pipeline run, without saving it.
+func (app *ChainlinkApplication) ReplayJobRunV2(ctx context.Context, runID int64) (pipeline.ReplayResult, error) {
+	run, err := app.pipelineORM.FindRun(runID)
+	if err != nil {
+		return pipeline.ReplayResult{}, errors.Wrapf(err, "run ID %v", runID)
+	}
+	return app.pipelineRunner.ReplayRun(ctx, run, app.logger)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -730,6 +874,18 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+// ReplayTargeted implements the Application interface.
+func (app *ChainlinkApplication) ReplayTargeted(chainID *big.Int, req log.ReplayRequest) (log.ReplayProgress, error) {
+	chain, err := app.Chains.EVM.Get(chainID)
+	if err != nil {
+		return log.ReplayProgress{}, err
+	}
+	if len(req.JobIDs) == 0 && chain.Config().FeatureLogPoller() {
+		req.LogPoller = chain.LogPoller()
+	}
+	return chain.LogBroadcaster().ReplayTargeted(req)
+}
+
 // GetChains returns Chains.
 func (app *ChainlinkApplication) GetChains() Chains {
 	return app.Chains
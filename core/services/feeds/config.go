@@ -3,14 +3,17 @@ package feeds
 import (
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore
 
 type Config interface {
+	validate.Config
 	Dev() bool
 	FeatureOffchainReporting() bool
+	FeatureOffchainReporting2() bool
 	DefaultHTTPTimeout() models.Duration
 	OCRBlockchainTimeout() time.Duration
 	OCRContractConfirmations() uint16
@@ -21,4 +24,5 @@ type Config interface {
 	OCRObservationTimeout() time.Duration
 	OCRObservationGracePeriod() time.Duration
 	LogSQL() bool
+	FeedsManagerHealthReportInterval() time.Duration
 }
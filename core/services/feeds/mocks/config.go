@@ -56,6 +56,48 @@ func (_m *Config) FeatureOffchainReporting() bool {
 	return r0
 }
 
+// FeatureOffchainReporting2 provides a mock function with given fields:
+func (_m *Config) FeatureOffchainReporting2() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FeedsManagerHealthReportInterval provides a mock function with given fields:
+func (_m *Config) FeedsManagerHealthReportInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// JobPipelineResultWriteQueueDepth provides a mock function with given fields:
+func (_m *Config) JobPipelineResultWriteQueueDepth() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // LogSQL provides a mock function with given fields:
 func (_m *Config) LogSQL() bool {
 	ret := _m.Called()
@@ -70,6 +112,139 @@ func (_m *Config) LogSQL() bool {
 	return r0
 }
 
+// OCR2BlockchainTimeout provides a mock function with given fields:
+func (_m *Config) OCR2BlockchainTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OCR2ContractConfirmations provides a mock function with given fields:
+func (_m *Config) OCR2ContractConfirmations() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// OCR2ContractPollInterval provides a mock function with given fields:
+func (_m *Config) OCR2ContractPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OCR2ContractSubscribeInterval provides a mock function with given fields:
+func (_m *Config) OCR2ContractSubscribeInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OCR2ContractTransmitterTransmitTimeout provides a mock function with given fields:
+func (_m *Config) OCR2ContractTransmitterTransmitTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OCR2DatabaseTimeout provides a mock function with given fields:
+func (_m *Config) OCR2DatabaseTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// OCR2KeyBundleID provides a mock function with given fields:
+func (_m *Config) OCR2KeyBundleID() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCR2MonitoringEndpoint provides a mock function with given fields:
+func (_m *Config) OCR2MonitoringEndpoint() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OCR2TraceLogging provides a mock function with given fields:
+func (_m *Config) OCR2TraceLogging() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // OCRBlockchainTimeout provides a mock function with given fields:
 func (_m *Config) OCRBlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -83,6 +83,29 @@ func (_m *FeedsManagerClient) RejectedJob(ctx context.Context, in *proto.Rejecte
 	return r0, r1
 }
 
+// UpdateHealth provides a mock function with given fields: ctx, in
+func (_m *FeedsManagerClient) UpdateHealth(ctx context.Context, in *proto.UpdateHealthRequest) (*proto.UpdateHealthResponse, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 *proto.UpdateHealthResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.UpdateHealthRequest) *proto.UpdateHealthResponse); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.UpdateHealthResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.UpdateHealthRequest) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateNode provides a mock function with given fields: ctx, in
 func (_m *FeedsManagerClient) UpdateNode(ctx context.Context, in *proto.UpdateNodeRequest) (*proto.UpdateNodeResponse, error) {
 	ret := _m.Called(ctx, in)
@@ -487,6 +487,36 @@ func (_m *ORM) ListChainConfigsByManagerIDs(mgrIDs []int64) ([]feeds.ChainConfig
 	return r0, r1
 }
 
+// ListJobHealthsByManagerID provides a mock function with given fields: mgrID, qopts
+func (_m *ORM) ListJobHealthsByManagerID(mgrID int64, qopts ...pg.QOpt) ([]feeds.JobHealth, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, mgrID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []feeds.JobHealth
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) []feeds.JobHealth); ok {
+		r0 = rf(mgrID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobHealth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(mgrID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListJobProposals provides a mock function with given fields:
 func (_m *ORM) ListJobProposals() ([]feeds.JobProposal, error) {
 	ret := _m.Called()
@@ -700,6 +730,27 @@ func (_m *ORM) UpdateManager(mgr feeds.FeedsManager, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// UpdateSpecApprovalPolicy provides a mock function with given fields: id, policy, qopts
+func (_m *ORM) UpdateSpecApprovalPolicy(id int64, policy feeds.ApprovalPolicyType, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, policy)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, feeds.ApprovalPolicyType, ...pg.QOpt) error); ok {
+		r0 = rf(id, policy, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSpecDefinition provides a mock function with given fields: id, spec, qopts
 func (_m *ORM) UpdateSpecDefinition(id int64, spec string, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -142,6 +142,29 @@ func (_m *Service) GetChainConfig(id int64) (*feeds.ChainConfig, error) {
 	return r0, r1
 }
 
+// GetHealthReport provides a mock function with given fields: ctx, id
+func (_m *Service) GetHealthReport(ctx context.Context, id int64) (*feeds.HealthReport, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *feeds.HealthReport
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.HealthReport); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.HealthReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobProposal provides a mock function with given fields: id
 func (_m *Service) GetJobProposal(id int64) (*feeds.JobProposal, error) {
 	ret := _m.Called(id)
@@ -440,6 +463,20 @@ func (_m *Service) Start() error {
 	return r0
 }
 
+// SyncHealth provides a mock function with given fields: id
+func (_m *Service) SyncHealth(id int64) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SyncNodeInfo provides a mock function with given fields: id
 func (_m *Service) SyncNodeInfo(id int64) error {
 	ret := _m.Called(id)
@@ -42,11 +42,157 @@ type FeedsManager struct {
 	Name               string
 	URI                string
 	PublicKey          crypto.PublicKey
+	ApprovalPolicies   ApprovalPolicies
 	IsConnectionActive bool
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
 
+// ApprovalPolicyType defines the types of policies which can automatically
+// approve or reject a job proposal spec when it is received.
+type ApprovalPolicyType string
+
+const (
+	// ApprovalPolicyAutoApproveUpdates approves a new spec version of an
+	// approved job proposal when the job type is listed in the policy (or the
+	// policy lists no job types) and the contract address of the job is
+	// unchanged.
+	ApprovalPolicyAutoApproveUpdates ApprovalPolicyType = "auto_approve_updates"
+	// ApprovalPolicyAutoRejectUnlistedJobTypes rejects a spec when the job type
+	// is not listed in the policy.
+	ApprovalPolicyAutoRejectUnlistedJobTypes ApprovalPolicyType = "auto_reject_unlisted_job_types"
+)
+
+// ApprovalPolicy defines a policy which is evaluated when a job proposal spec
+// is received from the feeds manager.
+type ApprovalPolicy struct {
+	Type     ApprovalPolicyType `json:"type"`
+	JobTypes []string           `json:"job_types"`
+}
+
+// Validate validates the approval policy.
+func (p ApprovalPolicy) Validate() error {
+	switch p.Type {
+	case ApprovalPolicyAutoApproveUpdates:
+	case ApprovalPolicyAutoRejectUnlistedJobTypes:
+		if len(p.JobTypes) == 0 {
+			return errors.New("at least one job type must be listed")
+		}
+	default:
+		return errors.Errorf("invalid approval policy type: %s", p.Type)
+	}
+
+	for _, jt := range p.JobTypes {
+		switch jt {
+		case JobTypeFluxMonitor, JobTypeOffchainReporting, JobTypeOffchainReporting2:
+		default:
+			return errors.Errorf("invalid job type: %s", jt)
+		}
+	}
+
+	return nil
+}
+
+// hasJobType checks if the job type is listed in the policy.
+func (p ApprovalPolicy) hasJobType(jobType string) bool {
+	for _, jt := range p.JobTypes {
+		if jt == jobType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApprovalPolicies defines the approval policies of a feeds manager.
+type ApprovalPolicies []ApprovalPolicy
+
+func (ps ApprovalPolicies) Value() (driver.Value, error) {
+	if ps == nil {
+		return json.Marshal(ApprovalPolicies{})
+	}
+
+	return json.Marshal(ps)
+}
+
+func (ps *ApprovalPolicies) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &ps)
+}
+
+// Validate validates all the approval policies.
+func (ps ApprovalPolicies) Validate() error {
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApprovalAction is the action taken by an approval policy.
+type ApprovalAction string
+
+const (
+	ApprovalActionNone    ApprovalAction = ""
+	ApprovalActionApprove ApprovalAction = "approve"
+	ApprovalActionReject  ApprovalAction = "reject"
+)
+
+// ApprovalPolicyArgs are the properties of a received job proposal spec which
+// the approval policies are evaluated against.
+type ApprovalPolicyArgs struct {
+	JobType string
+	// IsUpdate is true when the spec is a new version of an approved job
+	// proposal.
+	IsUpdate bool
+	// ContractAddressUnchanged is true when the contract address of the spec is
+	// the same as the address of the currently running job.
+	ContractAddressUnchanged bool
+	// ReferencesSecrets is true when the spec refers to node secrets.
+	ReferencesSecrets bool
+	// AllowedSecretsChanged is true when the secrets which the spec allows
+	// differ from those of the currently running job.
+	AllowedSecretsChanged bool
+}
+
+// Evaluate evaluates the policies against the spec. Reject policies take
+// precedence over approval policies. A spec which refers to node secrets or
+// changes the secrets allowed by the job is never approved automatically. The
+// policy which fired is returned along with the action.
+func (ps ApprovalPolicies) Evaluate(args ApprovalPolicyArgs) (ApprovalAction, *ApprovalPolicy) {
+	for i, p := range ps {
+		if p.Type == ApprovalPolicyAutoRejectUnlistedJobTypes && !p.hasJobType(args.JobType) {
+			return ApprovalActionReject, &ps[i]
+		}
+	}
+
+	for i, p := range ps {
+		if p.Type != ApprovalPolicyAutoApproveUpdates {
+			continue
+		}
+
+		if len(p.JobTypes) > 0 && !p.hasJobType(args.JobType) {
+			continue
+		}
+
+		if args.ReferencesSecrets || args.AllowedSecretsChanged {
+			continue
+		}
+
+		if args.IsUpdate && args.ContractAddressUnchanged {
+			return ApprovalActionApprove, &ps[i]
+		}
+	}
+
+	return ApprovalActionNone, nil
+}
+
 // ChainConfig defines the chain configuration for a Feeds Manager.
 type ChainConfig struct {
 	ID                int64
@@ -176,6 +322,7 @@ type JobProposalSpec struct {
 	Status          SpecStatus
 	Version         int32
 	JobProposalID   int64
+	ApprovalPolicy  null.String // ApprovalPolicy is the type of the approval policy which approved or rejected the spec.
 	StatusUpdatedAt time.Time
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
@@ -186,3 +333,43 @@ func (s *JobProposalSpec) CanEditDefinition() bool {
 	return s.Status == SpecStatusPending ||
 		s.Status == SpecStatusCancelled
 }
+
+// JobHealth is a summary of the health of a job which was created from an
+// approved job proposal.
+type JobHealth struct {
+	RemoteUUID         uuid.UUID
+	Version            int32
+	JobID              int32
+	LastRunState       null.String
+	LastRunCreatedAt   null.Time
+	LastRunFinishedAt  null.Time
+	ErroredRunCount    int64
+	SpecErrorCount     int64
+	LastTransmissionAt null.Time
+}
+
+// KeyHealth defines the health of a sending key on a chain.
+type KeyHealth struct {
+	Address    string
+	EthBalance string
+}
+
+// ChainHealth defines the health of a chain which the feeds manager has a chain
+// config for.
+type ChainHealth struct {
+	ChainID       string
+	ChainType     ChainType
+	HeadNumber    int64
+	HeadTimestamp null.Time
+	LiveNodeCount int32
+	NodeCount     int32
+	Keys          []KeyHealth
+	Error         null.String
+}
+
+// HealthReport contains the job and chain health which is reported to the
+// feeds manager.
+type HealthReport struct {
+	Jobs   []JobHealth
+	Chains []ChainHealth
+}
@@ -203,3 +203,154 @@ func Test_JobProposal_CanEditDefinition(t *testing.T) {
 		})
 	}
 }
+
+func Test_ApprovalPolicies_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		give    ApprovalPolicies
+		wantErr string
+	}{
+		{
+			name: "valid",
+			give: ApprovalPolicies{
+				{Type: ApprovalPolicyAutoApproveUpdates},
+				{Type: ApprovalPolicyAutoRejectUnlistedJobTypes, JobTypes: []string{JobTypeFluxMonitor}},
+			},
+		},
+		{
+			name:    "invalid type",
+			give:    ApprovalPolicies{{Type: "invalid"}},
+			wantErr: "invalid approval policy type: invalid",
+		},
+		{
+			name:    "invalid job type",
+			give:    ApprovalPolicies{{Type: ApprovalPolicyAutoApproveUpdates, JobTypes: []string{"invalid"}}},
+			wantErr: "invalid job type: invalid",
+		},
+		{
+			name:    "reject policy without job types",
+			give:    ApprovalPolicies{{Type: ApprovalPolicyAutoRejectUnlistedJobTypes}},
+			wantErr: "at least one job type must be listed",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.give.Validate()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ApprovalPolicies_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		approveAll = ApprovalPolicy{Type: ApprovalPolicyAutoApproveUpdates}
+		approveFM  = ApprovalPolicy{Type: ApprovalPolicyAutoApproveUpdates, JobTypes: []string{JobTypeFluxMonitor}}
+		rejectOCR2 = ApprovalPolicy{Type: ApprovalPolicyAutoRejectUnlistedJobTypes, JobTypes: []string{JobTypeFluxMonitor, JobTypeOffchainReporting}}
+	)
+
+	tests := []struct {
+		name       string
+		policies   ApprovalPolicies
+		args       ApprovalPolicyArgs
+		wantAction ApprovalAction
+		wantPolicy *ApprovalPolicy
+	}{
+		{
+			name:       "no policies",
+			args:       ApprovalPolicyArgs{JobType: JobTypeFluxMonitor, IsUpdate: true, ContractAddressUnchanged: true},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "approves an update",
+			policies:   ApprovalPolicies{approveAll},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting, IsUpdate: true, ContractAddressUnchanged: true},
+			wantAction: ApprovalActionApprove,
+			wantPolicy: &approveAll,
+		},
+		{
+			name:       "does not approve a new proposal",
+			policies:   ApprovalPolicies{approveAll},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "does not approve a changed contract address",
+			policies:   ApprovalPolicies{approveAll},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting, IsUpdate: true},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "does not approve a spec referring to secrets",
+			policies:   ApprovalPolicies{approveAll},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting, IsUpdate: true, ContractAddressUnchanged: true, ReferencesSecrets: true},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "does not approve changed allowed secrets",
+			policies:   ApprovalPolicies{approveAll},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting, IsUpdate: true, ContractAddressUnchanged: true, AllowedSecretsChanged: true},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "does not approve an unlisted job type",
+			policies:   ApprovalPolicies{approveFM},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting, IsUpdate: true, ContractAddressUnchanged: true},
+			wantAction: ApprovalActionNone,
+		},
+		{
+			name:       "rejects an unlisted job type",
+			policies:   ApprovalPolicies{rejectOCR2},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting2},
+			wantAction: ApprovalActionReject,
+			wantPolicy: &rejectOCR2,
+		},
+		{
+			name:       "rejection takes precedence over approval",
+			policies:   ApprovalPolicies{approveAll, rejectOCR2},
+			args:       ApprovalPolicyArgs{JobType: JobTypeOffchainReporting2, IsUpdate: true, ContractAddressUnchanged: true},
+			wantAction: ApprovalActionReject,
+			wantPolicy: &rejectOCR2,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, policy := tc.policies.Evaluate(tc.args)
+			assert.Equal(t, tc.wantAction, action)
+			assert.Equal(t, tc.wantPolicy, policy)
+		})
+	}
+}
+
+func Test_ApprovalPolicies_Scan(t *testing.T) {
+	t.Parallel()
+
+	var (
+		give = `[{"type":"auto_approve_updates","job_types":["ocr"]}]`
+		want = ApprovalPolicies{{Type: ApprovalPolicyAutoApproveUpdates, JobTypes: []string{"ocr"}}}
+	)
+
+	var actual ApprovalPolicies
+	err := actual.Scan([]byte(give))
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+
+	val, err := ApprovalPolicies(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), val)
+}
@@ -49,8 +49,10 @@ type ORM interface {
 	ListSpecsByJobProposalIDs(ids []int64, qopts ...pg.QOpt) ([]JobProposalSpec, error)
 	RejectSpec(id int64, qopts ...pg.QOpt) error
 	UpdateSpecDefinition(id int64, spec string, qopts ...pg.QOpt) error
+	UpdateSpecApprovalPolicy(id int64, policy ApprovalPolicyType, qopts ...pg.QOpt) error
 
 	IsJobManaged(jobID int64, qopts ...pg.QOpt) (bool, error)
+	ListJobHealthsByManagerID(mgrID int64, qopts ...pg.QOpt) ([]JobHealth, error)
 }
 
 var _ ORM = &orm{}
@@ -79,11 +81,11 @@ FROM feeds_managers
 // CreateManager creates a feeds manager.
 func (o *orm) CreateManager(ms *FeedsManager, qopts ...pg.QOpt) (id int64, err error) {
 	stmt := `
-INSERT INTO feeds_managers (name, uri, public_key, created_at, updated_at)
-VALUES ($1,$2,$3,NOW(),NOW())
+INSERT INTO feeds_managers (name, uri, public_key, approval_policies, created_at, updated_at)
+VALUES ($1,$2,$3,$4,NOW(),NOW())
 RETURNING id;
 `
-	err = o.q.WithOpts(qopts...).Get(&id, stmt, ms.Name, ms.URI, ms.PublicKey)
+	err = o.q.WithOpts(qopts...).Get(&id, stmt, ms.Name, ms.URI, ms.PublicKey, ms.ApprovalPolicies)
 
 	return id, errors.Wrap(err, "CreateManager failed")
 }
@@ -236,7 +238,7 @@ RETURNING id;
 // GetManager gets a feeds manager by id.
 func (o *orm) GetManager(id int64) (mgr *FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at
 FROM feeds_managers
 WHERE id = $1
 `
@@ -249,7 +251,7 @@ WHERE id = $1
 // ListManager lists all feeds managers.
 func (o *orm) ListManagers() (mgrs []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at
 FROM feeds_managers;
 `
 
@@ -260,7 +262,7 @@ FROM feeds_managers;
 // ListManagersByIDs gets feeds managers by ids.
 func (o *orm) ListManagersByIDs(ids []int64) (managers []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, approval_policies, created_at, updated_at
 FROM feeds_managers
 WHERE id = ANY($1)
 ORDER BY created_at, id;`
@@ -275,11 +277,11 @@ ORDER BY created_at, id;`
 func (o *orm) UpdateManager(mgr FeedsManager, qopts ...pg.QOpt) (err error) {
 	stmt := `
 UPDATE feeds_managers
-SET name = $1, uri = $2, public_key = $3, updated_at = NOW()
-WHERE id = $4;
+SET name = $1, uri = $2, public_key = $3, approval_policies = $4, updated_at = NOW()
+WHERE id = $5;
 `
 
-	res, err := o.q.WithOpts(qopts...).Exec(stmt, mgr.Name, mgr.URI, mgr.PublicKey, mgr.ID)
+	res, err := o.q.WithOpts(qopts...).Exec(stmt, mgr.Name, mgr.URI, mgr.PublicKey, mgr.ApprovalPolicies, mgr.ID)
 	if err != nil {
 		return errors.Wrap(err, "UpdateManager failed to update feeds_managers")
 	}
@@ -526,7 +528,7 @@ SELECT exists (
 // GetSpec fetches the job proposal spec by id
 func (o *orm) GetSpec(id int64, qopts ...pg.QOpt) (*JobProposalSpec, error) {
 	stmt := `
-SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+SELECT id, definition, version, status, job_proposal_id, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE id = $1;
 `
@@ -539,7 +541,7 @@ WHERE id = $1;
 // GetLatestSpec gets the latest spec for a job proposal.
 func (o *orm) GetLatestSpec(jpID int64) (*JobProposalSpec, error) {
 	stmt := `
-	SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+	SELECT id, definition, version, status, job_proposal_id, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE (job_proposal_id, version) IN
 (
@@ -560,7 +562,7 @@ AND job_proposal_id = $1
 // ids.
 func (o *orm) ListSpecsByJobProposalIDs(ids []int64, qopts ...pg.QOpt) ([]JobProposalSpec, error) {
 	stmt := `
-SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+SELECT id, definition, version, status, job_proposal_id, approval_policy, status_updated_at, created_at, updated_at
 FROM job_proposal_specs
 WHERE job_proposal_id = ANY($1)
 `
@@ -640,6 +642,33 @@ WHERE id = $2;
 	return nil
 }
 
+// UpdateSpecApprovalPolicy records the approval policy which approved or
+// rejected a job proposal spec.
+func (o *orm) UpdateSpecApprovalPolicy(id int64, policy ApprovalPolicyType, qopts ...pg.QOpt) error {
+	stmt := `
+UPDATE job_proposal_specs
+SET approval_policy = $1,
+	updated_at = NOW()
+WHERE id = $2;
+`
+
+	res, err := o.q.WithOpts(qopts...).Exec(stmt, policy, id)
+	if err != nil {
+		return errors.Wrap(err, "UpdateSpecApprovalPolicy failed to update approval policy")
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateSpecApprovalPolicy failed to get RowsAffected")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 // IsJobManaged determines if a job is managed by the feeds manager.
 func (o *orm) IsJobManaged(jobID int64, qopts ...pg.QOpt) (exists bool, err error) {
 	stmt := `
@@ -654,3 +683,79 @@ SELECT exists (
 	err = o.q.WithOpts(qopts...).Get(&exists, stmt, jobID)
 	return exists, errors.Wrap(err, "IsJobManaged failed")
 }
+
+// ListJobHealthsByManagerID summarizes the health of the jobs which were
+// created from the approved job proposals of a feeds manager.
+func (o *orm) ListJobHealthsByManagerID(mgrID int64, qopts ...pg.QOpt) ([]JobHealth, error) {
+	stmt := `
+SELECT
+	job_proposals.remote_uuid,
+	COALESCE((
+		SELECT MAX(version)
+		FROM job_proposal_specs
+		WHERE job_proposal_id = job_proposals.id AND status = 'approved'
+	), 0) AS version,
+	jobs.id AS job_id,
+	last_run.state AS last_run_state,
+	last_run.created_at AS last_run_created_at,
+	last_run.finished_at AS last_run_finished_at,
+	(
+		SELECT COUNT(*)
+		FROM pipeline_runs
+		WHERE pipeline_spec_id = jobs.pipeline_spec_id AND state = 'errored'
+	) AS errored_run_count,
+	(
+		SELECT COALESCE(SUM(occurrences), 0)
+		FROM job_spec_errors
+		WHERE job_id = jobs.id
+	) AS spec_error_count,
+	GREATEST(
+		(
+			SELECT MAX(eth_txes.broadcast_at)
+			FROM eth_txes
+			INNER JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+			INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+			WHERE pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id
+		),
+		(
+			SELECT MAX(broadcast_at)
+			FROM eth_txes
+			WHERE to_address = ocr_oracle_specs.contract_address
+			AND (ocr_oracle_specs.transmitter_address IS NULL OR from_address = ocr_oracle_specs.transmitter_address)
+			AND (ocr_oracle_specs.evm_chain_id IS NULL OR evm_chain_id = ocr_oracle_specs.evm_chain_id)
+		),
+		(
+			SELECT MAX(broadcast_at)
+			FROM eth_txes
+			WHERE to_address = CASE WHEN ocr2_oracle_specs.relay = 'evm' THEN decode(substring(ocr2_oracle_specs.contract_id from 3), 'hex') END
+			AND from_address = CASE WHEN ocr2_oracle_specs.relay = 'evm' THEN decode(substring(ocr2_oracle_specs.transmitter_id from 3), 'hex') END
+			AND evm_chain_id::text = ocr2_oracle_specs.relay_config->>'chainID'
+		),
+		(
+			SELECT MAX(broadcast_at)
+			FROM eth_txes
+			WHERE to_address = flux_monitor_specs.contract_address
+			AND (flux_monitor_specs.evm_chain_id IS NULL OR evm_chain_id = flux_monitor_specs.evm_chain_id)
+		)
+	) AS last_transmission_at
+FROM job_proposals
+INNER JOIN jobs ON job_proposals.external_job_id = jobs.external_job_id
+LEFT JOIN ocr_oracle_specs ON ocr_oracle_specs.id = jobs.ocr_oracle_spec_id
+LEFT JOIN ocr2_oracle_specs ON ocr2_oracle_specs.id = jobs.ocr2_oracle_spec_id
+LEFT JOIN flux_monitor_specs ON flux_monitor_specs.id = jobs.flux_monitor_spec_id
+LEFT JOIN LATERAL (
+	SELECT state, created_at, finished_at
+	FROM pipeline_runs
+	WHERE pipeline_spec_id = jobs.pipeline_spec_id
+	ORDER BY id DESC
+	LIMIT 1
+) AS last_run ON TRUE
+WHERE job_proposals.feeds_manager_id = $1
+AND job_proposals.status = 'approved'
+ORDER BY job_proposals.id;
+`
+
+	var healths []JobHealth
+	err := o.q.WithOpts(qopts...).Select(&healths, stmt, mgrID)
+	return healths, errors.Wrap(err, "ListJobHealthsByManagerID failed")
+}
@@ -2,6 +2,7 @@ package feeds_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
@@ -175,6 +177,12 @@ func Test_ORM_UpdateManager(t *testing.T) {
 		URI:       "127.0.0.1",
 		Name:      "New Name",
 		PublicKey: crypto.PublicKey([]byte("22222222222222222222222222222222")),
+		ApprovalPolicies: feeds.ApprovalPolicies{
+			{
+				Type:     feeds.ApprovalPolicyAutoApproveUpdates,
+				JobTypes: []string{feeds.JobTypeFluxMonitor},
+			},
+		},
 	}
 
 	err = orm.UpdateManager(updatedMgr)
@@ -186,6 +194,7 @@ func Test_ORM_UpdateManager(t *testing.T) {
 	assert.Equal(t, updatedMgr.URI, actual.URI)
 	assert.Equal(t, updatedMgr.Name, actual.Name)
 	assert.Equal(t, updatedMgr.PublicKey, actual.PublicKey)
+	assert.Equal(t, updatedMgr.ApprovalPolicies, actual.ApprovalPolicies)
 }
 
 // Chain Config
@@ -946,6 +955,32 @@ func Test_ORM_UpdateSpecDefinition(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_ORM_UpdateSpecApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		orm    = setupORM(t)
+		fmID   = createFeedsManager(t, orm)
+		jpID   = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		specID = createJobSpec(t, orm, int64(jpID))
+	)
+
+	prev, err := orm.GetSpec(specID)
+	require.NoError(t, err)
+	assert.False(t, prev.ApprovalPolicy.Valid)
+
+	err = orm.UpdateSpecApprovalPolicy(specID, feeds.ApprovalPolicyAutoApproveUpdates)
+	require.NoError(t, err)
+
+	actual, err := orm.GetSpec(specID)
+	require.NoError(t, err)
+	assert.Equal(t, null.StringFrom(string(feeds.ApprovalPolicyAutoApproveUpdates)), actual.ApprovalPolicy)
+
+	// Not found
+	err = orm.UpdateSpecApprovalPolicy(-1, feeds.ApprovalPolicyAutoApproveUpdates)
+	require.Error(t, err)
+}
+
 // Other
 
 func Test_ORM_IsJobManaged(t *testing.T) {
@@ -973,6 +1008,61 @@ func Test_ORM_IsJobManaged(t *testing.T) {
 	assert.True(t, isManaged)
 }
 
+func Test_ORM_ListJobHealthsByManagerID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		orm           = setupORM(t)
+		fmID          = createFeedsManager(t, orm)
+		jpID          = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		specID        = createJobSpec(t, orm, int64(jpID))
+		externalJobID = uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+	)
+
+	j := createJob(t, orm.db, externalJobID.UUID)
+
+	healths, err := orm.ListJobHealthsByManagerID(fmID)
+	require.NoError(t, err)
+	require.Len(t, healths, 0)
+
+	err = orm.ApproveSpec(specID, externalJobID.UUID)
+	require.NoError(t, err)
+
+	jp, err := orm.GetJobProposal(jpID)
+	require.NoError(t, err)
+
+	healths, err = orm.ListJobHealthsByManagerID(fmID)
+	require.NoError(t, err)
+	require.Len(t, healths, 1)
+
+	actual := healths[0]
+	assert.Equal(t, jp.RemoteUUID, actual.RemoteUUID)
+	assert.Equal(t, int32(1), actual.Version)
+	assert.Equal(t, j.ID, actual.JobID)
+	assert.False(t, actual.LastRunState.Valid)
+	assert.False(t, actual.LastTransmissionAt.Valid)
+	assert.Equal(t, int64(0), actual.ErroredRunCount)
+	assert.Equal(t, int64(0), actual.SpecErrorCount)
+
+	// Only transmissions from the job's transmitter to its contract are
+	// attributed to the job
+	var (
+		borm        = cltest.NewTxmORM(t, orm.db, cltest.NewTestGeneralConfig(t))
+		transmitter = j.OCROracleSpec.TransmitterAddress.Address()
+		broadcastAt = time.Now().Add(-time.Hour).Round(time.Second)
+	)
+	cltest.MustInsertUnconfirmedEthTx(t, borm, 0, transmitter, broadcastAt.Add(time.Minute))
+	etx := cltest.MustInsertUnconfirmedEthTx(t, borm, 1, transmitter, broadcastAt)
+	_, err = orm.db.Exec(`UPDATE eth_txes SET to_address = $1 WHERE id = $2`, j.OCROracleSpec.ContractAddress, etx.ID)
+	require.NoError(t, err)
+
+	healths, err = orm.ListJobHealthsByManagerID(fmID)
+	require.NoError(t, err)
+	require.Len(t, healths, 1)
+	require.True(t, healths[0].LastTransmissionAt.Valid)
+	assert.True(t, broadcastAt.Equal(healths[0].LastTransmissionAt.Time))
+}
+
 // Helpers
 
 func assertChainConfigEqual(t *testing.T, want map[string]interface{}, actual feeds.ChainConfig) {
@@ -1013,7 +1103,7 @@ func createJob(t *testing.T, db *sqlx.DB, externalJobID uuid.UUID) *job.Job {
 		lggr        = logger.TestLogger(t)
 		pipelineORM = pipeline.NewORM(db, lggr, config)
 		cc          = evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
-		orm         = job.NewORM(db, cc, pipelineORM, keyStore, lggr, config)
+		orm         = job.NewORM(db, cc, pipelineORM, keyStore, lggr, config, alerts.NullNotifier{})
 	)
 
 	keyStore.OCR().Add(cltest.DefaultOCRKey)
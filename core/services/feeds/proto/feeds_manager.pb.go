@@ -1169,6 +1169,449 @@ func (x *ProposeJobResponse) GetId() string {
 	return ""
 }
 
+// The health of a job which was proposed by the feeds manager. Timestamps are
+// unix seconds and are 0 when there is no value.
+type JobHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid               string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Version            int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	LastRunState       string `protobuf:"bytes,3,opt,name=last_run_state,json=lastRunState,proto3" json:"last_run_state,omitempty"`
+	LastRunCreatedAt   int64  `protobuf:"varint,4,opt,name=last_run_created_at,json=lastRunCreatedAt,proto3" json:"last_run_created_at,omitempty"`
+	LastRunFinishedAt  int64  `protobuf:"varint,5,opt,name=last_run_finished_at,json=lastRunFinishedAt,proto3" json:"last_run_finished_at,omitempty"`
+	ErroredRunCount    int64  `protobuf:"varint,6,opt,name=errored_run_count,json=erroredRunCount,proto3" json:"errored_run_count,omitempty"`
+	SpecErrorCount     int64  `protobuf:"varint,7,opt,name=spec_error_count,json=specErrorCount,proto3" json:"spec_error_count,omitempty"`
+	LastTransmissionAt int64  `protobuf:"varint,8,opt,name=last_transmission_at,json=lastTransmissionAt,proto3" json:"last_transmission_at,omitempty"`
+}
+
+func (x *JobHealth) Reset() {
+	*x = JobHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobHealth) ProtoMessage() {}
+
+func (x *JobHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobHealth.ProtoReflect.Descriptor instead.
+func (*JobHealth) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JobHealth) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *JobHealth) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *JobHealth) GetLastRunState() string {
+	if x != nil {
+		return x.LastRunState
+	}
+	return ""
+}
+
+func (x *JobHealth) GetLastRunCreatedAt() int64 {
+	if x != nil {
+		return x.LastRunCreatedAt
+	}
+	return 0
+}
+
+func (x *JobHealth) GetLastRunFinishedAt() int64 {
+	if x != nil {
+		return x.LastRunFinishedAt
+	}
+	return 0
+}
+
+func (x *JobHealth) GetErroredRunCount() int64 {
+	if x != nil {
+		return x.ErroredRunCount
+	}
+	return 0
+}
+
+func (x *JobHealth) GetSpecErrorCount() int64 {
+	if x != nil {
+		return x.SpecErrorCount
+	}
+	return 0
+}
+
+func (x *JobHealth) GetLastTransmissionAt() int64 {
+	if x != nil {
+		return x.LastTransmissionAt
+	}
+	return 0
+}
+
+// The health of a sending key on a specific chain
+type KeyHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	EthBalance string `protobuf:"bytes,2,opt,name=eth_balance,json=ethBalance,proto3" json:"eth_balance,omitempty"`
+}
+
+func (x *KeyHealth) Reset() {
+	*x = KeyHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyHealth) ProtoMessage() {}
+
+func (x *KeyHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyHealth.ProtoReflect.Descriptor instead.
+func (*KeyHealth) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *KeyHealth) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *KeyHealth) GetEthBalance() string {
+	if x != nil {
+		return x.EthBalance
+	}
+	return ""
+}
+
+// The health of a specific chain. The head timestamp is unix seconds.
+type ChainHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain         *Chain       `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	HeadNumber    int64        `protobuf:"varint,2,opt,name=head_number,json=headNumber,proto3" json:"head_number,omitempty"`
+	HeadTimestamp int64        `protobuf:"varint,3,opt,name=head_timestamp,json=headTimestamp,proto3" json:"head_timestamp,omitempty"`
+	LiveNodeCount int32        `protobuf:"varint,4,opt,name=live_node_count,json=liveNodeCount,proto3" json:"live_node_count,omitempty"`
+	NodeCount     int32        `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Keys          []*KeyHealth `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+	Error         string       `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ChainHealth) Reset() {
+	*x = ChainHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainHealth) ProtoMessage() {}
+
+func (x *ChainHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainHealth.ProtoReflect.Descriptor instead.
+func (*ChainHealth) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChainHealth) GetChain() *Chain {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+func (x *ChainHealth) GetHeadNumber() int64 {
+	if x != nil {
+		return x.HeadNumber
+	}
+	return 0
+}
+
+func (x *ChainHealth) GetHeadTimestamp() int64 {
+	if x != nil {
+		return x.HeadTimestamp
+	}
+	return 0
+}
+
+func (x *ChainHealth) GetLiveNodeCount() int32 {
+	if x != nil {
+		return x.LiveNodeCount
+	}
+	return 0
+}
+
+func (x *ChainHealth) GetNodeCount() int32 {
+	if x != nil {
+		return x.NodeCount
+	}
+	return 0
+}
+
+func (x *ChainHealth) GetKeys() []*KeyHealth {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ChainHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs   []*JobHealth   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Chains []*ChainHealth `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *UpdateHealthRequest) Reset() {
+	*x = UpdateHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHealthRequest) ProtoMessage() {}
+
+func (x *UpdateHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHealthRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHealthRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateHealthRequest) GetJobs() []*JobHealth {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *UpdateHealthRequest) GetChains() []*ChainHealth {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type UpdateHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateHealthResponse) Reset() {
+	*x = UpdateHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHealthResponse) ProtoMessage() {}
+
+func (x *UpdateHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHealthResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHealthResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{22}
+}
+
+type GetHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHealthRequest) Reset() {
+	*x = GetHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthRequest) ProtoMessage() {}
+
+func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{23}
+}
+
+type GetHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs   []*JobHealth   `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Chains []*ChainHealth `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *GetHealthResponse) Reset() {
+	*x = GetHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthResponse) ProtoMessage() {}
+
+func (x *GetHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetHealthResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetHealthResponse) GetJobs() []*JobHealth {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *GetHealthResponse) GetChains() []*ChainHealth {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
 type OCR1Config_P2PKeyBundle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1181,7 +1624,7 @@ type OCR1Config_P2PKeyBundle struct {
 func (x *OCR1Config_P2PKeyBundle) Reset() {
 	*x = OCR1Config_P2PKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1194,7 +1637,7 @@ func (x *OCR1Config_P2PKeyBundle) String() string {
 func (*OCR1Config_P2PKeyBundle) ProtoMessage() {}
 
 func (x *OCR1Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18]
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,7 +1681,7 @@ type OCR1Config_OCRKeyBundle struct {
 func (x *OCR1Config_OCRKeyBundle) Reset() {
 	*x = OCR1Config_OCRKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1251,7 +1694,7 @@ func (x *OCR1Config_OCRKeyBundle) String() string {
 func (*OCR1Config_OCRKeyBundle) ProtoMessage() {}
 
 func (x *OCR1Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19]
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1750,7 @@ type OCR2Config_P2PKeyBundle struct {
 func (x *OCR2Config_P2PKeyBundle) Reset() {
 	*x = OCR2Config_P2PKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1763,7 @@ func (x *OCR2Config_P2PKeyBundle) String() string {
 func (*OCR2Config_P2PKeyBundle) ProtoMessage() {}
 
 func (x *OCR2Config_P2PKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20]
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,7 +1807,7 @@ type OCR2Config_OCRKeyBundle struct {
 func (x *OCR2Config_OCRKeyBundle) Reset() {
 	*x = OCR2Config_OCRKeyBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1377,7 +1820,7 @@ func (x *OCR2Config_OCRKeyBundle) String() string {
 func (*OCR2Config_OCRKeyBundle) ProtoMessage() {}
 
 func (x *OCR2Config_OCRKeyBundle) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21]
+	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,51 +2022,115 @@ var file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = []byte{
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
 	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
 	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x63, 0x0a, 0x07,
-	0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c,
-	0x55, 0x58, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
-	0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x52, 0x10, 0x02, 0x12, 0x11,
-	0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x52, 0x32, 0x10,
-	0x03, 0x2a, 0x68, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x4d, 0x10, 0x01, 0x12, 0x15,
-	0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x4c,
-	0x41, 0x4e, 0x41, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x10, 0x03, 0x32, 0xd8, 0x02, 0x0a, 0x0c,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17, 0x2e,
-	0x63, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16,
-	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17,
-	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f,
-	0x62, 0x12, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
-	0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x4c, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6b, 0x69, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc7, 0x02, 0x0a,
+	0x09, 0x4a, 0x6f, 0x62, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a,
+	0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x65, 0x64, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x70,
+	0x65, 0x63, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x63, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xf8,
+	0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20,
+	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x69, 0x76, 0x65,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x16,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x2a, 0x63, 0x0a,
+	0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46,
+	0x4c, 0x55, 0x58, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x52, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x52, 0x32,
+	0x10, 0x03, 0x2a, 0x68, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x4d, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f,
+	0x4c, 0x41, 0x4e, 0x41, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x10, 0x03, 0x32, 0x9d, 0x03, 0x0a,
+	0x0c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x63,
+	0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x17,
+	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12,
+	0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a,
+	0x6f, 0x62, 0x12, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
+	0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63,
+	0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x88, 0x01, 0x0a,
+	0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x63, 0x66, 0x6d,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x15, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1639,7 +2146,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_noderpc_proto_feeds_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_noderpc_proto_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_pkg_noderpc_proto_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_pkg_noderpc_proto_feeds_manager_proto_goTypes = []interface{}{
 	(JobType)(0),                    // 0: cfm.JobType
 	(ChainType)(0),                  // 1: cfm.ChainType
@@ -1661,18 +2168,25 @@ var file_pkg_noderpc_proto_feeds_manager_proto_goTypes = []interface{}{
 	(*CancelledJobResponse)(nil),    // 17: cfm.CancelledJobResponse
 	(*ProposeJobRequest)(nil),       // 18: cfm.ProposeJobRequest
 	(*ProposeJobResponse)(nil),      // 19: cfm.ProposeJobResponse
-	(*OCR1Config_P2PKeyBundle)(nil), // 20: cfm.OCR1Config.P2PKeyBundle
-	(*OCR1Config_OCRKeyBundle)(nil), // 21: cfm.OCR1Config.OCRKeyBundle
-	(*OCR2Config_P2PKeyBundle)(nil), // 22: cfm.OCR2Config.P2PKeyBundle
-	(*OCR2Config_OCRKeyBundle)(nil), // 23: cfm.OCR2Config.OCRKeyBundle
+	(*JobHealth)(nil),               // 20: cfm.JobHealth
+	(*KeyHealth)(nil),               // 21: cfm.KeyHealth
+	(*ChainHealth)(nil),             // 22: cfm.ChainHealth
+	(*UpdateHealthRequest)(nil),     // 23: cfm.UpdateHealthRequest
+	(*UpdateHealthResponse)(nil),    // 24: cfm.UpdateHealthResponse
+	(*GetHealthRequest)(nil),        // 25: cfm.GetHealthRequest
+	(*GetHealthResponse)(nil),       // 26: cfm.GetHealthResponse
+	(*OCR1Config_P2PKeyBundle)(nil), // 27: cfm.OCR1Config.P2PKeyBundle
+	(*OCR1Config_OCRKeyBundle)(nil), // 28: cfm.OCR1Config.OCRKeyBundle
+	(*OCR2Config_P2PKeyBundle)(nil), // 29: cfm.OCR2Config.P2PKeyBundle
+	(*OCR2Config_OCRKeyBundle)(nil), // 30: cfm.OCR2Config.OCRKeyBundle
 }
 var file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = []int32{
 	1,  // 0: cfm.Chain.type:type_name -> cfm.ChainType
 	1,  // 1: cfm.Account.chain_type:type_name -> cfm.ChainType
-	20, // 2: cfm.OCR1Config.p2p_key_bundle:type_name -> cfm.OCR1Config.P2PKeyBundle
-	21, // 3: cfm.OCR1Config.ocr_key_bundle:type_name -> cfm.OCR1Config.OCRKeyBundle
-	22, // 4: cfm.OCR2Config.p2p_key_bundle:type_name -> cfm.OCR2Config.P2PKeyBundle
-	23, // 5: cfm.OCR2Config.ocr_key_bundle:type_name -> cfm.OCR2Config.OCRKeyBundle
+	27, // 2: cfm.OCR1Config.p2p_key_bundle:type_name -> cfm.OCR1Config.P2PKeyBundle
+	28, // 3: cfm.OCR1Config.ocr_key_bundle:type_name -> cfm.OCR1Config.OCRKeyBundle
+	29, // 4: cfm.OCR2Config.p2p_key_bundle:type_name -> cfm.OCR2Config.P2PKeyBundle
+	30, // 5: cfm.OCR2Config.ocr_key_bundle:type_name -> cfm.OCR2Config.OCRKeyBundle
 	2,  // 6: cfm.ChainConfig.chain:type_name -> cfm.Chain
 	4,  // 7: cfm.ChainConfig.flux_monitor_config:type_name -> cfm.FluxMonitorConfig
 	5,  // 8: cfm.ChainConfig.ocr1_config:type_name -> cfm.OCR1Config
@@ -1681,23 +2195,33 @@ var file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = []int32{
 	3,  // 11: cfm.UpdateNodeRequest.accounts:type_name -> cfm.Account
 	2,  // 12: cfm.UpdateNodeRequest.chains:type_name -> cfm.Chain
 	7,  // 13: cfm.UpdateNodeRequest.chain_configs:type_name -> cfm.ChainConfig
-	10, // 14: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
-	12, // 15: cfm.FeedsManager.Healthcheck:input_type -> cfm.HealthcheckRequest
-	8,  // 16: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
-	14, // 17: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
-	16, // 18: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
-	18, // 19: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
-	11, // 20: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
-	13, // 21: cfm.FeedsManager.Healthcheck:output_type -> cfm.HealthcheckResponse
-	9,  // 22: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
-	15, // 23: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
-	17, // 24: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
-	19, // 25: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
-	20, // [20:26] is the sub-list for method output_type
-	14, // [14:20] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	2,  // 14: cfm.ChainHealth.chain:type_name -> cfm.Chain
+	21, // 15: cfm.ChainHealth.keys:type_name -> cfm.KeyHealth
+	20, // 16: cfm.UpdateHealthRequest.jobs:type_name -> cfm.JobHealth
+	22, // 17: cfm.UpdateHealthRequest.chains:type_name -> cfm.ChainHealth
+	20, // 18: cfm.GetHealthResponse.jobs:type_name -> cfm.JobHealth
+	22, // 19: cfm.GetHealthResponse.chains:type_name -> cfm.ChainHealth
+	10, // 20: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
+	12, // 21: cfm.FeedsManager.Healthcheck:input_type -> cfm.HealthcheckRequest
+	8,  // 22: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
+	14, // 23: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
+	16, // 24: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
+	23, // 25: cfm.FeedsManager.UpdateHealth:input_type -> cfm.UpdateHealthRequest
+	18, // 26: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
+	25, // 27: cfm.NodeService.GetHealth:input_type -> cfm.GetHealthRequest
+	11, // 28: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
+	13, // 29: cfm.FeedsManager.Healthcheck:output_type -> cfm.HealthcheckResponse
+	9,  // 30: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
+	15, // 31: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
+	17, // 32: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
+	24, // 33: cfm.FeedsManager.UpdateHealth:output_type -> cfm.UpdateHealthResponse
+	19, // 34: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
+	26, // 35: cfm.NodeService.GetHealth:output_type -> cfm.GetHealthResponse
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_pkg_noderpc_proto_feeds_manager_proto_init() }
@@ -1923,7 +2447,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			}
 		}
 		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OCR1Config_P2PKeyBundle); i {
+			switch v := v.(*JobHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1935,7 +2459,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			}
 		}
 		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OCR1Config_OCRKeyBundle); i {
+			switch v := v.(*KeyHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1947,7 +2471,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			}
 		}
 		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OCR2Config_P2PKeyBundle); i {
+			switch v := v.(*ChainHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1959,6 +2483,90 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			}
 		}
 		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCR1Config_P2PKeyBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCR1Config_OCRKeyBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCR2Config_P2PKeyBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OCR2Config_OCRKeyBundle); i {
 			case 0:
 				return &v.state
@@ -1977,7 +2585,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_noderpc_proto_feeds_manager_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   22,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
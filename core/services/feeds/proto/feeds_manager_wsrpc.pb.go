@@ -18,6 +18,7 @@ type FeedsManagerClient interface {
 	UpdateNode(ctx context.Context, in *UpdateNodeRequest) (*UpdateNodeResponse, error)
 	RejectedJob(ctx context.Context, in *RejectedJobRequest) (*RejectedJobResponse, error)
 	CancelledJob(ctx context.Context, in *CancelledJobRequest) (*CancelledJobResponse, error)
+	UpdateHealth(ctx context.Context, in *UpdateHealthRequest) (*UpdateHealthResponse, error)
 }
 
 type feedsManagerClient struct {
@@ -64,12 +65,22 @@ func (c *feedsManagerClient) CancelledJob(ctx context.Context, in *CancelledJobR
 	return out, nil
 }
 
+func (c *feedsManagerClient) UpdateHealth(ctx context.Context, in *UpdateHealthRequest) (*UpdateHealthResponse, error) {
+	out := new(UpdateHealthResponse)
+	err := c.cc.Invoke(ctx, "UpdateHealth", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeedsManagerServer is the server API for FeedsManager service.
 type FeedsManagerServer interface {
 	ApprovedJob(context.Context, *ApprovedJobRequest) (*ApprovedJobResponse, error)
 	UpdateNode(context.Context, *UpdateNodeRequest) (*UpdateNodeResponse, error)
 	RejectedJob(context.Context, *RejectedJobRequest) (*RejectedJobResponse, error)
 	CancelledJob(context.Context, *CancelledJobRequest) (*CancelledJobResponse, error)
+	UpdateHealth(context.Context, *UpdateHealthRequest) (*UpdateHealthResponse, error)
 }
 
 func RegisterFeedsManagerServer(s wsrpc.ServiceRegistrar, srv FeedsManagerServer) {
@@ -108,6 +119,14 @@ func _FeedsManager_CancelledJob_Handler(srv interface{}, ctx context.Context, de
 	return srv.(FeedsManagerServer).CancelledJob(ctx, in)
 }
 
+func _FeedsManager_UpdateHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(UpdateHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(FeedsManagerServer).UpdateHealth(ctx, in)
+}
+
 // FeedsManager_ServiceDesc is the wsrpc.ServiceDesc for FeedsManager service.
 // It's only intended for direct use with wsrpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,6 +150,10 @@ var FeedsManager_ServiceDesc = wsrpc.ServiceDesc{
 			MethodName: "CancelledJob",
 			Handler:    _FeedsManager_CancelledJob_Handler,
 		},
+		{
+			MethodName: "UpdateHealth",
+			Handler:    _FeedsManager_UpdateHealth_Handler,
+		},
 	},
 }
 
@@ -138,6 +161,7 @@ var FeedsManager_ServiceDesc = wsrpc.ServiceDesc{
 //
 type NodeServiceClient interface {
 	ProposeJob(ctx context.Context, in *ProposeJobRequest) (*ProposeJobResponse, error)
+	GetHealth(ctx context.Context, in *GetHealthRequest) (*GetHealthResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -157,9 +181,19 @@ func (c *nodeServiceClient) ProposeJob(ctx context.Context, in *ProposeJobReques
 	return out, nil
 }
 
+func (c *nodeServiceClient) GetHealth(ctx context.Context, in *GetHealthRequest) (*GetHealthResponse, error) {
+	out := new(GetHealthResponse)
+	err := c.cc.Invoke(ctx, "GetHealth", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 type NodeServiceServer interface {
 	ProposeJob(context.Context, *ProposeJobRequest) (*ProposeJobResponse, error)
+	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
 }
 
 func RegisterNodeServiceServer(s wsrpc.ServiceRegistrar, srv NodeServiceServer) {
@@ -174,6 +208,14 @@ func _NodeService_ProposeJob_Handler(srv interface{}, ctx context.Context, dec f
 	return srv.(NodeServiceServer).ProposeJob(ctx, in)
 }
 
+func _NodeService_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(GetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(NodeServiceServer).GetHealth(ctx, in)
+}
+
 // NodeService_ServiceDesc is the wsrpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with wsrpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,5 +227,9 @@ var NodeService_ServiceDesc = wsrpc.ServiceDesc{
 			MethodName: "ProposeJob",
 			Handler:    _NodeService_ProposeJob_Handler,
 		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _NodeService_GetHealth_Handler,
+		},
 	},
 }
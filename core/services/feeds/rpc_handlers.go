@@ -40,3 +40,17 @@ func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest)
 
 	return &pb.ProposeJobResponse{}, nil
 }
+
+// GetHealth responds with the health of the jobs proposed by the feeds manager
+// and of the chains it has chain configs for.
+func (h *RPCHandlers) GetHealth(ctx context.Context, req *pb.GetHealthRequest) (*pb.GetHealthResponse, error) {
+	report, err := h.svc.GetHealthReport(ctx, h.feedsManagerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetHealthResponse{
+		Jobs:   newJobHealthMsgs(report.Jobs),
+		Chains: newChainHealthMsgs(report.Chains),
+	}, nil
+}
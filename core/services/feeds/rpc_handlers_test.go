@@ -8,8 +8,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 type TestRPCHandlers struct {
@@ -63,3 +65,55 @@ func Test_RPCHandlers_ProposeJob(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_RPCHandlers_GetHealth(t *testing.T) {
+	var (
+		ctx        = context.Background()
+		remoteUUID = uuid.NewV4()
+	)
+	h := setupTestHandlers(t)
+
+	h.svc.
+		On("GetHealthReport", ctx, h.feedsManagerID).
+		Return(&feeds.HealthReport{
+			Jobs: []feeds.JobHealth{
+				{
+					RemoteUUID:      remoteUUID,
+					Version:         1,
+					LastRunState:    null.StringFrom("errored"),
+					ErroredRunCount: 2,
+				},
+			},
+			Chains: []feeds.ChainHealth{
+				{
+					ChainID:       "42",
+					ChainType:     feeds.ChainTypeEVM,
+					HeadNumber:    100,
+					LiveNodeCount: 1,
+					NodeCount:     2,
+					Keys: []feeds.KeyHealth{
+						{Address: "0x0000", EthBalance: "1.000000000000000000"},
+					},
+				},
+			},
+		}, nil)
+
+	res, err := h.GetHealth(ctx, &pb.GetHealthRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, res.Jobs, 1)
+	assert.Equal(t, remoteUUID.String(), res.Jobs[0].Uuid)
+	assert.Equal(t, int64(1), res.Jobs[0].Version)
+	assert.Equal(t, "errored", res.Jobs[0].LastRunState)
+	assert.Equal(t, int64(2), res.Jobs[0].ErroredRunCount)
+	assert.Equal(t, int64(0), res.Jobs[0].LastRunCreatedAt)
+
+	require.Len(t, res.Chains, 1)
+	assert.Equal(t, &pb.Chain{Id: "42", Type: pb.ChainType_CHAIN_TYPE_EVM}, res.Chains[0].Chain)
+	assert.Equal(t, int64(100), res.Chains[0].HeadNumber)
+	assert.Equal(t, int32(1), res.Chains[0].LiveNodeCount)
+	assert.Equal(t, int32(2), res.Chains[0].NodeCount)
+	require.Len(t, res.Chains[0].Keys, 1)
+	assert.Equal(t, "0x0000", res.Chains[0].Keys[0].Address)
+	assert.Equal(t, "1.000000000000000000", res.Chains[0].Keys[0].EthBalance)
+}
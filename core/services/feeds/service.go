@@ -4,6 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
@@ -16,15 +23,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
 )
 
 //go:generate mockery --name Service --output ./mocks/ --case=underscore
@@ -32,6 +44,7 @@ import (
 
 var (
 	ErrOCRDisabled          = errors.New("ocr is disabled")
+	ErrOCR2Disabled         = errors.New("ocr2 is disabled")
 	ErrSingleFeedsManager   = errors.New("only a single feeds manager is supported")
 	ErrJobAlreadyExists     = errors.New("a job for this contract address already exists - please use the 'force' option to replace it")
 	ErrFeedsManagerDisabled = errors.New("feeds manager is disabled")
@@ -40,6 +53,10 @@ var (
 		Name: "feeds_job_proposal_requests",
 		Help: "Metric to track job proposal requests",
 	})
+
+	// secretsReference matches a variable expression referring to a node
+	// secret, e.g. $(secrets.apiKey)
+	secretsReference = regexp.MustCompile(`\$\(\s*` + pipeline.SecretsKey + `\.`)
 )
 
 // Service represents a behavior of the feeds service
@@ -62,6 +79,8 @@ type Service interface {
 
 	ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error)
 	SyncNodeInfo(id int64) error
+	SyncHealth(id int64) error
+	GetHealthReport(ctx context.Context, id int64) (*HealthReport, error)
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 
 	GetJobProposal(id int64) (*JobProposal, error)
@@ -81,6 +100,9 @@ type Service interface {
 type service struct {
 	utils.StartStopOnce
 
+	chStop chan struct{}
+	wg     sync.WaitGroup
+
 	orm          ORM
 	jobORM       job.ORM
 	q            pg.Q
@@ -88,6 +110,7 @@ type service struct {
 	p2pKeyStore  keystore.P2P
 	ocr1KeyStore keystore.OCR
 	ocr2KeyStore keystore.OCR2
+	ethKeyStore  keystore.Eth
 	jobSpawner   job.Spawner
 	cfg          Config
 	connMgr      ConnectionsManager
@@ -110,6 +133,7 @@ func NewService(
 ) *service {
 	lggr = lggr.Named("Feeds")
 	svc := &service{
+		chStop:       make(chan struct{}),
 		orm:          orm,
 		jobORM:       jobORM,
 		q:            pg.NewQ(db, lggr, cfg),
@@ -118,6 +142,7 @@ func NewService(
 		csaKeyStore:  keyStore.CSA(),
 		ocr1KeyStore: keyStore.OCR(),
 		ocr2KeyStore: keyStore.OCR2(),
+		ethKeyStore:  keyStore.Eth(),
 		cfg:          cfg,
 		connMgr:      newConnectionsManager(lggr),
 		chainSet:     chainSet,
@@ -129,10 +154,11 @@ func NewService(
 }
 
 type RegisterManagerParams struct {
-	Name         string
-	URI          string
-	PublicKey    crypto.PublicKey
-	ChainConfigs []ChainConfig
+	Name             string
+	URI              string
+	PublicKey        crypto.PublicKey
+	ApprovalPolicies ApprovalPolicies
+	ChainConfigs     []ChainConfig
 }
 
 // RegisterManager registers a new ManagerService and attempts to establish a
@@ -148,10 +174,15 @@ func (s *service) RegisterManager(params RegisterManagerParams) (int64, error) {
 		return 0, ErrSingleFeedsManager
 	}
 
+	if err = params.ApprovalPolicies.Validate(); err != nil {
+		return 0, errors.Wrap(err, "invalid approval policies")
+	}
+
 	mgr := FeedsManager{
-		Name:      params.Name,
-		URI:       params.URI,
-		PublicKey: params.PublicKey,
+		Name:             params.Name,
+		URI:              params.URI,
+		PublicKey:        params.PublicKey,
+		ApprovalPolicies: params.ApprovalPolicies,
 	}
 
 	var id int64
@@ -180,6 +211,11 @@ func (s *service) RegisterManager(params RegisterManagerParams) (int64, error) {
 	mgr.ID = id
 	s.connectFeedManager(mgr, privkey)
 
+	// Managers registered at boot have their health reporter started by Start
+	s.IfStarted(func() {
+		s.startHealthReporter(mgr.ID)
+	})
+
 	return id, nil
 }
 
@@ -218,9 +254,131 @@ func (s *service) SyncNodeInfo(id int64) error {
 	return nil
 }
 
+// SyncHealth pushes the health of the jobs proposed by the feeds manager and
+// of the chains it has chain configs for to FMS.
+func (s *service) SyncHealth(id int64) error {
+	fmsClient, err := s.connMgr.GetClient(id)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch client")
+	}
+
+	ctx, cancel := utils.ContextFromChan(s.chStop)
+	defer cancel()
+
+	report, err := s.GetHealthReport(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmsClient.UpdateHealth(ctx, &pb.UpdateHealthRequest{
+		Jobs:   newJobHealthMsgs(report.Jobs),
+		Chains: newChainHealthMsgs(report.Chains),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetHealthReport generates a report of the health of the jobs created from
+// the feeds manager's approved job proposals and of the chains which the feeds
+// manager has chain configs for.
+func (s *service) GetHealthReport(ctx context.Context, id int64) (*HealthReport, error) {
+	jobs, err := s.orm.ListJobHealthsByManagerID(id, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch job health")
+	}
+
+	cfgs, err := s.orm.ListChainConfigsByManagerIDs([]int64{id})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch chain configs")
+	}
+
+	chains := make([]ChainHealth, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		chains = append(chains, s.getChainHealth(ctx, cfg))
+	}
+
+	return &HealthReport{
+		Jobs:   jobs,
+		Chains: chains,
+	}, nil
+}
+
+// getChainHealth fetches the health of the chain of a chain config. Any errors
+// are recorded on the health so that a single unhealthy chain does not prevent
+// the remaining chains from being reported.
+func (s *service) getChainHealth(ctx context.Context, cfg ChainConfig) ChainHealth {
+	health := ChainHealth{
+		ChainID:   cfg.ChainID,
+		ChainType: cfg.ChainType,
+		Keys:      []KeyHealth{},
+	}
+
+	// Only supports EVM Chains
+	if cfg.ChainType != ChainTypeEVM {
+		health.Error = null.StringFrom("unsupported chain type")
+		return health
+	}
+
+	chainID, ok := new(big.Int).SetString(cfg.ChainID, 10)
+	if !ok {
+		health.Error = null.StringFrom("invalid chain id")
+		return health
+	}
+
+	chain, err := s.chainSet.Get(chainID)
+	if err != nil {
+		health.Error = null.StringFrom(err.Error())
+		return health
+	}
+
+	for _, state := range chain.Client().NodeStates() {
+		health.NodeCount++
+		if state == evmclient.NodeStateAlive.String() {
+			health.LiveNodeCount++
+		}
+	}
+
+	var errs []string
+	keys, err := s.ethKeyStore.SendingKeys(chainID)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to load sending keys: %v", err))
+	}
+	for _, key := range keys {
+		kh := KeyHealth{Address: key.Address.Hex()}
+		// The balance monitor is nil when disabled
+		if bm := chain.BalanceMonitor(); bm != nil {
+			if bal := bm.GetEthBalance(key.Address.Address()); bal != nil {
+				kh.EthBalance = bal.String()
+			}
+		}
+		health.Keys = append(health.Keys, kh)
+	}
+
+	headCtx, cancel := evmclient.DefaultQueryCtx(ctx)
+	defer cancel()
+	head, err := chain.Client().HeadByNumber(headCtx, nil)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to fetch latest head: %v", err))
+	} else if head != nil {
+		health.HeadNumber = head.Number
+		health.HeadTimestamp = null.TimeFrom(head.Timestamp)
+	}
+
+	if len(errs) > 0 {
+		health.Error = null.StringFrom(strings.Join(errs, "; "))
+	}
+	return health
+}
+
 // UpdateManager updates the feed manager details, takes down the
 // connection and reestablishes a new connection with the updated public key.
 func (s *service) UpdateManager(ctx context.Context, mgr FeedsManager) error {
+	if err := mgr.ApprovalPolicies.Validate(); err != nil {
+		return errors.Wrap(err, "invalid approval policies")
+	}
+
 	q := s.q.WithOpts(pg.WithParentCtx(ctx))
 	err := q.Transaction(func(tx pg.Queryer) error {
 		txerr := s.orm.UpdateManager(mgr, pg.WithQueryer(tx))
@@ -427,7 +585,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	}
 
 	// TODO - Use parent context
-	var id int64
+	var id, specID int64
 	q := s.q.WithOpts(pg.WithParentCtx(context.Background()))
 	err = q.Transaction(func(tx pg.Queryer) error {
 		var txerr error
@@ -443,7 +601,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		}
 
 		// Create the spec version
-		_, txerr = s.orm.CreateSpec(JobProposalSpec{
+		specID, txerr = s.orm.CreateSpec(JobProposalSpec{
 			Definition:    args.Spec,
 			Status:        SpecStatusPending,
 			Version:       args.Version,
@@ -459,9 +617,107 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		return 0, err
 	}
 
+	// Apply the approval policies of the feeds manager. A failure to apply a
+	// policy leaves the spec pending for manual review, so the proposal itself
+	// is not failed.
+	if err = s.applyApprovalPolicies(ctx, args, existing, specID); err != nil {
+		s.lggr.Errorw("Failed to apply approval policies to job proposal spec",
+			"err", err,
+			"jobProposalID", id,
+			"specID", specID,
+		)
+	}
+
 	return id, nil
 }
 
+// applyApprovalPolicies evaluates the approval policies of the feeds manager
+// against a newly proposed spec and approves or rejects the spec when a policy
+// matches. The existing job proposal is nil when the spec belongs to a new job
+// proposal.
+func (s *service) applyApprovalPolicies(ctx context.Context, args *ProposeJobArgs, existing *JobProposal, specID int64) error {
+	mgr, err := s.orm.GetManager(args.FeedsManagerID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get feeds manager")
+	}
+
+	if len(mgr.ApprovalPolicies) == 0 {
+		return nil
+	}
+
+	j, err := s.generateJob(args.Spec)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate a job based on spec")
+	}
+
+	policyArgs := ApprovalPolicyArgs{
+		JobType:           feedsJobType(j.Type),
+		ReferencesSecrets: secretsReference.MatchString(args.Spec),
+	}
+
+	if existing != nil && existing.Status == JobProposalStatusApproved && existing.ExternalJobID.Valid {
+		policyArgs.IsUpdate = true
+
+		current, ferr := s.jobORM.FindJobByExternalJobID(existing.ExternalJobID.UUID, pg.WithParentCtx(ctx))
+		if ferr != nil {
+			return errors.Wrap(ferr, "FindJobByExternalJobID failed")
+		}
+
+		currentID, aerr := jobContractID(current)
+		if aerr != nil {
+			return aerr
+		}
+
+		proposedID, aerr := jobContractID(*j)
+		if aerr != nil {
+			return aerr
+		}
+
+		policyArgs.ContractAddressUnchanged = currentID == proposedID
+
+		var currentSecrets []string
+		if current.PipelineSpec != nil {
+			currentSecrets = current.PipelineSpec.AllowedSecrets
+		}
+		policyArgs.AllowedSecretsChanged = !sameStrings(currentSecrets, j.AllowedSecrets)
+	}
+
+	action, policy := mgr.ApprovalPolicies.Evaluate(policyArgs)
+	if action != ApprovalActionApprove && action != ApprovalActionReject {
+		return nil
+	}
+
+	// The spec is only approved or rejected if the policy which did so is
+	// recorded
+	var notify func() error
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	err = q.Transaction(func(tx pg.Queryer) error {
+		var txerr error
+		if action == ApprovalActionApprove {
+			if notify, txerr = s.approveSpec(ctx, specID, true, pg.WithQueryer(tx)); txerr != nil {
+				return errors.Wrap(txerr, "failed to auto approve spec")
+			}
+		} else {
+			if notify, txerr = s.rejectSpec(ctx, specID, pg.WithQueryer(tx)); txerr != nil {
+				return errors.Wrap(txerr, "failed to auto reject spec")
+			}
+		}
+
+		return s.orm.UpdateSpecApprovalPolicy(specID, policy.Type, pg.WithQueryer(tx))
+	})
+	if err != nil {
+		return err
+	}
+
+	s.lggr.Infow("Applied approval policy to job proposal spec",
+		"policy", policy.Type,
+		"action", action,
+		"specID", specID,
+	)
+
+	return notify()
+}
+
 // GetJobProposal gets a job proposal by id.
 func (s *service) GetJobProposal(id int64) (*JobProposal, error) {
 	return s.orm.GetJobProposal(id)
@@ -469,48 +725,58 @@ func (s *service) GetJobProposal(id int64) (*JobProposal, error) {
 
 // RejectSpec rejects a spec.
 func (s *service) RejectSpec(ctx context.Context, id int64) error {
-	pctx := pg.WithParentCtx(ctx)
+	notify, err := s.rejectSpec(ctx, id)
+	if err != nil {
+		return err
+	}
 
-	spec, err := s.orm.GetSpec(id, pctx)
+	return notify()
+}
+
+// rejectSpec rejects a spec, within the transaction given by qopts if any. It
+// returns the function which notifies the FMS of the rejection, which must only
+// be called once the transaction has been committed.
+func (s *service) rejectSpec(ctx context.Context, id int64, qopts ...pg.QOpt) (func() error, error) {
+	qopts = append([]pg.QOpt{pg.WithParentCtx(ctx)}, qopts...)
+
+	spec, err := s.orm.GetSpec(id, qopts...)
 	if err != nil {
-		return errors.Wrap(err, "orm: job proposal spec")
+		return nil, errors.Wrap(err, "orm: job proposal spec")
 	}
 
 	// Validate
 	if spec.Status != SpecStatusPending {
-		return errors.New("must be a pending job proposal spec")
+		return nil, errors.New("must be a pending job proposal spec")
 	}
 
-	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, qopts...)
 	if err != nil {
-		return errors.Wrap(err, "orm: job proposal")
+		return nil, errors.Wrap(err, "orm: job proposal")
 	}
 
 	fmsClient, err := s.connMgr.GetClient(proposal.FeedsManagerID)
 	if err != nil {
-		return errors.Wrap(err, "fms rpc client is not connected")
+		return nil, errors.Wrap(err, "fms rpc client is not connected")
 	}
 
-	q := s.q.WithOpts(pctx)
+	q := s.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
-		if err = s.orm.RejectSpec(id, pg.WithQueryer(tx)); err != nil {
-			return err
-		}
+		return s.orm.RejectSpec(id, pg.WithQueryer(tx))
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reject job proposal")
+	}
 
-		if _, err = fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
+	return func() error {
+		if _, err := fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
 			Uuid:    proposal.RemoteUUID.String(),
 			Version: int64(spec.Version),
 		}); err != nil {
-			return err
+			return errors.Wrap(err, "could not notify the feeds manager of the rejection")
 		}
 
 		return nil
-	})
-	if err != nil {
-		return errors.Wrap(err, "could not reject job proposal")
-	}
-
-	return nil
+	}, nil
 }
 
 // IsJobManaged determines is a job is managed by the Feeds Manager.
@@ -521,60 +787,67 @@ func (s *service) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 // ApproveSpec approves a spec for a job proposal and creates a job with the
 // spec.
 func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
-	pctx := pg.WithParentCtx(ctx)
+	notify, err := s.approveSpec(ctx, id, force)
+	if err != nil {
+		return err
+	}
 
-	spec, err := s.orm.GetSpec(id, pctx)
+	return notify()
+}
+
+// approveSpec approves a spec, within the transaction given by qopts if any. It
+// returns the function which notifies the FMS of the approval, which must only
+// be called once the transaction has been committed.
+func (s *service) approveSpec(ctx context.Context, id int64, force bool, qopts ...pg.QOpt) (func() error, error) {
+	qopts = append([]pg.QOpt{pg.WithParentCtx(ctx)}, qopts...)
+
+	spec, err := s.orm.GetSpec(id, qopts...)
 	if err != nil {
-		return errors.Wrap(err, "orm: job proposal spec")
+		return nil, errors.Wrap(err, "orm: job proposal spec")
 	}
 
 	switch spec.Status {
 	case SpecStatusApproved:
-		return errors.New("cannot approve an approved spec")
+		return nil, errors.New("cannot approve an approved spec")
 	case SpecStatusRejected:
-		return errors.New("cannot approve a rejected spec")
+		return nil, errors.New("cannot approve a rejected spec")
 	case SpecStatusCancelled:
 		// Allowed to approve a cancelled job if it is the latest job
 		latest, serr := s.orm.GetLatestSpec(spec.JobProposalID)
 		if serr != nil {
-			return errors.Wrap(err, "failed to get latest spec")
+			return nil, errors.Wrap(err, "failed to get latest spec")
 		}
 
 		if latest.ID != spec.ID {
-			return errors.New("cannot approve a cancelled spec")
+			return nil, errors.New("cannot approve a cancelled spec")
 		}
 	case SpecStatusPending:
 		// NOOP - pending jobs are allowed to be approved
 	default:
-		return errors.New("invalid status")
+		return nil, errors.New("invalid status")
 	}
 
-	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, pctx)
+	proposal, err := s.orm.GetJobProposal(spec.JobProposalID, qopts...)
 	if err != nil {
-		return errors.Wrap(err, "orm: job proposal")
+		return nil, errors.Wrap(err, "orm: job proposal")
 	}
 
 	fmsClient, err := s.connMgr.GetClient(proposal.FeedsManagerID)
 	if err != nil {
-		return errors.Wrap(err, "fms rpc client")
+		return nil, errors.Wrap(err, "fms rpc client")
 	}
 
 	j, err := s.generateJob(spec.Definition)
 	if err != nil {
-		return errors.Wrap(err, "could not generate job from spec")
+		return nil, errors.Wrap(err, "could not generate job from spec")
 	}
 
-	var address ethkey.EIP55Address
-	switch j.Type {
-	case job.OffchainReporting:
-		address = j.OCROracleSpec.ContractAddress
-	case job.FluxMonitor:
-		address = j.FluxMonitorSpec.ContractAddress
-	default:
-		return errors.Errorf("unsupported job type when approving job proposal specs: %s", j.Type)
+	address, err := jobContractAddress(*j)
+	if err != nil {
+		return nil, err
 	}
 
-	q := s.q.WithOpts(pctx)
+	q := s.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
 		existingJobID, txerr := s.jobORM.FindJobIDByAddress(address, pg.WithQueryer(tx))
 		if txerr == nil {
@@ -599,21 +872,22 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 			return txerr
 		}
 
-		// Send to FMS Client
-		if _, txerr = fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
-			Uuid:    proposal.RemoteUUID.String(),
-			Version: int64(spec.Version),
-		}); txerr != nil {
-			return txerr
-		}
-
 		return nil
 	})
 	if err != nil {
-		return errors.Wrap(err, "could not approve job proposal")
+		return nil, errors.Wrap(err, "could not approve job proposal")
 	}
 
-	return nil
+	return func() error {
+		if _, err := fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
+			Uuid:    proposal.RemoteUUID.String(),
+			Version: int64(spec.Version),
+		}); err != nil {
+			return errors.Wrap(err, "could not notify the feeds manager of the approval")
+		}
+
+		return nil
+	}, nil
 }
 
 // CancelSpec cancels a spec for a job proposal.
@@ -727,6 +1001,7 @@ func (s *service) Start() error {
 
 		mgr := mgrs[0]
 		s.connectFeedManager(mgr, privkey)
+		s.startHealthReporter(mgr.ID)
 
 		return nil
 	})
@@ -735,6 +1010,9 @@ func (s *service) Start() error {
 // Close shuts down the service
 func (s *service) Close() error {
 	return s.StopOnce("FeedsService", func() error {
+		close(s.chStop)
+		s.wg.Wait()
+
 		// This blocks until it finishes
 		s.connMgr.Close()
 
@@ -742,6 +1020,39 @@ func (s *service) Close() error {
 	})
 }
 
+// startHealthReporter starts pushing the health report to the feeds manager,
+// unless reporting is disabled.
+func (s *service) startHealthReporter(id int64) {
+	if interval := s.cfg.FeedsManagerHealthReportInterval(); interval > 0 {
+		s.wg.Add(1)
+		go s.runHealthReporter(id, interval)
+	}
+}
+
+// runHealthReporter periodically pushes the health report to the feeds
+// manager while it is connected.
+func (s *service) runHealthReporter(id int64, interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(utils.WithJitter(interval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !s.connMgr.IsConnected(id) {
+				continue
+			}
+
+			if err := s.SyncHealth(id); err != nil {
+				s.lggr.Warnw("Unable to sync health with FMS", "err", err)
+			}
+		case <-s.chStop:
+			return
+		}
+	}
+}
+
 // connectFeedManager connects to a feeds manager
 func (s *service) connectFeedManager(mgr FeedsManager, privkey []byte) {
 	s.connMgr.Connect(ConnectOpts{
@@ -799,6 +1110,11 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 			return nil, ErrOCRDisabled
 		}
 		js, err = ocr.ValidatedOracleSpecToml(s.chainSet, spec)
+	case job.OffchainReporting2:
+		if !s.cfg.Dev() && !s.cfg.FeatureOffchainReporting2() {
+			return nil, ErrOCR2Disabled
+		}
+		js, err = validate.ValidatedOracleSpecToml(s.cfg, spec)
 	case job.FluxMonitor:
 		js, err = fluxmonitorv2.ValidatedFluxMonitorSpec(s.cfg, spec)
 	default:
@@ -842,6 +1158,68 @@ func (s *service) newChainConfigMsg(cfg ChainConfig) (*pb.ChainConfig, error) {
 	}, nil
 }
 
+// newJobHealthMsgs generates JobHealth protobuf messages.
+func newJobHealthMsgs(healths []JobHealth) []*pb.JobHealth {
+	msgs := make([]*pb.JobHealth, 0, len(healths))
+	for _, h := range healths {
+		msgs = append(msgs, &pb.JobHealth{
+			Uuid:               h.RemoteUUID.String(),
+			Version:            int64(h.Version),
+			LastRunState:       h.LastRunState.ValueOrZero(),
+			LastRunCreatedAt:   unixOrZero(h.LastRunCreatedAt),
+			LastRunFinishedAt:  unixOrZero(h.LastRunFinishedAt),
+			ErroredRunCount:    h.ErroredRunCount,
+			SpecErrorCount:     h.SpecErrorCount,
+			LastTransmissionAt: unixOrZero(h.LastTransmissionAt),
+		})
+	}
+
+	return msgs
+}
+
+// newChainHealthMsgs generates ChainHealth protobuf messages.
+func newChainHealthMsgs(healths []ChainHealth) []*pb.ChainHealth {
+	msgs := make([]*pb.ChainHealth, 0, len(healths))
+	for _, h := range healths {
+		keys := make([]*pb.KeyHealth, 0, len(h.Keys))
+		for _, k := range h.Keys {
+			keys = append(keys, &pb.KeyHealth{
+				Address:    k.Address,
+				EthBalance: k.EthBalance,
+			})
+		}
+
+		chainType := pb.ChainType_CHAIN_TYPE_UNSPECIFIED
+		if h.ChainType == ChainTypeEVM {
+			chainType = pb.ChainType_CHAIN_TYPE_EVM
+		}
+
+		msgs = append(msgs, &pb.ChainHealth{
+			Chain: &pb.Chain{
+				Id:   h.ChainID,
+				Type: chainType,
+			},
+			HeadNumber:    h.HeadNumber,
+			HeadTimestamp: unixOrZero(h.HeadTimestamp),
+			LiveNodeCount: h.LiveNodeCount,
+			NodeCount:     h.NodeCount,
+			Keys:          keys,
+			Error:         h.Error.ValueOrZero(),
+		})
+	}
+
+	return msgs
+}
+
+// unixOrZero returns the unix timestamp in seconds, or 0 if the time is null.
+func unixOrZero(t null.Time) int64 {
+	if !t.Valid {
+		return 0
+	}
+
+	return t.Time.Unix()
+}
+
 // newFMConfigMsg generates a FMConfig protobuf message. Flux Monitor does not
 // have any configuration but this is here for consistency.
 func (*service) newFluxMonitorConfigMsg(cfg FluxMonitorConfig) *pb.FluxMonitorConfig {
@@ -956,9 +1334,75 @@ func (s *service) validateProposeJobArgs(args ProposeJobArgs) error {
 		return errors.New("only OCR job type supports multiaddr")
 	}
 
+	// Only the node operator may allow a job to use node secrets
+	if len(j.AllowedSecrets) > 0 {
+		return errors.New("job proposals may not allow secrets")
+	}
+
 	return nil
 }
 
+// jobContractAddress returns the address of the contract which the job
+// interacts with.
+func jobContractAddress(j job.Job) (ethkey.EIP55Address, error) {
+	switch j.Type {
+	case job.OffchainReporting:
+		return j.OCROracleSpec.ContractAddress, nil
+	case job.OffchainReporting2:
+		if j.OCR2OracleSpec.Relay != relaytypes.EVM {
+			return "", errors.Errorf("unsupported relay when approving job proposal specs: %s", j.OCR2OracleSpec.Relay)
+		}
+		return ethkey.NewEIP55Address(j.OCR2OracleSpec.ContractID)
+	case job.FluxMonitor:
+		return j.FluxMonitorSpec.ContractAddress, nil
+	default:
+		return "", errors.Errorf("unsupported job type when approving job proposal specs: %s", j.Type)
+	}
+}
+
+// sameStrings returns whether a and b hold the same strings, in any order.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// jobContractID returns the ID of the contract which the job interacts with,
+// which for EVM chains is its address.
+func jobContractID(j job.Job) (string, error) {
+	switch j.Type {
+	case job.OffchainReporting2:
+		return j.OCR2OracleSpec.ContractID, nil
+	default:
+		address, err := jobContractAddress(j)
+		return address.String(), err
+	}
+}
+
+// feedsJobType converts a job type into the job type used by the feeds
+// manager.
+func feedsJobType(t job.Type) string {
+	switch t {
+	case job.OffchainReporting:
+		return JobTypeOffchainReporting
+	case job.OffchainReporting2:
+		return JobTypeOffchainReporting2
+	case job.FluxMonitor:
+		return JobTypeFluxMonitor
+	default:
+		return string(t)
+	}
+}
+
 func (s *service) restartConnection(mgr FeedsManager) error {
 	s.lggr.Infof("Restarting connection")
 
@@ -1045,6 +1489,10 @@ func (ns NullService) RejectSpec(ctx context.Context, id int64) error {
 	return ErrFeedsManagerDisabled
 }
 func (ns NullService) SyncNodeInfo(id int64) error { return nil }
+func (ns NullService) SyncHealth(id int64) error   { return nil }
+func (ns NullService) GetHealthReport(ctx context.Context, id int64) (*HealthReport, error) {
+	return nil, ErrFeedsManagerDisabled
+}
 func (ns NullService) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
 	return ErrFeedsManagerDisabled
 }
@@ -72,6 +72,7 @@ type TestService struct {
 	p2pKeystore  *ksmocks.P2P
 	ocr1Keystore *ksmocks.OCR
 	ocr2Keystore *ksmocks.OCR2
+	ethKeystore  *ksmocks.Eth
 	cfg          *mocks.Config
 	cc           evm.ChainSet
 }
@@ -87,6 +88,7 @@ func setupTestService(t *testing.T) *TestService {
 		p2pKeystore  = &ksmocks.P2P{}
 		ocr1Keystore = &ksmocks.OCR{}
 		ocr2Keystore = &ksmocks.OCR2{}
+		ethKeystore  = &ksmocks.Eth{}
 		cfg          = &mocks.Config{}
 	)
 	orm.Test(t)
@@ -98,6 +100,7 @@ func setupTestService(t *testing.T) *TestService {
 	p2pKeystore.Test(t)
 	ocr1Keystore.Test(t)
 	ocr2Keystore.Test(t)
+	ethKeystore.Test(t)
 	cfg.Test(t)
 
 	t.Cleanup(func() {
@@ -111,6 +114,7 @@ func setupTestService(t *testing.T) *TestService {
 			p2pKeystore,
 			ocr1Keystore,
 			ocr2Keystore,
+			ethKeystore,
 			cfg,
 		)
 	})
@@ -124,6 +128,7 @@ func setupTestService(t *testing.T) *TestService {
 	keyStore.On("P2P").Return(p2pKeystore)
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
+	keyStore.On("Eth").Return(ethKeystore)
 	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, cfg, cc, logger.TestLogger(t), "1.0.0")
 	svc.SetConnectionsManager(connMgr)
 
@@ -138,6 +143,7 @@ func setupTestService(t *testing.T) *TestService {
 		p2pKeystore:  p2pKeystore,
 		ocr1Keystore: ocr1Keystore,
 		ocr2Keystore: ocr2Keystore,
+		ethKeystore:  ethKeystore,
 		cfg:          cfg,
 		cc:           cc,
 	}
@@ -191,6 +197,38 @@ func Test_Service_RegisterManager(t *testing.T) {
 	assert.Equal(t, actual, id)
 }
 
+func Test_Service_RegisterManager_AfterStart(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id     = int64(1)
+		params = feeds.RegisterManagerParams{
+			Name: "FMS",
+			URI:  "localhost:8080",
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+	svc.orm.On("ListManagers").Return([]feeds.FeedsManager{}, nil).Once()
+	require.Error(t, svc.Start())
+
+	svc.orm.On("CountManagers").Return(int64(0), nil)
+	svc.orm.On("CreateManager", mock.Anything, mock.Anything).Return(id, nil)
+	svc.orm.On("CreateBatchChainConfig", params.ChainConfigs, mock.Anything).Return([]int64{}, nil)
+	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{}))
+	svc.connMgr.On("Close")
+	// The health reporter is started for the new manager
+	svc.cfg.On("FeedsManagerHealthReportInterval").Return(time.Minute).Once()
+
+	actual, err := svc.RegisterManager(params)
+	require.NoError(t, err)
+	assert.Equal(t, id, actual)
+
+	require.NoError(t, svc.Close())
+}
+
 func Test_Service_ListManagers(t *testing.T) {
 	t.Parallel()
 
@@ -471,6 +509,53 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("GetJobProposalByRemoteUUID", jp.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
 				svc.orm.On("UpsertJobProposal", &jp, mock.Anything).Return(id, nil)
 				svc.orm.On("CreateSpec", spec, mock.Anything).Return(int64(100), nil)
+				svc.orm.On("GetManager", args.FeedsManagerID).Return(&feeds.FeedsManager{ID: args.FeedsManagerID}, nil)
+			},
+			args:   args,
+			wantID: id,
+		},
+		{
+			name: "Create success and auto rejected by approval policy",
+			before: func(svc *TestService) {
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+				svc.orm.On("GetJobProposalByRemoteUUID", jp.RemoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+				svc.orm.On("UpsertJobProposal", &jp, mock.Anything).Return(id, nil)
+				svc.orm.On("CreateSpec", spec, mock.Anything).Return(int64(100), nil)
+				svc.orm.On("GetManager", args.FeedsManagerID).Return(&feeds.FeedsManager{
+					ID: args.FeedsManagerID,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{
+							Type:     feeds.ApprovalPolicyAutoRejectUnlistedJobTypes,
+							JobTypes: []string{feeds.JobTypeOffchainReporting},
+						},
+					},
+				}, nil)
+
+				// Reject the spec, in the same transaction as recording the
+				// policy
+				svc.orm.On("GetSpec", int64(100), mock.Anything, mock.Anything).Return(&feeds.JobProposalSpec{
+					ID:            100,
+					Status:        feeds.SpecStatusPending,
+					Version:       args.Version,
+					JobProposalID: id,
+				}, nil)
+				svc.orm.On("GetJobProposal", id, mock.Anything, mock.Anything).Return(&jp, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("RejectSpec", int64(100), mock.Anything).Return(nil)
+				svc.orm.On("UpdateSpecApprovalPolicy",
+					int64(100),
+					feeds.ApprovalPolicyAutoRejectUnlistedJobTypes,
+					mock.Anything,
+				).Return(nil)
+
+				// The FMS is notified once the transaction is committed
+				svc.fmsClient.On("RejectedJob",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					&proto.RejectedJobRequest{
+						Uuid:    jp.RemoteUUID.String(),
+						Version: int64(args.Version),
+					},
+				).Return(&proto.RejectedJobResponse{}, nil)
 			},
 			args:   args,
 			wantID: id,
@@ -489,6 +574,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.On("ExistsSpecByJobProposalIDAndVersion", jp.ID, args.Version).Return(false, nil)
 				svc.orm.On("UpsertJobProposal", &jp, mock.Anything).Return(id, nil)
 				svc.orm.On("CreateSpec", spec, mock.Anything).Return(int64(100), nil)
+				svc.orm.On("GetManager", args.FeedsManagerID).Return(&feeds.FeedsManager{ID: args.FeedsManagerID}, nil)
 			},
 			args:   args,
 			wantID: id,
@@ -509,6 +595,16 @@ func Test_Service_ProposeJob(t *testing.T) {
 			},
 			wantErr: "only OCR job type supports multiaddr",
 		},
+		{
+			name: "must not allow secrets",
+			before: func(svc *TestService) {
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+			},
+			args: &feeds.ProposeJobArgs{
+				Spec: `allowedSecrets = ["apiKey"]` + TestSpec,
+			},
+			wantErr: "job proposals may not allow secrets",
+		},
 		{
 			name: "ensure an upsert validates the job proposal belongs to the feeds manager",
 			before: func(svc *TestService) {
@@ -670,6 +766,89 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Service_SyncHealth(t *testing.T) {
+	var (
+		mgr        = &feeds.FeedsManager{ID: 1}
+		remoteUUID = uuid.NewV4()
+		lastRunAt  = time.Now()
+		jobHealths = []feeds.JobHealth{
+			{
+				RemoteUUID:       remoteUUID,
+				Version:          2,
+				JobID:            1,
+				LastRunState:     null.StringFrom("completed"),
+				LastRunCreatedAt: null.TimeFrom(lastRunAt),
+				ErroredRunCount:  3,
+				SpecErrorCount:   1,
+			},
+		}
+		ccfg = feeds.ChainConfig{
+			ID:             100,
+			FeedsManagerID: mgr.ID,
+			ChainID:        "42",
+			ChainType:      feeds.ChainTypeEVM,
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.connMgr.On("GetClient", mgr.ID).Return(svc.fmsClient, nil)
+	svc.orm.On("ListJobHealthsByManagerID", mgr.ID, mock.Anything).Return(jobHealths, nil)
+	svc.orm.On("ListChainConfigsByManagerIDs", []int64{mgr.ID}).Return([]feeds.ChainConfig{ccfg}, nil)
+	svc.fmsClient.
+		On("UpdateHealth", mock.Anything, mock.MatchedBy(func(req *proto.UpdateHealthRequest) bool {
+			if len(req.Jobs) != 1 || len(req.Chains) != 1 {
+				return false
+			}
+
+			job := req.Jobs[0]
+			chain := req.Chains[0]
+
+			// The chain is not in the chain set so an error is reported
+			return job.Uuid == remoteUUID.String() &&
+				job.Version == 2 &&
+				job.LastRunState == "completed" &&
+				job.LastRunCreatedAt == lastRunAt.Unix() &&
+				job.LastRunFinishedAt == 0 &&
+				job.ErroredRunCount == 3 &&
+				job.SpecErrorCount == 1 &&
+				job.LastTransmissionAt == 0 &&
+				chain.Chain.Id == ccfg.ChainID &&
+				chain.Chain.Type == proto.ChainType_CHAIN_TYPE_EVM &&
+				chain.Error != ""
+		})).
+		Return(&proto.UpdateHealthResponse{}, nil)
+
+	err := svc.SyncHealth(mgr.ID)
+	require.NoError(t, err)
+}
+
+func Test_Service_GetHealthReport(t *testing.T) {
+	var (
+		mgr  = &feeds.FeedsManager{ID: 1}
+		cfgs = []feeds.ChainConfig{
+			{ID: 100, FeedsManagerID: mgr.ID, ChainID: "42", ChainType: feeds.ChainTypeEVM},
+			{ID: 101, FeedsManagerID: mgr.ID, ChainID: cltest.FixtureChainID.String(), ChainType: feeds.ChainTypeEVM},
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("ListJobHealthsByManagerID", mgr.ID, mock.Anything).Return([]feeds.JobHealth{}, nil)
+	svc.orm.On("ListChainConfigsByManagerIDs", []int64{mgr.ID}).Return(cfgs, nil)
+	svc.ethKeystore.On("SendingKeys", mock.Anything).Return(nil, errors.New("keystore is locked"))
+
+	report, err := svc.GetHealthReport(context.Background(), mgr.ID)
+	require.NoError(t, err)
+
+	// An error on one chain is reported on that chain only
+	require.Len(t, report.Chains, 2)
+	assert.Equal(t, "42", report.Chains[0].ChainID)
+	assert.True(t, report.Chains[0].Error.Valid)
+	assert.Equal(t, cltest.FixtureChainID.String(), report.Chains[1].ChainID)
+	assert.Equal(t, "failed to load sending keys: keystore is locked", report.Chains[1].Error.ValueOrZero())
+}
+
 func Test_Service_IsJobManaged(t *testing.T) {
 	t.Parallel()
 
@@ -1326,7 +1505,7 @@ answer1 [type=median index=0];
 			},
 			id:      spec.ID,
 			force:   false,
-			wantErr: "could not approve job proposal: failure",
+			wantErr: "could not notify the feeds manager of the approval: failure",
 		},
 	}
 
@@ -1588,6 +1767,7 @@ func Test_Service_StartStop(t *testing.T) {
 	svc.connMgr.On("IsConnected", mgr.ID).Return(false)
 	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{}))
 	svc.connMgr.On("Close")
+	svc.cfg.On("FeedsManagerHealthReportInterval").Return(time.Minute)
 
 	err = svc.Start()
 	require.NoError(t, err)
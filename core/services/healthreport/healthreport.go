@@ -0,0 +1,533 @@
+package healthreport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+const (
+	// bridgesPageSize is the number of bridges loaded at a time.
+	bridgesPageSize = 100
+	// maxConcurrentBridgeChecks is the number of bridges checked at a time.
+	maxConcurrentBridgeChecks = 10
+	// bridgeHealthTTL is how long the health of a bridge is reused for, so
+	// that frequent reports do not flood the external adapters.
+	bridgeHealthTTL = 30 * time.Second
+	// refreshInterval is how often the latest report is generated, see
+	// Reporter.LatestReport.
+	refreshInterval = time.Minute
+)
+
+// ErrNoReport is returned by LatestReport before the first report is
+// generated.
+var ErrNoReport = errors.New("no health report generated yet")
+
+// Config is the configuration of the health report.
+type Config interface {
+	pg.LogConfig
+	HealthBridgeTimeout() time.Duration
+	HealthHeadAgeThreshold() time.Duration
+	HealthTxBacklogThreshold() uint32
+}
+
+// DependencyType is the kind of an external dependency of the node.
+type DependencyType string
+
+const (
+	DependencyTypeBridge           DependencyType = "bridge"
+	DependencyTypeFeedsManager     DependencyType = "feeds_manager"
+	DependencyTypeTelemetryIngress DependencyType = "telemetry_ingress"
+)
+
+// ChainHealth is the health of an EVM chain. The chain is failing if its
+// latest head is older than HEALTH_HEAD_AGE_THRESHOLD, none of its RPC nodes
+// are alive, its log broadcaster is disconnected, or it has more than
+// HEALTH_TX_BACKLOG_THRESHOLD unstarted and unconfirmed transactions.
+type ChainHealth struct {
+	EVMChainID              string           `json:"evmChainID"`
+	Status                  services.Status  `json:"status"`
+	HeadNumber              null.Int         `json:"headNumber"`
+	HeadTimestamp           null.Time        `json:"headTimestamp"`
+	HeadAge                 *models.Duration `json:"headAge"`
+	LiveNodeCount           int32            `json:"liveNodeCount"`
+	NodeCount               int32            `json:"nodeCount"`
+	LogBroadcasterConnected bool             `json:"logBroadcasterConnected"`
+	UnstartedTxCount        int64            `json:"unstartedTxCount"`
+	UnconfirmedTxCount      int64            `json:"unconfirmedTxCount"`
+	// Output holds the reasons the chain is failing
+	Output string `json:"output"`
+}
+
+// JobHealth is the health of a job. The job is failing if its last run
+// errored, or if an error was recorded for it since its last run started.
+type JobHealth struct {
+	ID                int32           `json:"id"`
+	ExternalJobID     uuid.UUID       `json:"externalJobID"`
+	Name              null.String     `json:"name"`
+	Type              string          `json:"type"`
+	Status            services.Status `json:"status"`
+	LastRunState      null.String     `json:"lastRunState"`
+	LastRunCreatedAt  null.Time       `json:"lastRunCreatedAt"`
+	LastRunFinishedAt null.Time       `json:"lastRunFinishedAt"`
+	LastError         null.String     `json:"lastError"`
+	LastErrorAt       null.Time       `json:"lastErrorAt"`
+}
+
+// DependencyHealth is the health of an external dependency of the node: a
+// bridge, a feeds manager or the telemetry ingress server.
+type DependencyHealth struct {
+	Type   DependencyType  `json:"type"`
+	Name   string          `json:"name"`
+	Status services.Status `json:"status"`
+	Output string          `json:"output"`
+}
+
+// Report is the health of the node's chains, jobs and external dependencies.
+// The node is failing if any of them are failing.
+type Report struct {
+	Status       services.Status
+	Chains       []ChainHealth
+	Jobs         []JobHealth
+	Dependencies []DependencyHealth
+}
+
+// Reporter generates health reports. Once started, it also generates a
+// report every minute, which is returned by LatestReport. The latest report
+// is meant for unauthenticated requests, which must not be able to generate
+// reports at will.
+type Reporter interface {
+	services.ServiceCtx
+	Report(ctx context.Context) (*Report, error)
+	LatestReport() (*Report, error)
+}
+
+type reporter struct {
+	utils.StartStopOnce
+	q         pg.Q
+	cfg       Config
+	lggr      logger.Logger
+	client    *http.Client
+	chainSet  evm.ChainSet
+	bridgeORM bridges.ORM
+	feeds     feeds.Service
+	telemetry services.Checkable
+
+	bridgeHealthsMu sync.Mutex
+	bridgeHealths   map[string]cachedBridgeHealth // by bridge name
+
+	latestMu  sync.RWMutex
+	latest    *Report
+	latestErr error
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+// cachedBridgeHealth is the health of a bridge checked at checkedAt.
+type cachedBridgeHealth struct {
+	url       string
+	health    DependencyHealth
+	checkedAt time.Time
+}
+
+var _ Reporter = &reporter{}
+
+// NewReporter returns a new Reporter. telemetry is the telemetry ingress
+// client, or nil if telemetry ingress is disabled.
+func NewReporter(db *sqlx.DB, cfg Config, lggr logger.Logger, chainSet evm.ChainSet, bridgeORM bridges.ORM, feedsService feeds.Service, telemetry services.Checkable) Reporter {
+	return &reporter{
+		q:         pg.NewQ(db, lggr, cfg),
+		cfg:       cfg,
+		lggr:      lggr.Named("HealthReporter"),
+		client:    clhttp.NewUnrestrictedHTTPClient(),
+		chainSet:  chainSet,
+		bridgeORM: bridgeORM,
+		feeds:     feedsService,
+		telemetry: telemetry,
+
+		bridgeHealths: make(map[string]cachedBridgeHealth),
+		latestErr:     ErrNoReport,
+		chStop:        make(chan struct{}),
+	}
+}
+
+// Start starts generating the latest report.
+func (r *reporter) Start(context.Context) error {
+	return r.StartOnce("HealthReporter", func() error {
+		r.wgDone.Add(1)
+		go r.run()
+		return nil
+	})
+}
+
+// Close stops generating the latest report.
+func (r *reporter) Close() error {
+	return r.StopOnce("HealthReporter", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *reporter) run() {
+	defer r.wgDone.Done()
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(utils.WithJitter(refreshInterval))
+	defer ticker.Stop()
+	for {
+		r.refresh(ctx)
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh generates the latest report. A report interrupted by Close is not
+// kept.
+func (r *reporter) refresh(ctx context.Context) {
+	report, err := r.Report(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		r.lggr.Errorw("Failed to generate health report", "err", err)
+	}
+	r.latestMu.Lock()
+	defer r.latestMu.Unlock()
+	r.latest, r.latestErr = report, err
+}
+
+// LatestReport implements Reporter.
+func (r *reporter) LatestReport() (*Report, error) {
+	r.latestMu.RLock()
+	defer r.latestMu.RUnlock()
+	return r.latest, r.latestErr
+}
+
+// Report implements Reporter.
+func (r *reporter) Report(ctx context.Context) (*Report, error) {
+	report := &Report{
+		Chains:       []ChainHealth{},
+		Dependencies: []DependencyHealth{},
+	}
+
+	if r.chainSet != nil {
+		for _, chain := range r.chainSet.Chains() {
+			report.Chains = append(report.Chains, r.chainHealth(ctx, chain))
+		}
+	}
+
+	jobs, err := r.jobHealths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Jobs = jobs
+
+	deps, err := r.dependencyHealths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.Dependencies = deps
+
+	report.Status = services.StatusPassing
+	for _, c := range report.Chains {
+		if c.Status == services.StatusFailing {
+			report.Status = services.StatusFailing
+		}
+	}
+	for _, j := range report.Jobs {
+		if j.Status == services.StatusFailing {
+			report.Status = services.StatusFailing
+		}
+	}
+	for _, d := range report.Dependencies {
+		if d.Status == services.StatusFailing {
+			report.Status = services.StatusFailing
+		}
+	}
+	return report, nil
+}
+
+// chainHealth fetches the health of chain. Errors are recorded in the output
+// of the health, so that a single chain does not prevent the others from
+// being reported.
+func (r *reporter) chainHealth(ctx context.Context, chain evm.Chain) ChainHealth {
+	h := ChainHealth{EVMChainID: chain.ID().String()}
+	var errs []string
+
+	for _, state := range chain.Client().NodeStates() {
+		h.NodeCount++
+		if state == evmclient.NodeStateAlive.String() {
+			h.LiveNodeCount++
+		}
+	}
+	h.LogBroadcasterConnected = chain.LogBroadcaster().IsConnected()
+
+	q := r.q.WithOpts(pg.WithParentCtx(ctx))
+	var head struct {
+		Number    int64
+		Timestamp time.Time
+	}
+	err := q.Get(&head, `SELECT number, timestamp FROM evm_heads WHERE evm_chain_id = $1 ORDER BY number DESC LIMIT 1`, h.EVMChainID)
+	if err == nil {
+		h.HeadNumber = null.IntFrom(head.Number)
+		h.HeadTimestamp = null.TimeFrom(head.Timestamp)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		errs = append(errs, fmt.Sprintf("failed to load latest head: %v", err))
+	}
+
+	var txes struct {
+		Unstarted   int64
+		Unconfirmed int64
+	}
+	err = q.Get(&txes, `
+SELECT
+	COUNT(*) FILTER (WHERE state = 'unstarted') AS unstarted,
+	COUNT(*) FILTER (WHERE state IN ('in_progress', 'unconfirmed')) AS unconfirmed
+FROM eth_txes
+WHERE evm_chain_id = $1`, h.EVMChainID)
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("failed to count transactions: %v", err))
+	}
+	h.UnstartedTxCount = txes.Unstarted
+	h.UnconfirmedTxCount = txes.Unconfirmed
+
+	checkChain(&h, r.cfg, time.Now(), errs)
+	return h
+}
+
+// checkChain sets the head age and status of h against the thresholds in
+// cfg. errs are the errors encountered while fetching h.
+func checkChain(h *ChainHealth, cfg Config, now time.Time, errs []string) {
+	if h.HeadTimestamp.Valid {
+		age := now.Sub(h.HeadTimestamp.Time)
+		if age < 0 {
+			age = 0
+		}
+		d := models.MustMakeDuration(age)
+		h.HeadAge = &d
+		if threshold := cfg.HealthHeadAgeThreshold(); threshold > 0 && age > threshold {
+			errs = append(errs, fmt.Sprintf("latest head is older than %s", threshold))
+		}
+	} else {
+		errs = append(errs, "no heads received")
+	}
+	if h.NodeCount > 0 && h.LiveNodeCount == 0 {
+		errs = append(errs, "no live RPC nodes")
+	}
+	if !h.LogBroadcasterConnected {
+		errs = append(errs, "log broadcaster is disconnected")
+	}
+	if threshold := cfg.HealthTxBacklogThreshold(); threshold > 0 && h.UnstartedTxCount+h.UnconfirmedTxCount > int64(threshold) {
+		errs = append(errs, fmt.Sprintf("more than %d unstarted and unconfirmed transactions", threshold))
+	}
+
+	h.Status = services.StatusPassing
+	if len(errs) > 0 {
+		h.Status = services.StatusFailing
+	}
+	h.Output = strings.Join(errs, "; ")
+}
+
+func (r *reporter) jobHealths(ctx context.Context) ([]JobHealth, error) {
+	stmt := `
+SELECT
+	jobs.id,
+	jobs.external_job_id,
+	jobs.name,
+	jobs.type,
+	last_run.state AS last_run_state,
+	last_run.created_at AS last_run_created_at,
+	last_run.finished_at AS last_run_finished_at,
+	last_error.description AS last_error,
+	last_error.updated_at AS last_error_at
+FROM jobs
+LEFT JOIN LATERAL (
+	SELECT state, created_at, finished_at
+	FROM pipeline_runs
+	WHERE pipeline_spec_id = jobs.pipeline_spec_id
+	ORDER BY id DESC
+	LIMIT 1
+) AS last_run ON TRUE
+LEFT JOIN LATERAL (
+	SELECT description, updated_at
+	FROM job_spec_errors
+	WHERE job_id = jobs.id
+	ORDER BY updated_at DESC
+	LIMIT 1
+) AS last_error ON TRUE
+ORDER BY jobs.id;
+`
+	healths := []JobHealth{}
+	if err := r.q.WithOpts(pg.WithParentCtx(ctx)).Select(&healths, stmt); err != nil {
+		return nil, errors.Wrap(err, "failed to load job health")
+	}
+	for i := range healths {
+		checkJob(&healths[i])
+	}
+	return healths, nil
+}
+
+// checkJob sets the status of h.
+func checkJob(h *JobHealth) {
+	h.Status = services.StatusPassing
+	if h.LastRunState.Valid && h.LastRunState.String == "errored" {
+		h.Status = services.StatusFailing
+	}
+	if h.LastErrorAt.Valid && (!h.LastRunCreatedAt.Valid || h.LastErrorAt.Time.After(h.LastRunCreatedAt.Time)) {
+		h.Status = services.StatusFailing
+	}
+}
+
+func (r *reporter) dependencyHealths(ctx context.Context) ([]DependencyHealth, error) {
+	var deps []DependencyHealth
+
+	var bts []bridges.BridgeType
+	for offset := 0; ; offset += bridgesPageSize {
+		page, count, err := r.bridgeORM.BridgeTypes(offset, bridgesPageSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load bridges")
+		}
+		bts = append(bts, page...)
+		if len(page) == 0 || len(bts) >= count {
+			break
+		}
+	}
+	deps = append(deps, r.bridgeHealthsCached(ctx, bts, time.Now())...)
+
+	managers, err := r.feeds.ListManagers()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load feeds managers")
+	}
+	for _, mgr := range managers {
+		h := DependencyHealth{Type: DependencyTypeFeedsManager, Name: mgr.Name, Status: services.StatusPassing}
+		if !mgr.IsConnectionActive {
+			h.Status = services.StatusFailing
+			h.Output = "not connected"
+		}
+		deps = append(deps, h)
+	}
+
+	if r.telemetry != nil {
+		h := DependencyHealth{Type: DependencyTypeTelemetryIngress, Name: "telemetry ingress", Status: services.StatusPassing}
+		if err := r.telemetry.Healthy(); err != nil {
+			h.Status = services.StatusFailing
+			h.Output = err.Error()
+		}
+		deps = append(deps, h)
+	}
+
+	return deps, nil
+}
+
+// bridgeHealthsCached returns the health of bts, checking at most
+// maxConcurrentBridgeChecks bridges at a time. The health of a bridge checked
+// less than bridgeHealthTTL before now is reused.
+func (r *reporter) bridgeHealthsCached(ctx context.Context, bts []bridges.BridgeType, now time.Time) []DependencyHealth {
+	healths := make([]DependencyHealth, len(bts))
+	checked := make([]bool, len(bts))
+
+	r.bridgeHealthsMu.Lock()
+	for i, bt := range bts {
+		cached, ok := r.bridgeHealths[bt.Name.String()]
+		if ok && cached.url == bt.URL.String() && now.Sub(cached.checkedAt) < bridgeHealthTTL {
+			healths[i] = cached.health
+		} else {
+			checked[i] = true
+		}
+	}
+	r.bridgeHealthsMu.Unlock()
+
+	sem := make(chan struct{}, maxConcurrentBridgeChecks)
+	var wg sync.WaitGroup
+	for i, bt := range bts {
+		if !checked[i] {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, bt bridges.BridgeType) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				healths[i] = DependencyHealth{Type: DependencyTypeBridge, Name: bt.Name.String(), Status: services.StatusFailing, Output: ctx.Err().Error()}
+				return
+			}
+			defer func() { <-sem }()
+			healths[i] = r.bridgeHealth(ctx, bt)
+		}(i, bt)
+	}
+	wg.Wait()
+
+	// Only the bridges which still exist are kept, and a cancelled check is
+	// not cached
+	cache := make(map[string]cachedBridgeHealth, len(bts))
+	r.bridgeHealthsMu.Lock()
+	defer r.bridgeHealthsMu.Unlock()
+	for i, bt := range bts {
+		name := bt.Name.String()
+		if !checked[i] {
+			if cached, ok := r.bridgeHealths[name]; ok {
+				cache[name] = cached
+			}
+		} else if ctx.Err() == nil {
+			cache[name] = cachedBridgeHealth{url: bt.URL.String(), health: healths[i], checkedAt: now}
+		}
+	}
+	r.bridgeHealths = cache
+	return healths
+}
+
+// bridgeHealth checks that the bridge's external adapter is reachable. Any
+// response, whatever its status, means the adapter is up.
+func (r *reporter) bridgeHealth(ctx context.Context, bt bridges.BridgeType) DependencyHealth {
+	h := DependencyHealth{Type: DependencyTypeBridge, Name: bt.Name.String(), Status: services.StatusPassing}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.HealthBridgeTimeout())
+	defer cancel()
+	u := url.URL(bt.URL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err == nil {
+		var resp *http.Response
+		resp, err = r.client.Do(req)
+		if err == nil {
+			r.lggr.ErrorIfClosing(resp.Body, "bridge response body")
+			return h
+		}
+	}
+
+	// Bridge URLs may contain credentials, so the URL is left out of the output
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		err = uerr.Err
+	}
+	h.Status = services.StatusFailing
+	h.Output = err.Error()
+	return h
+}
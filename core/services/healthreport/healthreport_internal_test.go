@@ -0,0 +1,233 @@
+package healthreport
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testConfig struct {
+	bridgeTimeout    time.Duration
+	headAgeThreshold time.Duration
+	txBacklog        uint32
+}
+
+func (testConfig) LogSQL() bool                               { return false }
+func (c testConfig) HealthBridgeTimeout() time.Duration       { return c.bridgeTimeout }
+func (c testConfig) HealthHeadAgeThreshold() time.Duration    { return c.headAgeThreshold }
+func (c testConfig) HealthTxBacklogThreshold() uint32         { return c.txBacklog }
+func (testConfig) DatabaseDefaultQueryTimeout() time.Duration { return time.Second }
+
+func Test_checkChain(t *testing.T) {
+	cfg := testConfig{headAgeThreshold: time.Minute, txBacklog: 10}
+	now := time.Now()
+	healthy := func() ChainHealth {
+		return ChainHealth{
+			HeadNumber:              null.IntFrom(42),
+			HeadTimestamp:           null.TimeFrom(now.Add(-10 * time.Second)),
+			LiveNodeCount:           1,
+			NodeCount:               2,
+			LogBroadcasterConnected: true,
+			UnstartedTxCount:        5,
+			UnconfirmedTxCount:      5,
+		}
+	}
+
+	t.Run("passing", func(t *testing.T) {
+		h := healthy()
+		checkChain(&h, cfg, now, nil)
+		assert.Equal(t, services.StatusPassing, h.Status)
+		assert.Empty(t, h.Output)
+		if assert.NotNil(t, h.HeadAge) {
+			assert.Equal(t, 10*time.Second, h.HeadAge.Duration())
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		modify func(h *ChainHealth)
+		output string
+	}{
+		{"old head", func(h *ChainHealth) { h.HeadTimestamp = null.TimeFrom(now.Add(-2 * time.Minute)) }, "latest head is older than 1m0s"},
+		{"no heads", func(h *ChainHealth) { h.HeadNumber, h.HeadTimestamp = null.Int{}, null.Time{} }, "no heads received"},
+		{"no live nodes", func(h *ChainHealth) { h.LiveNodeCount = 0 }, "no live RPC nodes"},
+		{"log broadcaster disconnected", func(h *ChainHealth) { h.LogBroadcasterConnected = false }, "log broadcaster is disconnected"},
+		{"tx backlog", func(h *ChainHealth) { h.UnconfirmedTxCount = 6 }, "more than 10 unstarted and unconfirmed transactions"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			h := healthy()
+			tc.modify(&h)
+			checkChain(&h, cfg, now, nil)
+			assert.Equal(t, services.StatusFailing, h.Status)
+			assert.Equal(t, tc.output, h.Output)
+		})
+	}
+
+	t.Run("fetch errors", func(t *testing.T) {
+		h := healthy()
+		h.LogBroadcasterConnected = false
+		checkChain(&h, cfg, now, []string{"failed to count transactions: boom"})
+		assert.Equal(t, services.StatusFailing, h.Status)
+		assert.Equal(t, "failed to count transactions: boom; log broadcaster is disconnected", h.Output)
+	})
+
+	t.Run("thresholds of 0 are disabled", func(t *testing.T) {
+		h := healthy()
+		h.HeadTimestamp = null.TimeFrom(now.Add(-time.Hour))
+		h.UnstartedTxCount = 1000
+		checkChain(&h, testConfig{}, now, nil)
+		assert.Equal(t, services.StatusPassing, h.Status)
+	})
+}
+
+func Test_checkJob(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name   string
+		health JobHealth
+		status services.Status
+	}{
+		{"never ran", JobHealth{}, services.StatusPassing},
+		{"last run completed", JobHealth{LastRunState: null.StringFrom("completed"), LastRunCreatedAt: null.TimeFrom(now)}, services.StatusPassing},
+		{"last run errored", JobHealth{LastRunState: null.StringFrom("errored"), LastRunCreatedAt: null.TimeFrom(now)}, services.StatusFailing},
+		{"error before last run", JobHealth{
+			LastRunState:     null.StringFrom("completed"),
+			LastRunCreatedAt: null.TimeFrom(now),
+			LastError:        null.StringFrom("boom"),
+			LastErrorAt:      null.TimeFrom(now.Add(-time.Minute)),
+		}, services.StatusPassing},
+		{"error since last run", JobHealth{
+			LastRunState:     null.StringFrom("completed"),
+			LastRunCreatedAt: null.TimeFrom(now),
+			LastError:        null.StringFrom("boom"),
+			LastErrorAt:      null.TimeFrom(now.Add(time.Minute)),
+		}, services.StatusFailing},
+		{"error without runs", JobHealth{LastError: null.StringFrom("boom"), LastErrorAt: null.TimeFrom(now)}, services.StatusFailing},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			checkJob(&tc.health)
+			assert.Equal(t, tc.status, tc.health.Status)
+		})
+	}
+}
+
+func Test_reporter_bridgeHealth(t *testing.T) {
+	r := &reporter{
+		cfg:    testConfig{bridgeTimeout: time.Second},
+		lggr:   logger.TestLogger(t),
+		client: http.DefaultClient,
+	}
+	bridge := func(u string) bridges.BridgeType {
+		return bridges.BridgeType{Name: "adapter", URL: models.WebURL(*testutils.MustParseURL(t, u))}
+	}
+
+	t.Run("reachable", func(t *testing.T) {
+		// Any response means the adapter is up
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodHead, r.Method)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}))
+		t.Cleanup(s.Close)
+
+		h := r.bridgeHealth(testutils.Context(t), bridge(s.URL))
+		assert.Equal(t, DependencyHealth{Type: DependencyTypeBridge, Name: "adapter", Status: services.StatusPassing}, h)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		s := httptest.NewServer(http.NotFoundHandler())
+		s.Close()
+
+		h := r.bridgeHealth(testutils.Context(t), bridge("http://user:secret@"+s.Listener.Addr().String()))
+		assert.Equal(t, services.StatusFailing, h.Status)
+		assert.NotEmpty(t, h.Output)
+		assert.NotContains(t, h.Output, "secret")
+	})
+}
+
+func Test_reporter_bridgeHealthsCached(t *testing.T) {
+	var mu sync.Mutex
+	var requests, inFlight, maxInFlight int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	t.Cleanup(s.Close)
+	counts := func() (int, int) {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests, maxInFlight
+	}
+
+	r := &reporter{
+		cfg:           testConfig{bridgeTimeout: time.Second},
+		lggr:          logger.TestLogger(t),
+		client:        http.DefaultClient,
+		bridgeHealths: make(map[string]cachedBridgeHealth),
+	}
+	var bts []bridges.BridgeType
+	for i := 0; i < 3*maxConcurrentBridgeChecks; i++ {
+		bts = append(bts, bridges.BridgeType{
+			Name: bridges.MustParseBridgeName(fmt.Sprintf("adapter%d", i)),
+			URL:  models.WebURL(*testutils.MustParseURL(t, s.URL)),
+		})
+	}
+	now := time.Now()
+
+	healths := r.bridgeHealthsCached(testutils.Context(t), bts, now)
+	require.Len(t, healths, len(bts))
+	for i, h := range healths {
+		assert.Equal(t, bts[i].Name.String(), h.Name)
+		assert.Equal(t, services.StatusPassing, h.Status)
+	}
+	n, peak := counts()
+	assert.Equal(t, len(bts), n)
+	assert.LessOrEqual(t, peak, maxConcurrentBridgeChecks)
+
+	// Within the TTL, the cached health is reused
+	healths = r.bridgeHealthsCached(testutils.Context(t), bts, now.Add(bridgeHealthTTL/2))
+	require.Len(t, healths, len(bts))
+	n, _ = counts()
+	assert.Equal(t, len(bts), n)
+
+	// A bridge whose URL changed is checked again
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+	bts[0].URL = models.WebURL(*testutils.MustParseURL(t, unreachable.URL))
+	healths = r.bridgeHealthsCached(testutils.Context(t), bts, now.Add(bridgeHealthTTL/2))
+	assert.Equal(t, services.StatusFailing, healths[0].Status)
+	n, _ = counts()
+	assert.Equal(t, len(bts), n)
+
+	// Past the TTL, every bridge is checked again
+	r.bridgeHealthsCached(testutils.Context(t), bts, now.Add(bridgeHealthTTL))
+	n, _ = counts()
+	assert.Equal(t, 2*len(bts)-1, n)
+
+	// Deleted bridges are dropped from the cache
+	r.bridgeHealthsCached(testutils.Context(t), bts[:1], now.Add(bridgeHealthTTL))
+	assert.Len(t, r.bridgeHealths, 1)
+}
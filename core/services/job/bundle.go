@@ -0,0 +1,377 @@
+package job
+
+import (
+	"encoding"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BundleVersion is the version of the bundle format written by this node.
+const BundleVersion = 1
+
+// Key types referenced by bundles.
+const (
+	KeyTypeEth    = "eth"
+	KeyTypeOCR    = "ocr"
+	KeyTypeOCR2   = "ocr2"
+	KeyTypeVRF    = "vrf"
+	KeyTypeSolana = "solana"
+	KeyTypeTerra  = "terra"
+)
+
+// Bundle is a portable set of jobs, together with the bridges, external
+// initiators and keys they depend on, for moving jobs between nodes. It never
+// contains any secrets: bridges get new tokens on import, and keys are only
+// referenced, so they must be imported separately.
+type Bundle struct {
+	Version            int                                `json:"version"`
+	ExportedAt         time.Time                          `json:"exportedAt"`
+	Jobs               []BundleJob                        `json:"jobs"`
+	Bridges            []bridges.BridgeTypeRequest        `json:"bridges"`
+	ExternalInitiators []bridges.ExternalInitiatorRequest `json:"externalInitiators"`
+	Keys               []KeyReference                     `json:"keys"`
+}
+
+// BundleJob is a job spec in a bundle.
+type BundleJob struct {
+	Name string `json:"name"`
+	Type Type   `json:"type"`
+	TOML string `json:"toml"`
+}
+
+// KeyReference identifies a key that a job depends on, e.g. the address of an
+// ETH key or the ID of an OCR key bundle.
+type KeyReference struct {
+	Type       string     `json:"type"`
+	ID         string     `json:"id"`
+	EVMChainID *utils.Big `json:"evmChainID,omitempty"`
+}
+
+// TOML returns a TOML spec for the job, as accepted by the job type's
+// validation. Values loaded from the node's configuration rather than the
+// original spec are omitted.
+func (j Job) TOML() (string, error) {
+	m := map[string]interface{}{
+		"type":          string(j.Type),
+		"schemaVersion": int64(j.SchemaVersion),
+		"externalJobID": j.ExternalJobID.String(),
+	}
+	if j.Name.Valid {
+		m["name"] = j.Name.String
+	}
+	if j.MaxTaskDuration != 0 {
+		m["maxTaskDuration"] = j.MaxTaskDuration.Duration().String()
+	}
+	if len(j.AllowedSecrets) > 0 {
+		m["allowedSecrets"] = []string(j.AllowedSecrets)
+	}
+
+	var spec interface{}
+	switch j.Type {
+	case Cron:
+		spec = j.CronSpec
+	case DirectRequest:
+		spec = j.DirectRequestSpec
+	case FluxMonitor:
+		spec = j.FluxMonitorSpec
+	case OffchainReporting:
+		spec = j.OCROracleSpec
+	case OffchainReporting2:
+		spec = j.OCR2OracleSpec
+	case Keeper:
+		spec = j.KeeperSpec
+	case VRF:
+		spec = j.VRFSpec
+	case Webhook:
+		if j.WebhookSpec == nil {
+			break
+		}
+		var eis []map[string]interface{}
+		for _, eiws := range j.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			eis = append(eis, map[string]interface{}{
+				"name": eiws.ExternalInitiator.Name,
+				"spec": eiws.Spec.String(),
+			})
+		}
+		if len(eis) > 0 {
+			m["externalInitiators"] = eis
+		}
+	case BlockhashStore:
+		spec = j.BlockhashStoreSpec
+	case Bootstrap:
+		spec = j.BootstrapSpec
+	default:
+		return "", errors.Errorf("unknown job type: %s", j.Type)
+	}
+	if spec != nil {
+		if err := tomlFields(reflect.ValueOf(spec), m); err != nil {
+			return "", errors.Wrapf(err, "failed to encode %s spec", j.Type)
+		}
+	}
+	if j.Type == OffchainReporting && j.OCROracleSpec != nil {
+		// Must always be set, even when false
+		m["isBootstrapPeer"] = j.OCROracleSpec.IsBootstrapPeer
+	}
+
+	tree, err := toml.TreeFromMap(m)
+	if err != nil {
+		return "", err
+	}
+	if source := j.pipelineSource(); source != "" {
+		// Literal strings keep the source readable, as they don't escape
+		// anything, but they cannot contain '''. They are also always written
+		// with a trailing newline.
+		if strings.Contains(source, "'''") {
+			tree.Set("observationSource", source)
+		} else {
+			tree.SetWithOptions("observationSource", toml.SetOptions{Multiline: true, Literal: true}, strings.TrimSuffix(source, "\n"))
+		}
+	}
+	return tree.ToTomlString()
+}
+
+func (j Job) pipelineSource() string {
+	if j.PipelineSpec != nil {
+		return j.PipelineSpec.DotDagSource
+	}
+	return j.Pipeline.Source
+}
+
+// BridgeNames returns the names of the bridges used by the job's pipeline,
+// and by the juels per fee coin pipeline of OCR2 median jobs.
+func (j Job) BridgeNames() ([]bridges.BridgeName, error) {
+	sources := []string{j.pipelineSource()}
+	if j.OCR2OracleSpec != nil && j.OCR2OracleSpec.PluginType == Median {
+		if source, ok := j.OCR2OracleSpec.PluginConfig["juelsPerFeeCoinSource"].(string); ok {
+			sources = append(sources, source)
+		}
+	}
+
+	seen := make(map[bridges.BridgeName]struct{})
+	var names []bridges.BridgeName
+	for _, source := range sources {
+		p, err := pipeline.Parse(source)
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range p.Tasks {
+			if task.Type() != pipeline.TaskTypeBridge {
+				continue
+			}
+			name, err := bridges.ParseBridgeName(task.(*pipeline.BridgeTask).Name)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Slice(names, func(i, k int) bool { return names[i] < names[k] })
+	return names, nil
+}
+
+// ExternalInitiatorNames returns the names of the external initiators that
+// can run a webhook job.
+func (j Job) ExternalInitiatorNames() []string {
+	if j.WebhookSpec == nil {
+		return nil
+	}
+	var names []string
+	for _, eiws := range j.WebhookSpec.ExternalInitiatorWebhookSpecs {
+		names = append(names, eiws.ExternalInitiator.Name)
+	}
+	return names
+}
+
+// KeyReferences returns the keys that the job's spec refers to.
+func (j Job) KeyReferences() []KeyReference {
+	var refs []KeyReference
+	eth := func(chainID *utils.Big, address string) {
+		refs = append(refs, KeyReference{Type: KeyTypeEth, ID: address, EVMChainID: chainID})
+	}
+	switch {
+	case j.OCROracleSpec != nil:
+		s := j.OCROracleSpec
+		if s.EncryptedOCRKeyBundleID != nil && !s.EncryptedOCRKeyBundleIDEnv {
+			refs = append(refs, KeyReference{Type: KeyTypeOCR, ID: s.EncryptedOCRKeyBundleID.String()})
+		}
+		if s.TransmitterAddress != nil && !s.TransmitterAddressEnv {
+			eth(s.EVMChainID, s.TransmitterAddress.Hex())
+		}
+	case j.OCR2OracleSpec != nil:
+		s := j.OCR2OracleSpec
+		if s.OCRKeyBundleID.Valid {
+			refs = append(refs, KeyReference{Type: KeyTypeOCR2, ID: s.OCRKeyBundleID.String})
+		}
+		if s.TransmitterID.Valid {
+			switch s.Relay {
+			case relaytypes.EVM:
+				var chainID *utils.Big
+				if id, ok := new(big.Int).SetString(toString(s.RelayConfig["chainID"]), 10); ok {
+					chainID = utils.NewBig(id)
+				}
+				eth(chainID, s.TransmitterID.String)
+			case relaytypes.Solana:
+				refs = append(refs, KeyReference{Type: KeyTypeSolana, ID: s.TransmitterID.String})
+			case relaytypes.Terra:
+				refs = append(refs, KeyReference{Type: KeyTypeTerra, ID: s.TransmitterID.String})
+			}
+		}
+	case j.KeeperSpec != nil:
+		eth(j.KeeperSpec.EVMChainID, j.KeeperSpec.FromAddress.Hex())
+	case j.VRFSpec != nil:
+		refs = append(refs, KeyReference{Type: KeyTypeVRF, ID: j.VRFSpec.PublicKey.String()})
+		for _, a := range j.VRFSpec.FromAddresses {
+			eth(j.VRFSpec.EVMChainID, a.Hex())
+		}
+	case j.BlockhashStoreSpec != nil:
+		if j.BlockhashStoreSpec.FromAddress != nil {
+			eth(j.BlockhashStoreSpec.EVMChainID, j.BlockhashStoreSpec.FromAddress.Hex())
+		}
+	}
+	return refs
+}
+
+// EVMChainID returns the ID of the EVM chain the job runs on, or nil if the
+// job does not specify one.
+func (j Job) EVMChainID() *utils.Big {
+	switch {
+	case j.OCROracleSpec != nil:
+		return j.OCROracleSpec.EVMChainID
+	case j.DirectRequestSpec != nil:
+		return j.DirectRequestSpec.EVMChainID
+	case j.FluxMonitorSpec != nil:
+		return j.FluxMonitorSpec.EVMChainID
+	case j.KeeperSpec != nil:
+		return j.KeeperSpec.EVMChainID
+	case j.VRFSpec != nil:
+		return j.VRFSpec.EVMChainID
+	case j.BlockhashStoreSpec != nil:
+		return j.BlockhashStoreSpec.EVMChainID
+	}
+	return nil
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case int64:
+		return strconv.FormatInt(s, 10)
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+	return ""
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// tomlFields adds the fields of the spec struct to m, keyed like the spec's
+// TOML is decoded. IDs, timestamps, zero values, and values loaded from the
+// node's configuration (flagged by a matching *Env field) are skipped.
+func tomlFields(v reflect.Value, m map[string]interface{}) error {
+	v = reflect.Indirect(v)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("toml"), ",")[0]
+		if tag == "-" || f.Name == "ID" || strings.HasSuffix(f.Name, "Env") || f.Type == reflect.TypeOf(time.Time{}) {
+			continue
+		}
+		if env := v.FieldByName(f.Name + "Env"); env.IsValid() && env.Kind() == reflect.Bool && env.Bool() {
+			continue
+		}
+		key := tag
+		if key == "" {
+			key = strings.ToLower(f.Name[:1]) + f.Name[1:]
+		}
+		value, ok, err := tomlValue(v.Field(i))
+		if err != nil {
+			return errors.Wrapf(err, "field %s", f.Name)
+		}
+		if ok {
+			m[key] = value
+		}
+	}
+	return nil
+}
+
+// tomlValue converts v to a value that can be encoded as TOML, and decoded
+// back into v's type. ok is false for nil and zero values, but not for
+// pointers to zero values, e.g. chain ID 0.
+func tomlValue(v reflect.Value) (value interface{}, ok bool, err error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		v = v.Elem()
+	} else if v.IsZero() {
+		return nil, false, nil
+	}
+
+	switch {
+	case v.Type() == durationType:
+		return time.Duration(v.Int()).String(), true, nil
+	case v.Kind() == reflect.Map:
+		if m, isMap := v.Interface().(JSONConfig); isMap {
+			return map[string]interface{}(m), true, nil
+		}
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		var values []interface{}
+		for i := 0; i < v.Len(); i++ {
+			if elem, elemOK, elemErr := tomlValue(v.Index(i)); elemErr != nil {
+				return nil, false, elemErr
+			} else if elemOK {
+				values = append(values, elem)
+			}
+		}
+		return values, len(values) > 0, nil
+	case reflect.PtrTo(v.Type()).Implements(textMarshalerType):
+		// Copy, since some types only implement it with a pointer receiver
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		b, err := p.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err == nil, err
+	case reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) && v.Type().Implements(stringerType):
+		// e.g. models.Sha256Hash, which decodes from text but has no MarshalText
+		return v.Interface().(fmt.Stringer).String(), true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true, nil
+	case reflect.Bool:
+		return v.Bool(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), true, nil
+	case reflect.Float32:
+		// Avoid float32 rounding errors, e.g. 0.1 => 0.10000000149011612
+		f, err := strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		return f, err == nil, err
+	case reflect.Float64:
+		return v.Float(), true, nil
+	}
+	return nil, false, errors.Errorf("unsupported type %s", v.Type())
+}
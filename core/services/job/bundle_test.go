@@ -0,0 +1,195 @@
+package job_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const ocr2Spec = `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+p2pBootstrapPeers  = ["12D3KooWHfYFQ8hGttAYbMCevQVESEQhzJAqFZokMVtom8bNxwGq@127.0.0.1:5001"]
+ocrKeyBundleID     = "73e8966a78ca09bb912e9565cfb79fbe8a6048fab1f0cf49b18047c3895e0447"
+transmitterID      = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+blockchainTimeout  = "20s"
+observationSource  = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1_multiply [type=multiply times=1.23];
+ds1 -> ds1_parse -> ds1_multiply -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse;
+"""
+`
+
+type fluxMonitorConfig struct{}
+
+func (fluxMonitorConfig) DefaultHTTPTimeout() models.Duration {
+	return models.MustMakeDuration(15 * time.Second)
+}
+
+func TestJob_TOML(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	chainSet := evmtest.NewChainSet(t, evmtest.TestChainOpts{GeneralConfig: cfg})
+
+	ei := bridges.ExternalInitiator{ID: 1, Name: "someei"}
+	eim := new(webhookmocks.ExternalInitiatorManager)
+	eim.Test(t)
+	eim.On("FindExternalInitiatorByName", ei.Name).Return(ei, nil)
+
+	for _, tc := range []struct {
+		name     string
+		toml     string
+		validate func(string) (job.Job, error)
+	}{
+		{"cron", testspecs.CronSpec, cron.ValidatedCronSpec},
+		{"directrequest", testspecs.DirectRequestSpecWithRequestersAndMinContractPayment, directrequest.ValidatedDirectRequestSpec},
+		{"fluxmonitor", testspecs.FluxMonitorSpec, func(ts string) (job.Job, error) {
+			return fluxmonitorv2.ValidatedFluxMonitorSpec(fluxMonitorConfig{}, ts)
+		}},
+		{"keeper", testspecs.GenerateKeeperSpec(testspecs.KeeperSpecParams{
+			Name:                     "keeper",
+			ContractAddress:          "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+			FromAddress:              "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+			EvmChainID:               4,
+			MinIncomingConfirmations: 3,
+			ObservationSource:        keeper.ExpectedObservationSource,
+		}).Toml(), keeper.ValidatedKeeperSpec},
+		{"vrf", testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
+			FromAddresses:       []string{"0xa8037A20989AFcBC51798de9762b351D63ff462e"},
+			RequestTimeout:      time.Hour,
+			BackoffInitialDelay: time.Minute,
+			BackoffMaxDelay:     time.Hour,
+			V2:                  true,
+		}).Toml(), vrf.ValidatedVRFSpec},
+		{"blockhashstore", testspecs.GenerateBlockhashStoreSpec(testspecs.BlockhashStoreSpecParams{
+			EVMChainID: 4,
+		}).Toml(), blockhashstore.ValidatedSpec},
+		{"bootstrap", testspecs.OCRBootstrapSpec, ocrbootstrap.ValidatedBootstrapSpecToml},
+		{"ocr", testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{}).Toml(), func(ts string) (job.Job, error) {
+			return ocr.ValidatedOracleSpecToml(chainSet, ts)
+		}},
+		{"ocr2", ocr2Spec, func(ts string) (job.Job, error) {
+			return validate.ValidatedOracleSpecToml(cfg, ts)
+		}},
+		{"webhook", testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{
+			ExternalInitiators: []webhook.TOMLWebhookSpecExternalInitiator{
+				{Name: ei.Name, Spec: cltest.JSONFromString(t, `{"foo": "bar"}`)},
+			},
+		}).Toml(), func(ts string) (job.Job, error) {
+			jb, err := webhook.ValidatedWebhookSpec(ts, eim)
+			if jb.WebhookSpec != nil {
+				// Jobs are exported as loaded from the DB, with their external initiators
+				for i := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+					jb.WebhookSpec.ExternalInitiatorWebhookSpecs[i].ExternalInitiator = ei
+				}
+			}
+			return jb, err
+		}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			jb, err := tc.validate(tc.toml)
+			require.NoError(t, err)
+
+			exported, err := jb.TOML()
+			require.NoError(t, err)
+			imported, err := tc.validate(exported)
+			require.NoError(t, err, exported)
+
+			// A trailing newline may be added to the pipeline
+			assert.Equal(t, strings.TrimSuffix(jb.Pipeline.Source, "\n"), strings.TrimSuffix(imported.Pipeline.Source, "\n"))
+			jb.Pipeline, imported.Pipeline = pipeline.Pipeline{}, pipeline.Pipeline{}
+			assert.Equal(t, jb, imported)
+		})
+	}
+}
+
+func TestJob_TOML_SkipsEnvValues(t *testing.T) {
+	interval := models.Interval(time.Minute)
+	jb := job.Job{
+		Type:          job.OffchainReporting,
+		SchemaVersion: 1,
+		OCROracleSpec: &job.OCROracleSpec{
+			ObservationTimeout:    interval,
+			ObservationTimeoutEnv: true,
+			BlockchainTimeout:     interval,
+			EVMChainID:            utils.NewBigI(4),
+		},
+	}
+
+	exported, err := jb.TOML()
+	require.NoError(t, err)
+	assert.NotContains(t, exported, "observationTimeout")
+	assert.Contains(t, exported, `blockchainTimeout = "1m0s"`)
+	assert.Contains(t, exported, `evmChainID = "4"`)
+}
+
+func TestJob_BundleReferences(t *testing.T) {
+	var publicKey secp256k1.PublicKey
+	require.NoError(t, publicKey.UnmarshalText([]byte("0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F8179800")))
+	from := ethkey.EIP55Address("0xa8037A20989AFcBC51798de9762b351D63ff462e")
+	jb := job.Job{
+		Type: job.VRF,
+		VRFSpec: &job.VRFSpec{
+			PublicKey:     publicKey,
+			EVMChainID:    utils.NewBigI(4),
+			FromAddresses: []ethkey.EIP55Address{from},
+		},
+		Pipeline: pipeline.Pipeline{Source: `
+			fetch  [type=bridge name="fetcher"];
+			submit [type=bridge name="submitter"];
+			again  [type=bridge name="fetcher"];
+			fetch -> submit -> again;
+		`},
+	}
+
+	names, err := jb.BridgeNames()
+	require.NoError(t, err)
+	assert.Equal(t, []bridges.BridgeName{"fetcher", "submitter"}, names)
+
+	assert.Equal(t, []job.KeyReference{
+		{Type: job.KeyTypeVRF, ID: publicKey.String()},
+		{Type: job.KeyTypeEth, ID: from.Hex(), EVMChainID: utils.NewBigI(4)},
+	}, jb.KeyReferences())
+	assert.Equal(t, utils.NewBigI(4), jb.EVMChainID())
+}
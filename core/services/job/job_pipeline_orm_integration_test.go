@@ -126,7 +126,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 		p, err := pipeline.Parse(DotStr)
 		require.NoError(t, err)
 
-		specID, err = orm.CreateSpec(*p, models.Interval(0))
+		specID, err = orm.CreateSpec(*p, models.Interval(0), nil)
 		require.NoError(t, err)
 
 		var pipelineSpecs []pipeline.Spec
@@ -147,7 +147,7 @@ func TestPipelineORM_Integration(t *testing.T) {
 		clearJobsDb(t, db)
 		orm := pipeline.NewORM(db, logger.TestLogger(t), cfg)
 		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{Client: cltest.NewEthClientMockWithDefaultChain(t), DB: db, GeneralConfig: config})
-		runner := pipeline.NewRunner(orm, config, cc, nil, nil, lggr, nil, nil)
+		runner := pipeline.NewRunner(orm, config, cc, nil, nil, nil, nil, nil, nil, lggr, nil, nil, nil)
 		defer runner.Close()
 		jobORM := job.NewTestORM(t, db, cc, orm, keyStore, cfg)
 
@@ -132,6 +132,20 @@ func (_m *Spawner) Start(_a0 context.Context) error {
 	return r0
 }
 
+// StartCreatedJob provides a mock function with given fields: ctx, jb
+func (_m *Spawner) StartCreatedJob(ctx context.Context, jb job.Job) error {
+	ret := _m.Called(ctx, jb)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job) error); ok {
+		r0 = rf(ctx, jb)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartService provides a mock function with given fields: ctx, spec
 func (_m *Spawner) StartService(ctx context.Context, spec job.Job) error {
 	ret := _m.Called(ctx, spec)
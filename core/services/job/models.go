@@ -127,6 +127,7 @@ type Job struct {
 	SchemaVersion        uint32
 	Name                 null.String
 	MaxTaskDuration      models.Interval
+	AllowedSecrets       pq.StringArray    `toml:"allowedSecrets"`
 	Pipeline             pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt            time.Time
 }
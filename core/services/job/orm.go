@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -75,6 +76,7 @@ type orm struct {
 	pipelineORM pipeline.ORM
 	lggr        logger.Logger
 	bridgeORM   bridges.ORM
+	notifier    alerts.Notifier
 }
 
 var _ ORM = (*orm)(nil)
@@ -86,6 +88,7 @@ func NewORM(
 	keyStore keystore.Master, // needed to validation key properties on new job creation
 	lggr logger.Logger,
 	cfg pg.LogConfig,
+	notifier alerts.Notifier, // raises an alert when an error is recorded for a job
 ) *orm {
 	namedLogger := lggr.Named("JobORM")
 	return &orm{
@@ -95,13 +98,14 @@ func NewORM(
 		pipelineORM: pipelineORM,
 		bridgeORM:   bridges.NewORM(db, lggr, cfg),
 		lggr:        namedLogger,
+		notifier:    notifier,
 	}
 }
 func (o *orm) Close() error {
 	return nil
 }
 
-func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
+func (o *orm) assertBridgesExist(p pipeline.Pipeline, qopts ...pg.QOpt) error {
 	var bridgeNames = make(map[bridges.BridgeName]struct{})
 	var uniqueBridges []bridges.BridgeName
 	for _, task := range p.Tasks {
@@ -120,7 +124,7 @@ func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
 		}
 	}
 	if len(uniqueBridges) != 0 {
-		_, err := o.bridgeORM.FindBridges(uniqueBridges)
+		_, err := o.bridgeORM.FindBridges(uniqueBridges, qopts...)
 		if err != nil {
 			return err
 		}
@@ -134,7 +138,7 @@ func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
 func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	p := jb.Pipeline
-	if err := o.assertBridgesExist(p); err != nil {
+	if err := o.assertBridgesExist(p, pg.WithQueryer(q.Queryer)); err != nil {
 		return err
 	}
 
@@ -254,7 +258,7 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				if err != nil {
 					return err
 				}
-				if err2 := o.assertBridgesExist(*feePipeline); err2 != nil {
+				if err2 := o.assertBridgesExist(*feePipeline, pg.WithQueryer(tx)); err2 != nil {
 					return err2
 				}
 			}
@@ -364,7 +368,7 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			o.lggr.Panicf("Unsupported jb.Type: %v", jb.Type)
 		}
 
-		pipelineSpecID, err := o.pipelineORM.CreateSpec(p, jb.MaxTaskDuration, pg.WithQueryer(tx))
+		pipelineSpecID, err := o.pipelineORM.CreateSpec(p, jb.MaxTaskDuration, jb.AllowedSecrets, pg.WithQueryer(tx))
 		if err != nil {
 			return errors.Wrap(err, "failed to create pipeline spec")
 		}
@@ -391,9 +395,9 @@ func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) erro
 
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, allowed_secrets, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, external_job_id, created_at)
-		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
+		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :allowed_secrets, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :external_job_id, NOW())
 		RETURNING *;`
 	return q.GetNamed(query, job, job)
@@ -485,6 +489,15 @@ func (o *orm) RecordError(jobID int32, description string, qopts ...pg.QOpt) err
 			return nil
 		}
 	}
+	if err == nil {
+		o.notifier.Notify(alerts.Alert{
+			Type:     alerts.TypeJobSpecError,
+			Severity: alerts.SeverityWarning,
+			Key:      fmt.Sprintf("%d/%s", jobID, description),
+			Summary:  fmt.Sprintf("Job %d errored: %s", jobID, description),
+			Details:  map[string]string{"jobID": fmt.Sprint(jobID), "description": description},
+		})
+	}
 	return err
 }
 func (o *orm) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
@@ -703,7 +716,7 @@ func (o *orm) FindJobByExternalJobID(externalJobID uuid.UUID, qopts ...pg.QOpt)
 	return
 }
 
-// FindJobIDByAddress - finds a job id by contract address. Currently only OCR and FM jobs are supported
+// FindJobIDByAddress - finds a job id by contract address. Currently only OCR, OCR2 (EVM relay) and FM jobs are supported
 func (o *orm) FindJobIDByAddress(address ethkey.EIP55Address, qopts ...pg.QOpt) (jobID int32, err error) {
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
@@ -711,10 +724,11 @@ func (o *orm) FindJobIDByAddress(address ethkey.EIP55Address, qopts ...pg.QOpt)
 SELECT jobs.id
 FROM jobs
 LEFT JOIN ocr_oracle_specs ocrspec on ocrspec.contract_address = $1 AND ocrspec.id = jobs.ocr_oracle_spec_id
+LEFT JOIN ocr2_oracle_specs ocr2spec on ocr2spec.relay = 'evm' AND lower(ocr2spec.contract_id) = lower($2) AND ocr2spec.id = jobs.ocr2_oracle_spec_id
 LEFT JOIN flux_monitor_specs fmspec on fmspec.contract_address = $1 AND fmspec.id = jobs.flux_monitor_spec_id
-WHERE ocrspec.id IS NOT NULL OR fmspec.id IS NOT NULL
+WHERE ocrspec.id IS NOT NULL OR ocr2spec.id IS NOT NULL OR fmspec.id IS NOT NULL
 `
-		err = tx.Get(&jobID, stmt, address)
+		err = tx.Get(&jobID, stmt, address, address.String())
 
 		if !errors.Is(err, sql.ErrNoRows) {
 			if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -16,7 +17,7 @@ import (
 )
 
 func NewTestORM(t *testing.T, db *sqlx.DB, chainSet evm.ChainSet, pipelineORM pipeline.ORM, keyStore keystore.Master, cfg pg.LogConfig) ORM {
-	o := NewORM(db, chainSet, pipelineORM, keyStore, logger.TestLogger(t), cfg)
+	o := NewORM(db, chainSet, pipelineORM, keyStore, logger.TestLogger(t), cfg, alerts.NullNotifier{})
 	t.Cleanup(func() { o.Close() })
 	return o
 }
@@ -26,6 +26,9 @@ type (
 	Spawner interface {
 		services.ServiceCtx
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
+		// StartCreatedJob starts a job that was saved with ORM.CreateJob as
+		// part of a larger transaction, once that transaction has committed.
+		StartCreatedJob(ctx context.Context, jb Job) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
 		ActiveJobs() map[int32]Job
 
@@ -220,14 +223,28 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		return err
 	}
 
-	if err = js.StartService(q.ParentCtx, *jb); err != nil {
+	return js.startCreatedJob(q.ParentCtx, delegate, *jb)
+}
+
+func (js *spawner) StartCreatedJob(ctx context.Context, jb Job) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	ctx, cancel := utils.WithCloseChan(ctx, js.chStop)
+	defer cancel()
+	return js.startCreatedJob(ctx, delegate, jb)
+}
+
+func (js *spawner) startCreatedJob(ctx context.Context, delegate Delegate, jb Job) error {
+	if err := js.StartService(ctx, jb); err != nil {
 		return err
 	}
 
-	delegate.AfterJobCreated(*jb)
+	delegate.AfterJobCreated(jb)
 
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
-	return err
+	return nil
 }
 
 // Should not get called before Start()
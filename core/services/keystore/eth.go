@@ -6,15 +6,27 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// ErrSigningDisabled is returned when asked to sign a message with a key
+// whose signing was not enabled with EnableSigning.
+var ErrSigningDisabled = errors.New("signing is not enabled for this key")
+
+// ErrSignTransaction is returned when asked to sign a raw message which is an
+// encoded transaction, since only the transaction manager may sign those.
+var ErrSignTransaction = errors.New("refusing to sign an encoded transaction")
+
 //go:generate mockery --name Eth --output mocks/ --case=underscore
 
 // Eth is the external interface for EthKeyStore
@@ -31,11 +43,19 @@ type Eth interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error)
+	SignTypedData(address common.Address, chainID *big.Int, typedData apitypes.TypedData) ([]byte, error)
+	SignRawMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error)
 
 	SendingKeys(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	FundingKeys() (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
 
+	Enable(address common.Address, chainID *big.Int) error
+	Disable(address common.Address, chainID *big.Int) error
+	EnableSigning(address common.Address, chainID *big.Int) error
+	DisableSigning(address common.Address, chainID *big.Int) error
+
 	GetState(id string) (ethkey.State, error)
 	SetState(ethkey.State) error
 	GetStatesForKeys([]ethkey.KeyV2) ([]ethkey.State, error)
@@ -258,7 +278,39 @@ func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *bi
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
-// SendingKeys returns all sending keys for the given chain
+// SignMessage signs the EIP-191 personal message hash of message with the
+// sending key of address on chainID. The recovery id of the signature is 27
+// or 28.
+func (ks *eth) SignMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	return ks.signHash(address, chainID, accounts.TextHash(message))
+}
+
+// SignTypedData signs the EIP-712 hash of typedData with the sending key of
+// address on chainID. If the domain of typedData has a chain ID, it must be
+// chainID. The recovery id of the signature is 27 or 28.
+func (ks *eth) SignTypedData(address common.Address, chainID *big.Int, typedData apitypes.TypedData) ([]byte, error) {
+	if typedData.Domain.ChainId != nil && (*big.Int)(typedData.Domain.ChainId).Cmp(chainID) != 0 {
+		return nil, errors.Errorf("typed data is for chain %s, not %s", (*big.Int)(typedData.Domain.ChainId), chainID)
+	}
+	hash, err := typedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return ks.signHash(address, chainID, hash)
+}
+
+// SignRawMessage signs the keccak256 hash of message with the sending key of
+// address on chainID. Since that is how transactions are signed, message must
+// not be an encoded transaction, or ErrSignTransaction is returned. The
+// recovery id of the signature is 27 or 28.
+func (ks *eth) SignRawMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	if isEncodedTransaction(message) {
+		return nil, ErrSignTransaction
+	}
+	return ks.signHash(address, chainID, crypto.Keccak256(message))
+}
+
+// SendingKeys returns all sending keys for the given chain, including disabled ones
 // If chainID is nil, returns all sending keys for all chains
 func (ks *eth) SendingKeys(chainID *big.Int) (sendingKeys []ethkey.KeyV2, err error) {
 	ks.lock.RLock()
@@ -287,9 +339,9 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 
 	var keys []ethkey.KeyV2
 	if len(whitelist) == 0 {
-		keys = ks.sendingKeys(chainID)
+		keys = ks.enabledSendingKeys(chainID)
 	} else if len(whitelist) > 0 {
-		for _, k := range ks.sendingKeys(chainID) {
+		for _, k := range ks.enabledSendingKeys(chainID) {
 			for _, addr := range whitelist {
 				if addr == k.Address.Address() {
 					keys = append(keys, k)
@@ -321,6 +373,86 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 	return leastRecentlyUsed.Address.Address(), nil
 }
 
+// Enable makes a disabled sending key of chainID available again for new
+// transactions.
+func (ks *eth) Enable(address common.Address, chainID *big.Int) error {
+	return ks.setDisabled(address, chainID, false)
+}
+
+// Disable excludes a sending key of chainID from GetRoundRobinAddress, and
+// thus from new transactions. Transactions which were already sent from the
+// key are still confirmed.
+func (ks *eth) Disable(address common.Address, chainID *big.Int) error {
+	return ks.setDisabled(address, chainID, true)
+}
+
+func (ks *eth) setDisabled(address common.Address, chainID *big.Int, disabled bool) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	state, err := ks.chainState(address, chainID)
+	if err != nil {
+		return err
+	}
+	if state.Disabled == disabled {
+		return nil
+	}
+	state.Disabled = disabled
+	if err = ks.setState(state); err != nil {
+		return err
+	}
+	ks.notify()
+	return nil
+}
+
+// EnableSigning allows the sending key of address on chainID to sign messages
+// with SignMessage, SignTypedData and SignRawMessage. Signing is disabled for
+// new keys, so that e.g. the transmitter keys of jobs cannot sign data by
+// default.
+func (ks *eth) EnableSigning(address common.Address, chainID *big.Int) error {
+	return ks.setSigningEnabled(address, chainID, true)
+}
+
+// DisableSigning forbids the sending key of address on chainID to sign
+// messages.
+func (ks *eth) DisableSigning(address common.Address, chainID *big.Int) error {
+	return ks.setSigningEnabled(address, chainID, false)
+}
+
+func (ks *eth) setSigningEnabled(address common.Address, chainID *big.Int, enabled bool) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	state, err := ks.chainState(address, chainID)
+	if err != nil {
+		return err
+	}
+	if state.SigningEnabled == enabled {
+		return nil
+	}
+	state.SigningEnabled = enabled
+	return ks.setState(state)
+}
+
+// chainState returns a copy of the state of the key of address, which must be
+// a key for chainID.
+//
+// caller must hold lock!
+func (ks *eth) chainState(address common.Address, chainID *big.Int) (ethkey.State, error) {
+	state, exists := ks.keyStates.Eth[address.Hex()]
+	if !exists {
+		return ethkey.State{}, KeyNotFoundError{ID: address.Hex(), KeyType: "Eth"}
+	}
+	if state.EVMChainID.Cmp(utils.NewBig(chainID)) != 0 {
+		return ethkey.State{}, errors.Errorf("eth key %s is not a key for chain %s", address.Hex(), chainID)
+	}
+	return *state, nil
+}
+
 func (ks *eth) GetState(id string) (ethkey.State, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -346,12 +478,26 @@ func (ks *eth) SetState(state ethkey.State) error {
 		return errors.Errorf("key not found with ID %s", state.KeyID())
 	}
 	ks.keyStates.Eth[state.KeyID()] = &state
-	sql := `UPDATE eth_key_states SET address = :address, next_nonce = :next_nonce, is_funding = :is_funding, evm_chain_id = :evm_chain_id, updated_at = NOW()
+	sql := `UPDATE eth_key_states SET address = :address, next_nonce = :next_nonce, is_funding = :is_funding, evm_chain_id = :evm_chain_id, disabled = :disabled, signing_enabled = :signing_enabled, updated_at = NOW()
 	WHERE address = :address;`
 	_, err := ks.orm.q.NamedExec(sql, state)
 	return errors.Wrap(err, "SetState#Exec failed")
 }
 
+// setState saves the flags of state. Unlike SetState, it leaves the next nonce
+// to the transaction manager, and reloads it from the DB.
+//
+// caller must hold lock!
+func (ks *eth) setState(state ethkey.State) error {
+	sql := `UPDATE eth_key_states SET is_funding = :is_funding, disabled = :disabled, signing_enabled = :signing_enabled, updated_at = NOW()
+	WHERE address = :address AND evm_chain_id = :evm_chain_id RETURNING *;`
+	if err := ks.orm.q.GetNamed(sql, &state, state); err != nil {
+		return errors.Wrap(err, "failed to update eth_key_state")
+	}
+	ks.keyStates.Eth[state.KeyID()] = &state
+	return nil
+}
+
 func (ks *eth) GetStatesForKeys(keys []ethkey.KeyV2) (states []ethkey.State, err error) {
 	for _, k := range keys {
 		state, err := ks.GetState(k.ID())
@@ -425,6 +571,56 @@ func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	return key, nil
 }
 
+func (ks *eth) signHash(address common.Address, chainID *big.Int, hash []byte) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.Hex())
+	if err != nil {
+		return nil, err
+	}
+	state := ks.keyStates.Eth[key.ID()]
+	if state.IsFunding || ((*big.Int)(&state.EVMChainID)).Cmp(chainID) != 0 {
+		return nil, errors.Errorf("%s is not a sending key for chain %s", address.Hex(), chainID)
+	}
+	if !state.SigningEnabled {
+		return nil, errors.Wrap(ErrSigningDisabled, address.Hex())
+	}
+	sig, err := crypto.Sign(hash, key.ToEcdsaPrivKey())
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// typedDataHash returns the EIP-712 hash of typedData:
+// keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message))
+func typedDataHash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data domain")
+	}
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data message")
+	}
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash), nil
+}
+
+// isEncodedTransaction returns true if data is an RLP list, optionally
+// preceded by an EIP-2718 transaction type, as signed transactions and the
+// payloads hashed to sign them are.
+func isEncodedTransaction(data []byte) bool {
+	if len(data) > 0 && data[0] <= 0x7f {
+		data = data[1:]
+	}
+	kind, _, rest, err := rlp.Split(data)
+	return err == nil && kind == rlp.List && len(rest) == 0
+}
+
 // caller must hold lock!
 func (ks *eth) fundingKeys() (fundingKeys []ethkey.KeyV2) {
 	for _, k := range ks.keyRing.Eth {
@@ -449,6 +645,16 @@ func (ks *eth) sendingKeys(chainID *big.Int) (sendingKeys []ethkey.KeyV2) {
 	return sendingKeys
 }
 
+// caller must hold lock!
+func (ks *eth) enabledSendingKeys(chainID *big.Int) (sendingKeys []ethkey.KeyV2) {
+	for _, k := range ks.sendingKeys(chainID) {
+		if !ks.keyStates.Eth[k.ID()].Disabled {
+			sendingKeys = append(sendingKeys, k)
+		}
+	}
+	return sendingKeys
+}
+
 // caller must hold lock!
 func (ks *eth) add(key ethkey.KeyV2, chainID *big.Int) error {
 	return ks.addEthKeyWithState(key, ethkey.State{EVMChainID: *utils.NewBig(chainID)})
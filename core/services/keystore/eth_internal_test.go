@@ -0,0 +1,85 @@
+package keystore
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The example of the EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": "1",
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func Test_typedDataHash(t *testing.T) {
+	t.Parallel()
+
+	var typedData apitypes.TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &typedData))
+
+	hash, err := typedDataHash(typedData)
+	require.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hexutil.Encode(hash))
+
+	typedData.PrimaryType = "Letter"
+	_, err = typedDataHash(typedData)
+	require.Error(t, err)
+}
+
+func Test_isEncodedTransaction(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+	legacy := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(2), 21000, big.NewInt(3), []byte{4})
+	dynamic := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000})
+	legacyBytes, err := legacy.MarshalBinary()
+	require.NoError(t, err)
+	dynamicBytes, err := dynamic.MarshalBinary()
+	require.NoError(t, err)
+	// The payload hashed to sign a legacy transaction with EIP-155
+	legacyPayload, err := rlp.EncodeToBytes([]interface{}{legacy.Nonce(), legacy.GasPrice(), legacy.Gas(), legacy.To(), legacy.Value(), legacy.Data(), chainID, uint(0), uint(0)})
+	require.NoError(t, err)
+
+	for _, data := range [][]byte{legacyBytes, dynamicBytes, legacyPayload} {
+		assert.True(t, isEncodedTransaction(data), hexutil.Encode(data))
+	}
+	for _, data := range [][]byte{nil, []byte("hello"), common.HexToHash("0x1234").Bytes(), append(legacyBytes, 0)} {
+		assert.False(t, isEncodedTransaction(data), hexutil.Encode(data))
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
@@ -247,6 +251,64 @@ func Test_EthKeyStore_GetRoundRobinAddress(t *testing.T) {
 	})
 }
 
+func Test_EthKeyStore_EnableDisable(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethKeyStore := keyStore.Eth()
+	chainID := &cltest.FixtureChainID
+
+	k1, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	k2, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	t.Run("disabled keys are not picked by round robin", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.Disable(k1.Address.Address(), chainID))
+
+		for i := 0; i < 3; i++ {
+			address, err := ethKeyStore.GetRoundRobinAddress(chainID)
+			require.NoError(t, err)
+			require.Equal(t, k2.Address.Address(), address)
+		}
+
+		_, err := ethKeyStore.GetRoundRobinAddress(chainID, k1.Address.Address())
+		require.Error(t, err)
+
+		// Disabled keys are still sending keys
+		sendingKeys, err := ethKeyStore.SendingKeys(chainID)
+		require.NoError(t, err)
+		require.Len(t, sendingKeys, 2)
+
+		state, err := ethKeyStore.GetState(k1.ID())
+		require.NoError(t, err)
+		require.True(t, state.Disabled)
+
+		var disabled bool
+		require.NoError(t, db.Get(&disabled, `SELECT disabled FROM eth_key_states WHERE address = $1`, k1.Address))
+		require.True(t, disabled)
+	})
+
+	t.Run("enabled keys are picked again", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.Enable(k1.Address.Address(), chainID))
+
+		address, err := ethKeyStore.GetRoundRobinAddress(chainID, k1.Address.Address())
+		require.NoError(t, err)
+		require.Equal(t, k1.Address.Address(), address)
+
+		state, err := ethKeyStore.GetState(k1.ID())
+		require.NoError(t, err)
+		require.False(t, state.Disabled)
+	})
+
+	t.Run("errors for another chain or an unknown key", func(t *testing.T) {
+		require.Error(t, ethKeyStore.Disable(k1.Address.Address(), testutils.SimulatedChainID))
+		err := ethKeyStore.Disable(testutils.NewAddress(), chainID)
+		require.ErrorAs(t, err, &keystore.KeyNotFoundError{})
+	})
+}
+
 func Test_EthKeyStore_SignTx(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	config := configtest.NewTestGeneralConfig(t)
@@ -268,6 +330,101 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignMessage(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	config := configtest.NewTestGeneralConfig(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	ethKeyStore := keyStore.Eth()
+
+	_, address := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	chainID := &cltest.FixtureChainID
+	message := []byte("hello")
+
+	recoverAddress := func(t *testing.T, hash, sig []byte) common.Address {
+		require.Len(t, sig, 65)
+		require.Contains(t, []byte{27, 28}, sig[64])
+		sig = append([]byte{}, sig...)
+		sig[64] -= 27
+		pubKey, err := crypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		return crypto.PubkeyToAddress(*pubKey)
+	}
+
+	t.Run("signing is disabled by default", func(t *testing.T) {
+		_, err := ethKeyStore.SignMessage(address, chainID, message)
+		require.ErrorIs(t, err, keystore.ErrSigningDisabled)
+		_, err = ethKeyStore.SignRawMessage(address, chainID, message)
+		require.ErrorIs(t, err, keystore.ErrSigningDisabled)
+	})
+
+	require.NoError(t, ethKeyStore.EnableSigning(address, chainID))
+	state, err := ethKeyStore.GetState(address.Hex())
+	require.NoError(t, err)
+	require.True(t, state.SigningEnabled)
+
+	t.Run("SignMessage", func(t *testing.T) {
+		sig, err := ethKeyStore.SignMessage(address, chainID, message)
+		require.NoError(t, err)
+		assert.Equal(t, address, recoverAddress(t, accounts.TextHash(message), sig))
+	})
+
+	t.Run("SignRawMessage", func(t *testing.T) {
+		sig, err := ethKeyStore.SignRawMessage(address, chainID, message)
+		require.NoError(t, err)
+		assert.Equal(t, address, recoverAddress(t, crypto.Keccak256(message), sig))
+
+		tx := types.NewTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil)
+		encoded, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		_, err = ethKeyStore.SignRawMessage(address, chainID, encoded)
+		require.ErrorIs(t, err, keystore.ErrSignTransaction)
+	})
+
+	t.Run("SignTypedData", func(t *testing.T) {
+		typedData := apitypes.TypedData{
+			Types: apitypes.Types{
+				"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+				"Answer":       {{Name: "value", Type: "uint256"}},
+			},
+			PrimaryType: "Answer",
+			Domain:      apitypes.TypedDataDomain{Name: "Test", ChainId: (*math.HexOrDecimal256)(chainID)},
+			Message:     apitypes.TypedDataMessage{"value": "42"},
+		}
+		sig, err := ethKeyStore.SignTypedData(address, chainID, typedData)
+		require.NoError(t, err)
+		domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+		require.NoError(t, err)
+		messageHash, err := typedData.HashStruct("Answer", typedData.Message)
+		require.NoError(t, err)
+		hash := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash)
+		assert.Equal(t, address, recoverAddress(t, hash, sig))
+
+		typedData.Domain.ChainId = math.NewHexOrDecimal256(1337)
+		_, err = ethKeyStore.SignTypedData(address, chainID, typedData)
+		require.EqualError(t, err, fmt.Sprintf("typed data is for chain 1337, not %s", chainID))
+	})
+
+	t.Run("only signs with the sending keys of the chain", func(t *testing.T) {
+		otherChainID := big.NewInt(1337)
+		_, err := ethKeyStore.SignMessage(address, otherChainID, message)
+		require.EqualError(t, err, fmt.Sprintf("%s is not a sending key for chain 1337", address.Hex()))
+
+		randomAddress := testutils.NewAddress()
+		_, err = ethKeyStore.SignMessage(randomAddress, chainID, message)
+		require.EqualError(t, err, fmt.Sprintf("unable to find eth key with id %s", randomAddress.Hex()))
+	})
+
+	t.Run("DisableSigning", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.DisableSigning(address, chainID))
+		_, err := ethKeyStore.SignMessage(address, chainID, message)
+		require.ErrorIs(t, err, keystore.ErrSigningDisabled)
+
+		require.Error(t, ethKeyStore.EnableSigning(address, big.NewInt(1337)))
+		err = ethKeyStore.EnableSigning(testutils.NewAddress(), chainID)
+		require.ErrorAs(t, err, &keystore.KeyNotFoundError{})
+	})
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := configtest.NewTestGeneralConfig(t)
@@ -7,14 +7,16 @@ import (
 )
 
 type State struct {
-	ID         int32
-	Address    EIP55Address
-	NextNonce  int64
-	IsFunding  bool
-	EVMChainID utils.Big
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	lastUsed   time.Time
+	ID             int32
+	Address        EIP55Address
+	NextNonce      int64
+	IsFunding      bool
+	Disabled       bool
+	SigningEnabled bool
+	EVMChainID     utils.Big
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	lastUsed       time.Time
 }
 
 func (s State) KeyID() string {
@@ -11,6 +11,8 @@ import (
 	cryptop2p "github.com/libp2p/go-libp2p-core/crypto"
 	peer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // Key represents a libp2p private key
@@ -102,6 +104,25 @@ func (ep2pk *EncryptedP2PKey) SetID(value string) error {
 	return nil
 }
 
+// Encrypt returns the private key of k encrypted via auth, in the format of the
+// EncryptedPrivKey of an EncryptedP2PKey.
+func (k Key) Encrypt(auth string, scryptParams utils.ScryptParams) ([]byte, error) {
+	marshalledPrivK, err := cryptop2p.MarshalPrivateKey(k.PrivKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshal P2P private key for %s", k.PeerID())
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(
+		marshalledPrivK,
+		[]byte(adulteratedPassword(auth)),
+		scryptParams.N,
+		scryptParams.P,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encrypt P2P key %s", k.PeerID())
+	}
+	return json.Marshal(&cryptoJSON)
+}
+
 // Decrypt returns the PrivateKey in e, decrypted via auth, or an error
 func (ep2pk EncryptedP2PKey) Decrypt(auth string) (k Key, err error) {
 	var cryptoJSON keystore.CryptoJSON
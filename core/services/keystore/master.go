@@ -25,6 +25,15 @@ import (
 
 var ErrLocked = errors.New("Keystore is locked")
 
+var (
+	// ErrWrongPassword is returned when rotating the password of the keystore
+	// with an old password which is not the one it was unlocked with.
+	ErrWrongPassword = errors.New("old password does not match the keystore password")
+	// ErrWeakPassword is returned when rotating the password of the keystore
+	// to a password which is not complex enough.
+	ErrWeakPassword = errors.New("new password is not strong enough")
+)
+
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
 // necessary because it is lazily evaluated
 type DefaultEVMChainIDFunc func() (defaultEVMChainID *big.Int, err error)
@@ -40,21 +49,25 @@ type Master interface {
 	Solana() Solana
 	Terra() Terra
 	VRF() VRF
+	Secrets() Secrets
 	Unlock(password string) error
+	Reload() error
+	RotatePassword(oldPassword, newPassword string, scryptParams utils.ScryptParams) error
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
 }
 
 type master struct {
 	*keyManager
-	csa    *csa
-	eth    *eth
-	ocr    *ocr
-	ocr2   ocr2
-	p2p    *p2p
-	solana *solana
-	terra  *terra
-	vrf    *vrf
+	csa     *csa
+	eth     *eth
+	ocr     *ocr
+	ocr2    ocr2
+	p2p     *p2p
+	solana  *solana
+	terra   *terra
+	vrf     *vrf
+	secrets *secrets
 }
 
 func New(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.LogConfig) Master {
@@ -79,6 +92,7 @@ func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger,
 		solana:     newSolanaKeyStore(km),
 		terra:      newTerraKeyStore(km),
 		vrf:        newVRFKeyStore(km),
+		secrets:    newSecretsKeyStore(km),
 	}
 }
 
@@ -114,6 +128,10 @@ func (ks *master) VRF() VRF {
 	return ks.vrf
 }
 
+func (ks *master) Secrets() Secrets {
+	return ks.secrets
+}
+
 func (ks *master) IsEmpty() (bool, error) {
 	var count int64
 	err := ks.orm.q.QueryRow("SELECT count(*) FROM encrypted_key_rings").Scan(&count)
@@ -215,6 +233,28 @@ func (km *keyManager) Unlock(password string) error {
 		}
 		return nil
 	}
+	if err := km.load(password); err != nil {
+		return err
+	}
+	km.password = password
+	return nil
+}
+
+// Reload reads the key ring and the key states from the DB again, and
+// decrypts the key ring with the password the keystore was unlocked with. A
+// standby node reloads its keystore when it is promoted, since the leader may
+// have changed the keys in the meantime.
+func (km *keyManager) Reload() error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	return km.load(km.password)
+}
+
+// caller must hold lock!
+func (km *keyManager) load(password string) error {
 	ekr, err := km.orm.getEncryptedKeyRing()
 	if err != nil {
 		return errors.Wrap(err, "unable to get encrypted key ring")
@@ -224,7 +264,6 @@ func (km *keyManager) Unlock(password string) error {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
 	kr.logPubKeys(km.logger)
-	km.keyRing = kr
 
 	ks, err := km.orm.loadKeyStates()
 	if err != nil {
@@ -234,9 +273,63 @@ func (km *keyManager) Unlock(password string) error {
 	if err = ks.validate(kr); err != nil {
 		return err
 	}
+	km.keyRing = kr
 	km.keyStates = ks
+	return nil
+}
 
-	km.password = password
+// RotatePassword re-encrypts the key ring, and the legacy V1 keys which are
+// encrypted with the keystore password, under newPassword with scryptParams,
+// which are then used for later saves. The re-encrypted keys are decrypted
+// again and compared with the current ones before they are saved, in a single
+// transaction.
+//
+// Legacy V1 VRF keys have their own password, so they are left untouched.
+//
+// Only this keystore is switched to newPassword. Another node unlocked with
+// the old password on the same database, e.g. a standby, keeps encrypting the
+// key ring with it when it saves keys, which would overwrite the rotated key
+// ring. Such nodes must be stopped before rotating, and restarted with the new
+// password.
+func (km *keyManager) RotatePassword(oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if oldPassword != km.password {
+		return ErrWrongPassword
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return fmt.Errorf("%w: %v", ErrWeakPassword, err)
+	}
+	if newPassword == oldPassword {
+		return fmt.Errorf("%w: it must differ from the old password", ErrWeakPassword)
+	}
+
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	kr, err := ekr.Decrypt(newPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt re-encrypted keyRing")
+	}
+	if err = km.keyRing.verifySameKeys(kr); err != nil {
+		return errors.Wrap(err, "re-encrypted keyRing differs")
+	}
+
+	legacyKeys, err := km.orm.reencryptV1Keys(oldPassword, newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to re-encrypt legacy keys")
+	}
+	if err = km.orm.saveEncryptedKeyRing(&ekr, legacyKeys.save); err != nil {
+		return err
+	}
+
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Info("Rotated keystore password")
 	return nil
 }
 
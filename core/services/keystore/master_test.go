@@ -2,12 +2,17 @@ package keystore_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,3 +65,109 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	const newPassword = "Rotated-K3yst0re-P4SS!"
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ethKey, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+	csaKey, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+
+	// legacy V1 keys, encrypted with the keystore password
+	v1EthKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	v1EthJSON, err := v1EthKey.ToEncryptedJSON(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO keys (address, json, created_at, updated_at, next_nonce, is_funding) VALUES ($1, $2, NOW(), NOW(), 0, false)`, v1EthKey.Address, v1EthJSON)
+	require.NoError(t, err)
+	v1CSAKey, err := csakey.New(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO csa_keys (public_key, encrypted_private_key, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())`, v1CSAKey.PublicKey, v1CSAKey.EncryptedPrivateKey)
+	require.NoError(t, err)
+
+	t.Run("requires the current password", func(t *testing.T) {
+		err := keyStore.RotatePassword("wrong password", newPassword, utils.FastScryptParams)
+		require.ErrorIs(t, err, keystore.ErrWrongPassword)
+	})
+
+	t.Run("requires a strong new password", func(t *testing.T) {
+		err := keyStore.RotatePassword(cltest.Password, "password", utils.FastScryptParams)
+		require.ErrorIs(t, err, keystore.ErrWeakPassword)
+		err = keyStore.RotatePassword(cltest.Password, cltest.Password, utils.FastScryptParams)
+		require.ErrorIs(t, err, keystore.ErrWeakPassword)
+	})
+
+	t.Run("re-encrypts the key ring and the legacy keys", func(t *testing.T) {
+		require.NoError(t, keyStore.RotatePassword(cltest.Password, newPassword, utils.FastScryptParams))
+
+		keyStore.ResetXXXTestOnly()
+		require.Error(t, keyStore.Unlock(cltest.Password))
+		require.NoError(t, keyStore.Unlock(newPassword))
+
+		_, err := keyStore.Eth().Get(ethKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+
+		v1EthKeys, _, err := keyStore.Eth().GetV1KeysAsV2(func() (*big.Int, error) { return &cltest.FixtureChainID, nil })
+		require.NoError(t, err)
+		require.Len(t, v1EthKeys, 1)
+		assert.Equal(t, v1EthKey.Address, v1EthKeys[0].Address)
+
+		v1CSAKeys, err := keyStore.CSA().GetV1KeysAsV2()
+		require.NoError(t, err)
+		require.Len(t, v1CSAKeys, 1)
+		assert.Equal(t, v1CSAKey.ToV2().ID(), v1CSAKeys[0].ID())
+	})
+}
+
+func TestMasterKeystore_Reload(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	// leader and standby share the DB
+	leader := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, leader.Unlock(cltest.Password))
+	standby := keystore.ExposedNewMaster(t, db, cfg)
+
+	t.Run("requires the keystore to be unlocked", func(t *testing.T) {
+		require.ErrorIs(t, standby.Reload(), keystore.ErrLocked)
+	})
+
+	require.NoError(t, standby.Unlock(cltest.Password))
+
+	t.Run("loads the keys added by another keystore", func(t *testing.T) {
+		ethKey, _ := cltest.MustAddRandomKeyToKeystore(t, leader.Eth())
+		csaKey, err := leader.CSA().Create()
+		require.NoError(t, err)
+
+		_, err = standby.Eth().Get(ethKey.ID())
+		require.Error(t, err)
+
+		require.NoError(t, standby.Reload())
+		_, err = standby.Eth().Get(ethKey.ID())
+		require.NoError(t, err)
+		_, err = standby.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		states, err := standby.Eth().GetStatesForChain(&cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, states, 1)
+		assert.Equal(t, ethKey.Address, states[0].Address)
+	})
+
+	t.Run("fails if the key ring does not decrypt with its password", func(t *testing.T) {
+		require.NoError(t, leader.RotatePassword(cltest.Password, "Rotated-K3yst0re-P4SS!", utils.FastScryptParams))
+
+		err := standby.Reload()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to decrypt encrypted key ring")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	testing "testing"
 
 	types "github.com/ethereum/go-ethereum/core/types"
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 // Eth is an autogenerated mock type for the Eth type
@@ -78,6 +79,62 @@ func (_m *Eth) Delete(id string) (ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// Disable provides a mock function with given fields: address, chainID
+func (_m *Eth) Disable(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableSigning provides a mock function with given fields: address, chainID
+func (_m *Eth) DisableSigning(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Enable provides a mock function with given fields: address, chainID
+func (_m *Eth) Enable(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnableSigning provides a mock function with given fields: address, chainID
+func (_m *Eth) EnableSigning(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EnsureKeys provides a mock function with given fields: chainID
 func (_m *Eth) EnsureKeys(chainID *big.Int) error {
 	ret := _m.Called(chainID)
@@ -369,6 +426,52 @@ func (_m *Eth) SetState(_a0 ethkey.State) error {
 	return r0
 }
 
+// SignMessage provides a mock function with given fields: address, chainID, message
+func (_m *Eth) SignMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	ret := _m.Called(address, chainID, message)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, []byte) []byte); ok {
+		r0 = rf(address, chainID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, []byte) error); ok {
+		r1 = rf(address, chainID, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignRawMessage provides a mock function with given fields: address, chainID, message
+func (_m *Eth) SignRawMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	ret := _m.Called(address, chainID, message)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, []byte) []byte); ok {
+		r0 = rf(address, chainID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, []byte) error); ok {
+		r1 = rf(address, chainID, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: fromAddress, tx, chainID
 func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(fromAddress, tx, chainID)
@@ -392,6 +495,29 @@ func (_m *Eth) SignTx(fromAddress common.Address, tx *types.Transaction, chainID
 	return r0, r1
 }
 
+// SignTypedData provides a mock function with given fields: address, chainID, typedData
+func (_m *Eth) SignTypedData(address common.Address, chainID *big.Int, typedData apitypes.TypedData) ([]byte, error) {
+	ret := _m.Called(address, chainID, typedData)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, apitypes.TypedData) []byte); ok {
+		r0 = rf(address, chainID, typedData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, apitypes.TypedData) error); ok {
+		r1 = rf(address, chainID, typedData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeToKeyChanges provides a mock function with given fields:
 func (_m *Eth) SubscribeToKeyChanges() (chan struct{}, func()) {
 	ret := _m.Called()
@@ -6,6 +6,7 @@ import (
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
 
+	utils "github.com/smartcontractkit/chainlink/core/utils"
 	testing "testing"
 )
 
@@ -129,6 +130,50 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// Reload provides a mock function with given fields:
+func (_m *Master) Reload() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotatePassword provides a mock function with given fields: oldPassword, newPassword, scryptParams
+func (_m *Master) RotatePassword(oldPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(oldPassword, newPassword, scryptParams)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, utils.ScryptParams) error); ok {
+		r0 = rf(oldPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Secrets provides a mock function with given fields:
+func (_m *Master) Secrets() keystore.Secrets {
+	ret := _m.Called()
+
+	var r0 keystore.Secrets
+	if rf, ok := ret.Get(0).(func() keystore.Secrets); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Secrets)
+		}
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
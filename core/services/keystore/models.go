@@ -1,8 +1,11 @@
 package keystore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocr2key"
@@ -81,18 +84,22 @@ type keyRing struct {
 	Solana map[string]solkey.Key
 	Terra  map[string]terrakey.Key
 	VRF    map[string]vrfkey.KeyV2
+	// Secrets are not keys, but are stored in the key ring so that they are
+	// encrypted with the same password
+	Secrets map[string]string
 }
 
 func newKeyRing() keyRing {
 	return keyRing{
-		CSA:    make(map[string]csakey.KeyV2),
-		Eth:    make(map[string]ethkey.KeyV2),
-		OCR:    make(map[string]ocrkey.KeyV2),
-		OCR2:   make(map[string]ocr2key.KeyBundle),
-		P2P:    make(map[string]p2pkey.KeyV2),
-		Solana: make(map[string]solkey.Key),
-		Terra:  make(map[string]terrakey.Key),
-		VRF:    make(map[string]vrfkey.KeyV2),
+		CSA:     make(map[string]csakey.KeyV2),
+		Eth:     make(map[string]ethkey.KeyV2),
+		OCR:     make(map[string]ocrkey.KeyV2),
+		OCR2:    make(map[string]ocr2key.KeyBundle),
+		P2P:     make(map[string]p2pkey.KeyV2),
+		Solana:  make(map[string]solkey.Key),
+		Terra:   make(map[string]terrakey.Key),
+		VRF:     make(map[string]vrfkey.KeyV2),
+		Secrets: make(map[string]string),
 	}
 }
 
@@ -144,9 +151,31 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, vrfKey := range kr.VRF {
 		rawKeys.VRF = append(rawKeys.VRF, vrfKey.Raw())
 	}
+	if len(kr.Secrets) > 0 {
+		rawKeys.Secrets = make(map[string]string, len(kr.Secrets))
+		for name, value := range kr.Secrets {
+			rawKeys.Secrets[name] = value
+		}
+	}
 	return rawKeys
 }
 
+// verifySameKeys returns an error if other does not hold the same keys as kr.
+func (kr *keyRing) verifySameKeys(other keyRing) error {
+	a, err := kr.raw().canonicalJSON()
+	if err != nil {
+		return err
+	}
+	b, err := other.raw().canonicalJSON()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(a, b) {
+		return errors.New("key rings hold different keys")
+	}
+	return nil
+}
+
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	lggr = lggr.Named("KeyRing")
 	var csaIDs []string
@@ -205,6 +234,9 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	if len(vrfIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d VRF keys", len(vrfIDs)), "keys", vrfIDs)
 	}
+	if len(kr.Secrets) > 0 {
+		lggr.Infow(fmt.Sprintf("Unlocked %d secrets", len(kr.Secrets)))
+	}
 }
 
 // rawKeyRing is an intermediate struct for encrypting / decrypting keyRing
@@ -219,6 +251,34 @@ type rawKeyRing struct {
 	Solana []solkey.Raw
 	Terra  []terrakey.Raw
 	VRF    []vrfkey.Raw
+	// Secrets is omitted when empty, so that key rings without secrets are
+	// encrypted exactly as before
+	Secrets map[string]string `json:",omitempty"`
+}
+
+// canonicalJSON returns the JSON of the raw keys, with the keys of each type
+// sorted by their JSON, since the key ring holds them in maps.
+func (rawKeys rawKeyRing) canonicalJSON() ([]byte, error) {
+	canonical := make(map[string]interface{})
+	v := reflect.ValueOf(rawKeys)
+	for i := 0; i < v.NumField(); i++ {
+		name, field := v.Type().Field(i).Name, v.Field(i)
+		if field.Kind() != reflect.Slice {
+			canonical[name] = field.Interface()
+			continue
+		}
+		keys := make([]string, field.Len())
+		for j := range keys {
+			b, err := json.Marshal(field.Index(j).Interface())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal %s key", name)
+			}
+			keys[j] = string(b)
+		}
+		sort.Strings(keys)
+		canonical[name] = keys
+	}
+	return json.Marshal(canonical)
 }
 
 func (rawKeys rawKeyRing) keys() (keyRing, error) {
@@ -255,6 +315,9 @@ func (rawKeys rawKeyRing) keys() (keyRing, error) {
 		vrfKey := rawVRFKey.Key()
 		keyRing.VRF[vrfKey.ID()] = vrfKey
 	}
+	for name, value := range rawKeys.Secrets {
+		keyRing.Secrets[name] = value
+	}
 	return keyRing, nil
 }
 
@@ -85,3 +85,29 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	require.Equal(t, originalKeyRing.VRF[vrf1.ID()].PublicKey, decryptedKeyRing.VRF[vrf1.ID()].PublicKey)
 	require.Equal(t, originalKeyRing.VRF[vrf2.ID()].PublicKey, decryptedKeyRing.VRF[vrf2.ID()].PublicKey)
 }
+
+func TestRawKeyRing_canonicalJSON(t *testing.T) {
+	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	vrf1, vrf2 := vrfkey.MustNewV2XXXTestingOnly(big.NewInt(1)), vrfkey.MustNewV2XXXTestingOnly(big.NewInt(2))
+
+	a, err := rawKeyRing{
+		CSA:     []csakey.Raw{csa1.Raw(), csa2.Raw()},
+		VRF:     []vrfkey.Raw{vrf1.Raw(), vrf2.Raw()},
+		Secrets: map[string]string{"a": "1", "b": "2"},
+	}.canonicalJSON()
+	require.NoError(t, err)
+	b, err := rawKeyRing{
+		CSA:     []csakey.Raw{csa2.Raw(), csa1.Raw()},
+		VRF:     []vrfkey.Raw{vrf2.Raw(), vrf1.Raw()},
+		Secrets: map[string]string{"b": "2", "a": "1"},
+	}.canonicalJSON()
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	c, err := rawKeyRing{
+		CSA: []csakey.Raw{csa1.Raw()},
+		VRF: []vrfkey.Raw{vrf2.Raw(), vrf1.Raw()},
+	}.canonicalJSON()
+	require.NoError(t, err)
+	require.NotEqual(t, a, c)
+}
@@ -1,8 +1,11 @@
 package keystore
 
 import (
+	"bytes"
 	"database/sql"
 
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
@@ -10,6 +13,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
@@ -95,3 +100,124 @@ func (orm ksORM) GetEncryptedV1P2PKeys() (retrieved []p2pkey.EncryptedP2PKey, er
 func (orm ksORM) GetEncryptedV1VRFKeys() (retrieved []vrfkey.EncryptedVRFKey, err error) {
 	return retrieved, orm.q.Select(&retrieved, `SELECT * FROM encrypted_vrf_keys WHERE deleted_at IS NULL`)
 }
+
+// reencryptedV1Keys are the legacy V1 keys encrypted with the keystore
+// password, re-encrypted under a new one.
+type reencryptedV1Keys struct {
+	csa []csakey.Key
+	eth []ethkey.Key
+	ocr []ocrkey.EncryptedKeyBundle
+	p2p []p2pkey.EncryptedP2PKey
+}
+
+// reencryptV1Keys decrypts the legacy V1 keys with oldPassword and encrypts
+// them under newPassword, checking that the re-encrypted keys decrypt to the
+// same private keys. V1 VRF keys have their own password and are skipped.
+func (orm ksORM) reencryptV1Keys(oldPassword, newPassword string, scryptParams utils.ScryptParams) (keys reencryptedV1Keys, err error) {
+	csaKeys, err := orm.GetEncryptedV1CSAKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range csaKeys {
+		privKey, err := k.EncryptedPrivateKey.Decrypt(oldPassword)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not decrypt CSA key %d", k.ID)
+		}
+		encrypted, err := crypto.NewEncryptedPrivateKey(privKey, newPassword, scryptParams)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not encrypt CSA key %d", k.ID)
+		}
+		if decrypted, err := encrypted.Decrypt(newPassword); err != nil || !bytes.Equal(decrypted, privKey) {
+			return keys, errors.Errorf("re-encrypted CSA key %d differs", k.ID)
+		}
+		k.EncryptedPrivateKey = *encrypted
+		keys.csa = append(keys.csa, k)
+	}
+
+	ethKeys, err := orm.GetEncryptedV1EthKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range ethKeys {
+		key, err := gethkeystore.DecryptKey(k.JSON, oldPassword)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not decrypt eth key %s", k.Address.Hex())
+		}
+		encrypted, err := gethkeystore.EncryptKey(key, newPassword, scryptParams.N, scryptParams.P)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not encrypt eth key %s", k.Address.Hex())
+		}
+		if decrypted, err := gethkeystore.DecryptKey(encrypted, newPassword); err != nil || decrypted.PrivateKey.D.Cmp(key.PrivateKey.D) != 0 {
+			return keys, errors.Errorf("re-encrypted eth key %s differs", k.Address.Hex())
+		}
+		k.JSON = encrypted
+		keys.eth = append(keys.eth, k)
+	}
+
+	ocrKeys, err := orm.GetEncryptedV1OCRKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range ocrKeys {
+		key, err := k.Decrypt(oldPassword)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not decrypt OCR key %s", k.ID)
+		}
+		encrypted, err := key.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			return keys, errors.Wrapf(err, "could not encrypt OCR key %s", k.ID)
+		}
+		if decrypted, err := encrypted.Decrypt(newPassword); err != nil || !bytes.Equal(decrypted.ToV2().Raw(), key.ToV2().Raw()) {
+			return keys, errors.Errorf("re-encrypted OCR key %s differs", k.ID)
+		}
+		k.EncryptedPrivateKeys = encrypted.EncryptedPrivateKeys
+		keys.ocr = append(keys.ocr, k)
+	}
+
+	p2pKeys, err := orm.GetEncryptedV1P2PKeys()
+	if err != nil {
+		return keys, err
+	}
+	for _, k := range p2pKeys {
+		key, err := k.Decrypt(oldPassword)
+		if err != nil {
+			return keys, err
+		}
+		encrypted, err := key.Encrypt(newPassword, scryptParams)
+		if err != nil {
+			return keys, err
+		}
+		reencrypted := k
+		reencrypted.EncryptedPrivKey = encrypted
+		if decrypted, err := reencrypted.Decrypt(newPassword); err != nil || !bytes.Equal(decrypted.ToV2().Raw(), key.ToV2().Raw()) {
+			return keys, errors.Errorf("re-encrypted P2P key %s differs", k.PeerID)
+		}
+		keys.p2p = append(keys.p2p, reencrypted)
+	}
+	return keys, nil
+}
+
+// save updates the re-encrypted keys in their tables.
+func (keys reencryptedV1Keys) save(tx pg.Queryer) error {
+	for _, k := range keys.csa {
+		if _, err := tx.Exec(`UPDATE csa_keys SET encrypted_private_key = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivateKey, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving CSA key %d", k.ID)
+		}
+	}
+	for _, k := range keys.eth {
+		if _, err := tx.Exec(`UPDATE keys SET json = $1, updated_at = NOW() WHERE id = $2`, k.JSON, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving eth key %s", k.Address.Hex())
+		}
+	}
+	for _, k := range keys.ocr {
+		if _, err := tx.Exec(`UPDATE encrypted_ocr_key_bundles SET encrypted_private_keys = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivateKeys, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving OCR key %s", k.ID)
+		}
+	}
+	for _, k := range keys.p2p {
+		if _, err := tx.Exec(`UPDATE encrypted_p2p_keys SET encrypted_priv_key = $1, updated_at = NOW() WHERE id = $2`, k.EncryptedPrivKey, k.ID); err != nil {
+			return errors.Wrapf(err, "while saving P2P key %s", k.PeerID)
+		}
+	}
+	return nil
+}
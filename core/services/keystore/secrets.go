@@ -0,0 +1,113 @@
+package keystore
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name Secrets --output ./mocks/ --case=underscore --filename secrets.go
+
+// Secrets stores named values, such as API keys, which job pipelines refer to
+// as $(secrets.name) instead of including them in the job spec. They are
+// encrypted with the rest of the key ring.
+type Secrets interface {
+	Get(name string) (string, error)
+	GetNames() ([]string, error)
+	Set(name, value string) error
+	Delete(name string) error
+}
+
+var secretNameRegexp = regexp.MustCompile(`\A[a-zA-Z0-9_]+\z`)
+
+// ErrInvalidSecret is returned when setting a secret with an invalid name or
+// an empty value
+var ErrInvalidSecret = errors.New("invalid secret")
+
+type secrets struct {
+	*keyManager
+}
+
+var _ Secrets = &secrets{}
+
+func newSecretsKeyStore(km *keyManager) *secrets {
+	return &secrets{
+		km,
+	}
+}
+
+func (ks *secrets) Get(name string) (string, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return "", ErrLocked
+	}
+	value, found := ks.keyRing.Secrets[name]
+	if !found {
+		return "", KeyNotFoundError{ID: name, KeyType: "Secret"}
+	}
+	return value, nil
+}
+
+// GetNames returns the names of all secrets, in order. Their values can only
+// be read with Get.
+func (ks *secrets) GetNames() ([]string, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	names := []string{}
+	for name := range ks.keyRing.Secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Set creates the named secret, or replaces its value if it already exists.
+func (ks *secrets) Set(name, value string) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if !secretNameRegexp.MatchString(name) {
+		return errors.Wrapf(ErrInvalidSecret, "name %q may only contain letters, digits and '_'", name)
+	}
+	if value == "" {
+		return errors.Wrap(ErrInvalidSecret, "value must not be empty")
+	}
+
+	oldValue, existed := ks.keyRing.Secrets[name]
+	ks.keyRing.Secrets[name] = value
+	if err := ks.save(); err != nil {
+		if existed {
+			ks.keyRing.Secrets[name] = oldValue
+		} else {
+			delete(ks.keyRing.Secrets, name)
+		}
+		return err
+	}
+	return nil
+}
+
+func (ks *secrets) Delete(name string) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	value, found := ks.keyRing.Secrets[name]
+	if !found {
+		return KeyNotFoundError{ID: name, KeyType: "Secret"}
+	}
+
+	delete(ks.keyRing.Secrets, name)
+	if err := ks.save(); err != nil {
+		ks.keyRing.Secrets[name] = value
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func Test_SecretsKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	keyStore.Unlock(cltest.Password)
+	ks := keyStore.Secrets()
+	reset := func() {
+		_, err := db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		keyStore.Unlock(cltest.Password)
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		names, err := ks.GetNames()
+		require.NoError(t, err)
+		require.Len(t, names, 0)
+	})
+
+	t.Run("errors when getting a non-existent secret", func(t *testing.T) {
+		defer reset()
+		_, err := ks.Get("apiKey")
+		require.Error(t, err)
+	})
+
+	t.Run("sets, replaces and deletes a secret", func(t *testing.T) {
+		defer reset()
+		require.NoError(t, ks.Set("apiKey", "foo"))
+		require.NoError(t, ks.Set("other_key", "bar"))
+		value, err := ks.Get("apiKey")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", value)
+
+		require.NoError(t, ks.Set("apiKey", "baz"))
+		value, err = ks.Get("apiKey")
+		require.NoError(t, err)
+		assert.Equal(t, "baz", value)
+
+		names, err := ks.GetNames()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"apiKey", "other_key"}, names)
+
+		require.NoError(t, ks.Delete("apiKey"))
+		_, err = ks.Get("apiKey")
+		require.Error(t, err)
+		require.Error(t, ks.Delete("apiKey"))
+	})
+
+	t.Run("rejects invalid secrets", func(t *testing.T) {
+		defer reset()
+		assert.ErrorIs(t, ks.Set("api.key", "foo"), keystore.ErrInvalidSecret)
+		assert.ErrorIs(t, ks.Set("apiKey", ""), keystore.ErrInvalidSecret)
+	})
+
+	t.Run("persists secrets encrypted with the key ring", func(t *testing.T) {
+		defer reset()
+		require.NoError(t, ks.Set("apiKey", "foo"))
+
+		var encrypted string
+		require.NoError(t, db.Get(&encrypted, "SELECT encrypted_keys FROM encrypted_key_rings"))
+		assert.NotContains(t, encrypted, "foo")
+
+		keyStore.ResetXXXTestOnly()
+		_, err := keyStore.Secrets().Get("apiKey")
+		require.ErrorIs(t, err, keystore.ErrLocked)
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		value, err := keyStore.Secrets().Get("apiKey")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", value)
+	})
+}
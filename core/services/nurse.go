@@ -34,6 +34,9 @@ type Nurse struct {
 	chGather chan gatherRequest
 	chStop   chan struct{}
 	wgDone   sync.WaitGroup
+
+	// continuous is set once the continuous profiler has started
+	continuous bool
 }
 
 type Config interface {
@@ -48,6 +51,11 @@ type Config interface {
 	AutoPprofMutexProfileFraction() int
 	AutoPprofMemThreshold() utils.FileSize
 	AutoPprofGoroutineThreshold() int
+	AutoPprofContinuousEnabled() bool
+	AutoPprofContinuousInterval() models.Duration
+	AutoPprofContinuousRetention() int
+	AutoPprofContinuousBlockProfileRate() int
+	AutoPprofContinuousMutexProfileFraction() int
 }
 
 type CheckFunc func() (unwell bool, meta Meta)
@@ -86,6 +94,12 @@ func (n *Nurse) Start() error {
 		n.AddCheck("mem", n.checkMem)
 		n.AddCheck("goroutines", n.checkGoroutines)
 
+		if n.cfg.AutoPprofContinuousEnabled() {
+			if err = n.startContinuous(); err != nil {
+				return err
+			}
+		}
+
 		n.wgDone.Add(2)
 
 		// Checker
@@ -132,6 +146,8 @@ func (n *Nurse) Close() error {
 	return n.StopOnce("nurse", func() error {
 		close(n.chStop)
 		n.wgDone.Wait()
+		runtime.SetBlockProfileRate(0)
+		runtime.SetMutexProfileFraction(0)
 		return nil
 	})
 }
@@ -150,6 +166,18 @@ func (n *Nurse) GatherVitals(reason string, meta Meta) {
 	}
 }
 
+// restoreProfileRates turns block and mutex profiling back down to the
+// continuous rates, or off if the continuous profiler is not running.
+func (n *Nurse) restoreProfileRates() {
+	if n.continuous {
+		runtime.SetBlockProfileRate(n.cfg.AutoPprofContinuousBlockProfileRate())
+		runtime.SetMutexProfileFraction(n.cfg.AutoPprofContinuousMutexProfileFraction())
+		return
+	}
+	runtime.SetBlockProfileRate(0)
+	runtime.SetMutexProfileFraction(0)
+}
+
 func (n *Nurse) checkMem() (bool, Meta) {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
@@ -192,14 +220,13 @@ func (n *Nurse) gatherVitals(reason string, meta Meta) {
 	}
 
 	runtime.SetBlockProfileRate(n.cfg.AutoPprofBlockProfileRate())
-	defer runtime.SetBlockProfileRate(0)
 	runtime.SetMutexProfileFraction(n.cfg.AutoPprofMutexProfileFraction())
-	defer runtime.SetMutexProfileFraction(0)
+	defer n.restoreProfileRates()
 
 	now := time.Now()
 
 	var wg sync.WaitGroup
-	wg.Add(9)
+	wg.Add(8)
 
 	err = n.appendLog(now, reason, meta)
 	if err != nil {
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ContinuousProfileTypes are the profiles sampled by the continuous profiler.
+var ContinuousProfileTypes = []string{"cpu", "heap", "mutex", "block"}
+
+var (
+	// ErrContinuousProfilingDisabled is returned when reading profiles while
+	// AUTO_PPROF_CONTINUOUS_ENABLED is not set.
+	ErrContinuousProfilingDisabled = errors.New("continuous profiling is disabled")
+	// ErrProfileNotFound is returned when no profile was sampled at the
+	// requested time.
+	ErrProfileNotFound = errors.New("profile not found")
+)
+
+const continuousDir = "continuous"
+
+// ProfileSample is a set of profiles sampled at the same time by the
+// continuous profiler.
+type ProfileSample struct {
+	Time  time.Time
+	Types []string
+}
+
+func (s ProfileSample) hasType(typ string) bool {
+	i := sort.SearchStrings(s.Types, typ)
+	return i < len(s.Types) && s.Types[i] == typ
+}
+
+func (n *Nurse) continuousRoot() string {
+	return filepath.Join(n.cfg.AutoPprofProfileRoot(), continuousDir)
+}
+
+func (n *Nurse) startContinuous() error {
+	if err := utils.EnsureDirAndMaxPerms(n.continuousRoot(), 0744); err != nil {
+		return err
+	}
+
+	// Block and mutex profiles only record events while enabled, so they stay
+	// enabled for diffs between samples to be meaningful. They are sampled at
+	// much lower rates than when gathering vitals, to keep the overhead low.
+	n.continuous = true
+	n.restoreProfileRates()
+
+	n.wgDone.Add(1)
+	go func() {
+		defer n.wgDone.Done()
+		ticker := time.NewTicker(n.cfg.AutoPprofContinuousInterval().Duration())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-n.chStop:
+				return
+			case <-ticker.C:
+			}
+			n.sampleContinuous(time.Now())
+		}
+	}()
+	return nil
+}
+
+func (n *Nurse) sampleContinuous(now time.Time) {
+	for _, typ := range []string{"heap", "mutex", "block"} {
+		var buf bytes.Buffer
+		if err := pprof.Lookup(typ).WriteTo(&buf, 0); err != nil {
+			n.log.Errorw(fmt.Sprintf("could not sample %v profile", typ), "error", err)
+			continue
+		}
+		n.writeSample(now, typ, buf.Bytes())
+	}
+
+	// Sampling CPU must not delay the next sample
+	dur := n.cfg.AutoPprofGatherDuration().Duration()
+	if interval := n.cfg.AutoPprofContinuousInterval().Duration(); dur > interval/2 {
+		dur = interval / 2
+	}
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		// The CPU profile is already being gathered for vitals
+		n.log.Debugw("could not sample cpu profile", "error", err)
+	} else {
+		select {
+		case <-n.chStop:
+		case <-time.After(dur):
+		}
+		pprof.StopCPUProfile()
+		n.writeSample(now, "cpu", buf.Bytes())
+	}
+
+	if err := n.pruneSamples(); err != nil {
+		n.log.Errorw("could not delete old profile samples", "error", err)
+	}
+}
+
+// writeSample writes to a temporary file first, so that profiles are never
+// read partially written.
+func (n *Nurse) writeSample(now time.Time, typ string, b []byte) {
+	path := n.samplePath(now, typ)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, profilePerms); err != nil {
+		n.log.Errorw(fmt.Sprintf("could not write %v profile sample", typ), "error", err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		n.log.Errorw(fmt.Sprintf("could not write %v profile sample", typ), "error", err)
+	}
+}
+
+func (n *Nurse) samplePath(t time.Time, typ string) string {
+	return filepath.Join(n.continuousRoot(), fmt.Sprintf("%d.%s.pprof", t.UnixNano(), typ))
+}
+
+// pruneSamples deletes the oldest samples beyond AUTO_PPROF_CONTINUOUS_RETENTION.
+func (n *Nurse) pruneSamples() error {
+	samples, err := n.ProfileSamples()
+	if err != nil {
+		return err
+	}
+	excess := len(samples) - n.cfg.AutoPprofContinuousRetention()
+	for i := 0; i < excess; i++ {
+		for _, typ := range samples[i].Types {
+			if err = os.Remove(n.samplePath(samples[i].Time, typ)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ProfileSamples returns the continuous profile samples kept on disk, oldest
+// first.
+func (n *Nurse) ProfileSamples() ([]ProfileSample, error) {
+	if !n.cfg.AutoPprofContinuousEnabled() {
+		return nil, ErrContinuousProfilingDisabled
+	}
+	entries, err := os.ReadDir(n.continuousRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	byTime := make(map[int64][]string)
+	for _, entry := range entries {
+		// <unix nanos>.<type>.pprof
+		parts := strings.Split(entry.Name(), ".")
+		if entry.IsDir() || len(parts) != 3 || parts[2] != "pprof" {
+			continue
+		}
+		nanos, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		byTime[nanos] = append(byTime[nanos], parts[1])
+	}
+
+	samples := make([]ProfileSample, 0, len(byTime))
+	for nanos, types := range byTime {
+		sort.Strings(types)
+		samples = append(samples, ProfileSample{Time: time.Unix(0, nanos), Types: types})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+	return samples, nil
+}
+
+// Profile returns the profile of type typ from the latest sample taken at or
+// before t.
+func (n *Nurse) Profile(typ string, t time.Time) (*profile.Profile, error) {
+	samples, err := n.ProfileSamples()
+	if err != nil {
+		return nil, err
+	}
+	var found *ProfileSample
+	for i := range samples {
+		if samples[i].Time.After(t) {
+			break
+		}
+		if samples[i].hasType(typ) {
+			found = &samples[i]
+		}
+	}
+	if found == nil {
+		return nil, errors.Wrapf(ErrProfileNotFound, "no %s profile sampled at or before %s", typ, t.Format(time.RFC3339))
+	}
+
+	file, err := os.Open(n.samplePath(found.Time, typ))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	p, err := profile.Parse(file)
+	if err != nil {
+		return nil, err
+	}
+	p.TimeNanos = found.Time.UnixNano()
+	return p, nil
+}
+
+// DiffProfiles returns the difference between the profiles of type typ
+// sampled at or before from and to, like `go tool pprof -diff_base`.
+func (n *Nurse) DiffProfiles(typ string, from, to time.Time) (*profile.Profile, error) {
+	p0, err := n.Profile(typ, from)
+	if err != nil {
+		return nil, err
+	}
+	p1, err := n.Profile(typ, to)
+	if err != nil {
+		return nil, err
+	}
+	ts := p1.TimeNanos
+	dur := p1.TimeNanos - p0.TimeNanos
+
+	p0.Scale(-1)
+	diff, err := profile.Merge([]*profile.Profile{p0, p1})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute delta for %v profile", typ)
+	}
+	diff.TimeNanos = ts
+	diff.DurationNanos = dur
+	return diff, nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type nurseConfig struct {
+	root       string
+	continuous bool
+}
+
+func (c nurseConfig) AutoPprofProfileRoot() string { return c.root }
+func (nurseConfig) AutoPprofPollInterval() models.Duration {
+	return models.MustMakeDuration(time.Hour)
+}
+func (nurseConfig) AutoPprofGatherDuration() models.Duration {
+	return models.MustMakeDuration(10 * time.Millisecond)
+}
+func (nurseConfig) AutoPprofGatherTraceDuration() models.Duration {
+	return models.MustMakeDuration(10 * time.Millisecond)
+}
+func (nurseConfig) AutoPprofMaxProfileSize() utils.FileSize { return 100 * utils.MB }
+func (nurseConfig) AutoPprofCPUProfileRate() int            { return 1 }
+func (nurseConfig) AutoPprofMemProfileRate() int            { return 1 }
+func (nurseConfig) AutoPprofBlockProfileRate() int          { return 1 }
+func (nurseConfig) AutoPprofMutexProfileFraction() int      { return 1 }
+func (nurseConfig) AutoPprofMemThreshold() utils.FileSize   { return 4 * utils.GB }
+func (nurseConfig) AutoPprofGoroutineThreshold() int        { return 5000 }
+func (c nurseConfig) AutoPprofContinuousEnabled() bool      { return c.continuous }
+func (nurseConfig) AutoPprofContinuousRetention() int       { return 2 }
+func (nurseConfig) AutoPprofContinuousInterval() models.Duration {
+	return models.MustMakeDuration(time.Hour)
+}
+func (nurseConfig) AutoPprofContinuousBlockProfileRate() int     { return 10000 }
+func (nurseConfig) AutoPprofContinuousMutexProfileFraction() int { return 100 }
+
+func TestNurse_Continuous(t *testing.T) {
+	n := NewNurse(nurseConfig{root: t.TempDir(), continuous: true}, logger.TestLogger(t))
+	require.NoError(t, n.Start())
+	t.Cleanup(func() { assert.NoError(t, n.Close()) })
+
+	samples, err := n.ProfileSamples()
+	require.NoError(t, err)
+	require.Empty(t, samples)
+
+	start := time.Now()
+	times := []time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)}
+	for _, now := range times {
+		n.sampleContinuous(now)
+	}
+
+	// oldest sample was deleted
+	samples, err = n.ProfileSamples()
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	for i, s := range samples {
+		assert.True(t, times[i+1].Equal(s.Time))
+		assert.Equal(t, []string{"block", "cpu", "heap", "mutex"}, s.Types)
+	}
+
+	_, err = n.Profile("heap", start.Add(30*time.Second))
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+
+	// latest sample at or before the requested time
+	p, err := n.Profile("heap", start.Add(90*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, times[1].UnixNano(), p.TimeNanos)
+
+	diff, err := n.DiffProfiles("heap", times[1], time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, times[2].UnixNano(), diff.TimeNanos)
+	assert.Equal(t, time.Minute.Nanoseconds(), diff.DurationNanos)
+}
+
+func TestNurse_ContinuousDisabled(t *testing.T) {
+	n := NewNurse(nurseConfig{root: t.TempDir()}, logger.TestLogger(t))
+
+	_, err := n.ProfileSamples()
+	assert.ErrorIs(t, err, ErrContinuousProfilingDisabled)
+	_, err = n.DiffProfiles("heap", time.Now(), time.Now())
+	assert.ErrorIs(t, err, ErrContinuousProfilingDisabled)
+}
+
+func TestNurse_ProfileRates(t *testing.T) {
+	// A negative fraction reads the current fraction without changing it
+	mutexProfileFraction := func() int { return runtime.SetMutexProfileFraction(-1) }
+
+	t.Run("continuous", func(t *testing.T) {
+		n := NewNurse(nurseConfig{root: t.TempDir(), continuous: true}, logger.TestLogger(t))
+		require.NoError(t, n.Start())
+		assert.Equal(t, 100, mutexProfileFraction())
+
+		n.gatherVitals("test", nil)
+		assert.Equal(t, 100, mutexProfileFraction())
+
+		require.NoError(t, n.Close())
+		assert.Equal(t, 0, mutexProfileFraction())
+	})
+
+	t.Run("vitals only", func(t *testing.T) {
+		n := NewNurse(nurseConfig{root: t.TempDir()}, logger.TestLogger(t))
+		require.NoError(t, n.Start())
+		t.Cleanup(func() { assert.NoError(t, n.Close()) })
+		assert.Equal(t, 0, mutexProfileFraction())
+
+		n.gatherVitals("test", nil)
+		assert.Equal(t, 0, mutexProfileFraction())
+	})
+}
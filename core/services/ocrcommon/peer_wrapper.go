@@ -42,6 +42,14 @@ type (
 		ocr2types.BootstrapperFactory
 	}
 
+	// PeerNetwork provides the endpoint and bootstrapper factories of a peer
+	// in place of the libocr networking stack, e.g. to run oracles over an
+	// in-memory network in tests.
+	PeerNetwork interface {
+		OCR1Peer(peerID string) (ocrtypes.BinaryNetworkEndpointFactory, ocrtypes.BootstrapperFactory)
+		OCR2Peer(peerID string) (ocr2types.BinaryNetworkEndpointFactory, ocr2types.BootstrapperFactory)
+	}
+
 	// SingletonPeerWrapper manages all libocr peers for the application
 	SingletonPeerWrapper struct {
 		utils.StartStopOnce
@@ -52,6 +60,9 @@ type (
 		PeerID        p2pkey.PeerID
 		pstoreWrapper *Pstorewrapper
 
+		// Network replaces the libocr networking stack if set
+		Network PeerNetwork
+
 		// V1V2 adapter
 		Peer *peerAdapter
 
@@ -118,6 +129,15 @@ func (p *SingletonPeerWrapper) Start(context.Context) error {
 		}
 		p.PeerID = key.PeerID()
 
+		if p.Network != nil {
+			p.lggr.Debugw("Using the given network in place of the OCR/OCR2 networking stack", "peerID", p.PeerID)
+			p.Peer = &peerAdapter{}
+			p.Peer.BinaryNetworkEndpointFactory, p.Peer.BootstrapperFactory = p.Network.OCR1Peer(p.PeerID.Raw())
+			p.Peer2 = &peerAdapter2{}
+			p.Peer2.BinaryNetworkEndpointFactory, p.Peer2.BootstrapperFactory = p.Network.OCR2Peer(p.PeerID.Raw())
+			return nil
+		}
+
 		// We need to start the peer store wrapper if v1 is required.
 		// Also fallback to listen params if announce params not specified.
 		ns := p.config.P2PNetworkingStack()
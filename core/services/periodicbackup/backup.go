@@ -1,6 +1,7 @@
 package periodicbackup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -20,7 +21,8 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePattern        = "cl_backup_%s_%s.dump"
+	timestampLayout    = "20060102T150405Z"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -48,6 +50,9 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		encryptionKey   []byte
+		retentionCount  uint32
+		retentionAge    time.Duration
 		done            chan bool
 		utils.StartStopOnce
 	}
@@ -57,6 +62,9 @@ type (
 		DatabaseBackupFrequency() time.Duration
 		DatabaseBackupURL() *url.URL
 		DatabaseBackupDir() string
+		DatabaseBackupEncryptionKey() string
+		DatabaseBackupRetentionCount() uint32
+		DatabaseBackupRetentionAge() time.Duration
 		DatabaseURL() url.URL
 		RootDir() string
 	}
@@ -80,12 +88,24 @@ func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error
 		outputParentDir = dir
 	}
 
+	var encryptionKey []byte
+	if config.DatabaseBackupEncryptionKey() != "" {
+		key, err := ParseEncryptionKey(config.DatabaseBackupEncryptionKey())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid DATABASE_BACKUP_ENCRYPTION_KEY")
+		}
+		encryptionKey = key
+	}
+
 	return &databaseBackup{
 		lggr,
 		dbUrl,
 		config.DatabaseBackupMode(),
 		config.DatabaseBackupFrequency(),
 		outputParentDir,
+		encryptionKey,
+		config.DatabaseBackupRetentionCount(),
+		config.DatabaseBackupRetentionAge(),
 		make(chan bool),
 		utils.StartStopOnce{},
 	}, nil
@@ -142,6 +162,9 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		return err
 	}
 	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	if err = pruneBackups(backup.outputParentDir, backup.retentionCount, backup.retentionAge, time.Now(), backup.logger); err != nil {
+		backup.logger.Errorw("Failed to delete old backups", "err", err)
+	}
 	return nil
 }
 
@@ -161,9 +184,13 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 
 	args := []string{
 		backup.databaseURL.String(),
-		"-f", tmpFile.Name(),
 		"-F", "c", // format: custom (zipped)
 	}
+	if backup.encryptionKey == nil {
+		// an encrypted dump is streamed from stdout instead, so that it is
+		// never written to disk unencrypted
+		args = append(args, "-f", tmpFile.Name())
+	}
 
 	if backup.mode == config.DatabaseBackupModeLite {
 		for _, table := range excludedDataFromTables {
@@ -185,33 +212,49 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		"pg_dump", args...,
 	)
 
-	_, err = cmd.Output()
+	if backup.encryptionKey != nil {
+		err = backup.dumpEncrypted(cmd, tmpFile.Name())
+	} else {
+		_, err = cmd.Output()
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			err = errors.Wrapf(err, "pg_dump failed with output: %s", string(ee.Stderr))
+		} else if err != nil {
+			err = errors.Wrap(err, "pg_dump failed")
+		}
+	}
 
 	if err != nil {
+		_ = os.Remove(tmpFile.Name())
 		partialResult := &backupResult{
 			size:            0,
 			path:            "",
 			maskedArguments: maskedArgs,
 			pgDumpArguments: args,
 		}
-		var ee *exec.ExitError
-		if errors.As(err, &ee) {
-			return partialResult, errors.Wrapf(err, "pg_dump failed with output: %s", string(ee.Stderr))
-		}
-		return partialResult, errors.Wrap(err, "pg_dump failed")
+		return partialResult, err
 	}
 
 	if version == "" {
 		version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
+	createdAt := time.Now().UTC()
+	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version, createdAt.Format(timestampLayout)))
+	dumpPath := tmpFile.Name()
+	if backup.encryptionKey != nil {
+		finalFilePath += ".enc"
+	}
 	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	err = os.Rename(dumpPath, finalFilePath)
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
+		_ = os.Remove(dumpPath)
 		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
 	}
 
+	if err = backup.writeManifest(finalFilePath, version, createdAt); err != nil {
+		return nil, err
+	}
+
 	file, err := os.Stat(finalFilePath)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to access the final backup file")
@@ -224,3 +267,54 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		pgDumpArguments: args,
 	}, nil
 }
+
+// dumpEncrypted runs the pg_dump cmd and encrypts its output as it is
+// written, into a new file at path.
+func (backup *databaseBackup) dumpEncrypted(cmd *exec.Cmd, path string) error {
+	dst, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create the encrypted backup file")
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		_ = dst.Close()
+		return errors.Wrap(err, "pg_dump failed")
+	}
+	if err = cmd.Start(); err != nil {
+		_ = dst.Close()
+		return errors.Wrap(err, "pg_dump failed")
+	}
+	encErr := encrypt(dst, stdout, backup.encryptionKey)
+	if cerr := dst.Close(); encErr == nil {
+		encErr = cerr
+	}
+	if encErr != nil {
+		_ = cmd.Process.Kill()
+	}
+	if err = cmd.Wait(); err != nil && encErr == nil {
+		return errors.Wrapf(err, "pg_dump failed with output: %s", stderr.String())
+	}
+	return errors.Wrap(encErr, "Failed to encrypt the backup")
+}
+
+func (backup *databaseBackup) writeManifest(path string, version string, createdAt time.Time) error {
+	sum, err := fileChecksum(path)
+	if err != nil {
+		return errors.Wrap(err, "Failed to compute the backup checksum")
+	}
+	migration, err := migrationVersion(backup.databaseURL)
+	if err != nil {
+		return errors.Wrap(err, "Failed to read the database migration version")
+	}
+	err = writeManifest(path, Manifest{
+		NodeVersion:      version,
+		MigrationVersion: migration,
+		Mode:             backup.mode,
+		CreatedAt:        createdAt,
+		Encrypted:        backup.encryptionKey != nil,
+		SHA256:           sum,
+	})
+	return errors.Wrap(err, "Failed to write the backup manifest")
+}
@@ -0,0 +1,154 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypted backups are sealed with AES-256-GCM in chunks, so that a dump
+// never has to fit in memory:
+//
+//   magic ‖ nonce prefix ‖ chunk ‖ chunk ‖ ...
+//
+// Each chunk is a flag byte, the length of its ciphertext as a big-endian
+// uint32, and the ciphertext of up to encryptionChunkSize bytes. The nonce of
+// a chunk is the nonce prefix followed by the index of the chunk, and its flag
+// is authenticated, so that reordered, dropped or truncated chunks fail to
+// decrypt. The flag of the last chunk is chunkFlagLast.
+const (
+	encryptionMagic     = "CLBACKUP1"
+	encryptionChunkSize = 1 << 20
+	noncePrefixSize     = 8
+
+	chunkFlagMore byte = 0
+	chunkFlagLast byte = 1
+)
+
+var errTruncatedBackup = errors.New("encrypted backup is truncated")
+
+// ParseEncryptionKey decodes a hex encoded AES-256 key.
+func ParseEncryptionKey(s string) ([]byte, error) {
+	key, err := hex.DecodeString(s)
+	if err != nil || len(key) != 32 {
+		return nil, errors.New("backup encryption key must be a hex encoded 32 byte key")
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, index uint32) []byte {
+	nonce := make([]byte, noncePrefixSize+4)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], index)
+	return nonce
+}
+
+// encrypt writes the encryption of src with key to dst.
+func encrypt(dst io.Writer, src io.Reader, key []byte) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err = rand.Read(prefix); err != nil {
+		return errors.Wrap(err, "failed to generate nonce")
+	}
+	if _, err = io.WriteString(dst, encryptionMagic); err != nil {
+		return err
+	}
+	if _, err = dst.Write(prefix); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(src)
+	buf := make([]byte, encryptionChunkSize)
+	for index := uint32(0); ; index++ {
+		n, err := io.ReadFull(r, buf)
+		flag := chunkFlagMore
+		switch {
+		case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+			flag = chunkFlagLast
+		case err != nil:
+			return err
+		default:
+			if _, err = r.Peek(1); errors.Is(err, io.EOF) {
+				flag = chunkFlagLast
+			} else if err != nil {
+				return err
+			}
+		}
+
+		sealed := gcm.Seal(nil, chunkNonce(prefix, index), buf[:n], []byte{flag})
+		header := make([]byte, 5)
+		header[0] = flag
+		binary.BigEndian.PutUint32(header[1:], uint32(len(sealed)))
+		if _, err = dst.Write(header); err != nil {
+			return err
+		}
+		if _, err = dst.Write(sealed); err != nil {
+			return err
+		}
+		if flag == chunkFlagLast {
+			return nil
+		}
+	}
+}
+
+// decrypt writes the decryption of src, which was encrypted with key, to dst.
+func decrypt(dst io.Writer, src io.Reader, key []byte) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	r := bufio.NewReader(src)
+	magic := make([]byte, len(encryptionMagic))
+	if _, err = io.ReadFull(r, magic); err != nil || string(magic) != encryptionMagic {
+		return errors.New("not an encrypted backup")
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err = io.ReadFull(r, prefix); err != nil {
+		return errTruncatedBackup
+	}
+
+	header := make([]byte, 5)
+	for index := uint32(0); ; index++ {
+		if _, err = io.ReadFull(r, header); err != nil {
+			return errTruncatedBackup
+		}
+		flag, size := header[0], binary.BigEndian.Uint32(header[1:])
+		if size > encryptionChunkSize+uint32(gcm.Overhead()) {
+			return errors.Errorf("encrypted backup chunk %d is too large", index)
+		}
+		sealed := make([]byte, size)
+		if _, err = io.ReadFull(r, sealed); err != nil {
+			return errTruncatedBackup
+		}
+		plain, err := gcm.Open(sealed[:0], chunkNonce(prefix, index), sealed, []byte{flag})
+		if err != nil {
+			return errors.Errorf("failed to decrypt backup chunk %d: wrong key or corrupted backup", index)
+		}
+		if _, err = dst.Write(plain); err != nil {
+			return err
+		}
+		if flag == chunkFlagLast {
+			if _, err = r.Peek(1); !errors.Is(err, io.EOF) {
+				return errors.New("encrypted backup has trailing data")
+			}
+			return nil
+		}
+	}
+}
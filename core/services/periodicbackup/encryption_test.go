@@ -0,0 +1,82 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption_RoundTrip(t *testing.T) {
+	key, err := ParseEncryptionKey(strings.Repeat("ab", 32))
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, 2*encryptionChunkSize + 7} {
+		plain := make([]byte, size)
+		_, err = rand.Read(plain)
+		require.NoError(t, err)
+
+		var enc bytes.Buffer
+		require.NoError(t, encrypt(&enc, bytes.NewReader(plain), key))
+		assert.False(t, size > 0 && bytes.Contains(enc.Bytes(), plain))
+
+		var dec bytes.Buffer
+		require.NoError(t, decrypt(&dec, bytes.NewReader(enc.Bytes()), key), "size %d", size)
+		assert.True(t, bytes.Equal(plain, dec.Bytes()), "size %d", size)
+	}
+}
+
+func TestEncryption_Tampering(t *testing.T) {
+	key, err := ParseEncryptionKey(strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	otherKey, err := ParseEncryptionKey(strings.Repeat("cd", 32))
+	require.NoError(t, err)
+
+	plain := bytes.Repeat([]byte("chainlink"), encryptionChunkSize/4)
+	var enc bytes.Buffer
+	require.NoError(t, encrypt(&enc, bytes.NewReader(plain), key))
+	encrypted := enc.Bytes()
+
+	t.Run("wrong key", func(t *testing.T) {
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted), otherKey)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "wrong key or corrupted backup")
+	})
+
+	t.Run("flipped bit", func(t *testing.T) {
+		corrupted := append([]byte{}, encrypted...)
+		corrupted[len(corrupted)/2] ^= 1
+		require.Error(t, decrypt(&bytes.Buffer{}, bytes.NewReader(corrupted), key))
+	})
+
+	t.Run("truncated after a chunk", func(t *testing.T) {
+		// magic, nonce prefix, and the first chunk header and ciphertext
+		firstChunk := len(encryptionMagic) + noncePrefixSize + 5 + encryptionChunkSize + 16
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted[:firstChunk]), key)
+		assert.ErrorIs(t, err, errTruncatedBackup)
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(append(append([]byte{}, encrypted...), 0)), key)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "trailing data")
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		err := decrypt(&bytes.Buffer{}, bytes.NewReader(plain), key)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not an encrypted backup")
+	})
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	_, err := ParseEncryptionKey(strings.Repeat("ab", 32))
+	assert.NoError(t, err)
+	_, err = ParseEncryptionKey(strings.Repeat("ab", 16))
+	assert.Error(t, err)
+	_, err = ParseEncryptionKey(strings.Repeat("zz", 32))
+	assert.Error(t, err)
+}
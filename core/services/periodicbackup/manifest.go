@@ -0,0 +1,239 @@
+package periodicbackup
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+)
+
+const manifestSuffix = ".manifest.json"
+
+// Manifest describes a backup file. It is stored next to the backup, in a
+// file with the same name followed by manifestSuffix.
+type Manifest struct {
+	NodeVersion      string                    `json:"nodeVersion"`
+	MigrationVersion int64                     `json:"migrationVersion"`
+	Mode             config.DatabaseBackupMode `json:"mode"`
+	CreatedAt        time.Time                 `json:"createdAt"`
+	Encrypted        bool                      `json:"encrypted"`
+	// SHA256 is the hex encoded checksum of the backup file as stored on disk
+	SHA256 string `json:"sha256"`
+}
+
+// ManifestPath returns the path of the manifest of the backup at path.
+func ManifestPath(path string) string {
+	return path + manifestSuffix
+}
+
+// ReadManifest reads the manifest of the backup at path.
+func ReadManifest(path string) (Manifest, error) {
+	var m Manifest
+	b, err := ioutil.ReadFile(ManifestPath(path))
+	if err != nil {
+		return m, errors.Wrap(err, "failed to read backup manifest")
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "failed to parse backup manifest")
+	}
+	return m, nil
+}
+
+func writeManifest(path string, m Manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(ManifestPath(path), b, 0600)
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// migrationVersion returns the version of the latest migration applied to the
+// database at dbURL.
+func migrationVersion(dbURL url.URL) (int64, error) {
+	db, err := sql.Open(string(dialects.Postgres), dbURL.String())
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	return migrate.Version(db)
+}
+
+// ValidateBackup checks that the backup at path matches the checksum in its
+// manifest, that it is a pg_dump archive, and that it was taken from a
+// database that this node can migrate. An encrypted backup is decrypted
+// entirely with encryptionKey, so that a wrong key or a corrupted chunk is
+// found before anything is restored.
+func ValidateBackup(path string, encryptionKey []byte) (Manifest, error) {
+	m, err := ReadManifest(path)
+	if err != nil {
+		return m, err
+	}
+	if m.MigrationVersion <= 0 {
+		return m, errors.Errorf("backup manifest has invalid migration version %d", m.MigrationVersion)
+	}
+	sum, err := fileChecksum(path)
+	if err != nil {
+		return m, errors.Wrap(err, "failed to read backup")
+	}
+	if sum != m.SHA256 {
+		return m, errors.Errorf("backup checksum mismatch: manifest has %s but file has %s; the backup is corrupted", m.SHA256, sum)
+	}
+	if err = validateArchive(path, m.Encrypted, encryptionKey); err != nil {
+		return m, err
+	}
+	latest, err := migrate.Latest()
+	if err != nil {
+		return m, errors.Wrap(err, "failed to load migrations")
+	}
+	if m.MigrationVersion > latest {
+		return m, errors.Errorf("backup was taken by node version %s at migration %d, but this node only knows migrations up to %d; restore it with node version %s or later", m.NodeVersion, m.MigrationVersion, latest, m.NodeVersion)
+	}
+	return m, nil
+}
+
+// pgDumpMagic starts the archives of pg_dump in the custom format.
+const pgDumpMagic = "PGDMP"
+
+// validateArchive checks that the backup at path is a pg_dump archive, once
+// decrypted if it is encrypted.
+func validateArchive(path string, encrypted bool, encryptionKey []byte) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read backup")
+	}
+	defer f.Close()
+	if !encrypted {
+		magic := make([]byte, len(pgDumpMagic))
+		if _, err = io.ReadFull(f, magic); err != nil || string(magic) != pgDumpMagic {
+			return errors.New("backup is not a pg_dump archive")
+		}
+		return nil
+	}
+	if encryptionKey == nil {
+		return errors.New("backup is encrypted; set DATABASE_BACKUP_ENCRYPTION_KEY to the key it was taken with")
+	}
+	var archive prefixWriter
+	if err = decrypt(&archive, f, encryptionKey); err != nil {
+		return errors.Wrap(err, "failed to decrypt backup")
+	}
+	if string(archive.prefix) != pgDumpMagic {
+		return errors.New("decrypted backup is not a pg_dump archive")
+	}
+	return nil
+}
+
+// prefixWriter discards what is written to it, except for its first bytes.
+type prefixWriter struct {
+	prefix []byte
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if n := len(pgDumpMagic) - len(w.prefix); n > 0 {
+		if n > len(p) {
+			n = len(p)
+		}
+		w.prefix = append(w.prefix, p[:n]...)
+	}
+	return len(p), nil
+}
+
+type storedBackup struct {
+	path     string
+	manifest Manifest
+}
+
+// listBackups returns the backups in dir, newest first. Backups which have a
+// manifest are dated by it. Dumps of the legacy cl_backup_<version>.dump
+// format, which have no manifest, are dated by their modification time.
+func listBackups(dir string) ([]storedBackup, error) {
+	manifests, err := filepath.Glob(filepath.Join(dir, "cl_backup_*"+manifestSuffix))
+	if err != nil {
+		return nil, err
+	}
+	var backups []storedBackup
+	for _, mp := range manifests {
+		path := strings.TrimSuffix(mp, manifestSuffix)
+		m, err := ReadManifest(path)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, storedBackup{path, m})
+	}
+	dumps, err := filepath.Glob(filepath.Join(dir, "cl_backup_*.dump"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range dumps {
+		if _, err = os.Stat(ManifestPath(path)); err == nil {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, storedBackup{path, Manifest{CreatedAt: info.ModTime()}})
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].manifest.CreatedAt.After(backups[j].manifest.CreatedAt)
+	})
+	return backups, nil
+}
+
+// pruneBackups deletes the backups in dir beyond the newest count, and those
+// older than maxAge. A zero count or maxAge disables the respective limit.
+// The newest backup is always kept.
+func pruneBackups(dir string, count uint32, maxAge time.Duration, now time.Time, lggr logger.Logger) error {
+	if count == 0 && maxAge == 0 {
+		return nil
+	}
+	backups, err := listBackups(dir)
+	if err != nil {
+		return err
+	}
+	for i, b := range backups {
+		if i == 0 {
+			continue
+		}
+		tooMany := count > 0 && i >= int(count)
+		tooOld := maxAge > 0 && now.Sub(b.manifest.CreatedAt) > maxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		lggr.Infow("Deleting old database backup", "filePath", b.path, "createdAt", b.manifest.CreatedAt)
+		if err = os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to delete backup %s", b.path)
+		}
+		if err = os.Remove(ManifestPath(b.path)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to delete manifest of backup %s", b.path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+)
+
+func writeTestBackup(t *testing.T, dir string, createdAt time.Time, migration int64) string {
+	path := filepath.Join(dir, fmt.Sprintf(filePattern, "1.2.3", createdAt.Format(timestampLayout)))
+	require.NoError(t, os.WriteFile(path, []byte(pgDumpMagic+path), 0600))
+	sum, err := fileChecksum(path)
+	require.NoError(t, err)
+	require.NoError(t, writeManifest(path, Manifest{
+		NodeVersion:      "1.2.3",
+		MigrationVersion: migration,
+		CreatedAt:        createdAt,
+		SHA256:           sum,
+	}))
+	return path
+}
+
+func TestManifest_ValidateBackup(t *testing.T) {
+	dir := t.TempDir()
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+
+	path := writeTestBackup(t, dir, time.Now(), latest)
+	m, err := ValidateBackup(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", m.NodeVersion)
+
+	newer := writeTestBackup(t, dir, time.Now().Add(time.Second), latest+1)
+	_, err = ValidateBackup(newer, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "restore it with node version 1.2.3 or later")
+
+	require.NoError(t, os.WriteFile(path, []byte("corrupted"), 0600))
+	_, err = ValidateBackup(path, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	_, err = ValidateBackup(filepath.Join(dir, "missing.dump"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read backup manifest")
+
+	notDump := writeTestBackup(t, dir, time.Now(), latest)
+	require.NoError(t, os.WriteFile(notDump, []byte("not a dump"), 0600))
+	sum, err := fileChecksum(notDump)
+	require.NoError(t, err)
+	require.NoError(t, writeManifest(notDump, Manifest{NodeVersion: "1.2.3", MigrationVersion: latest, SHA256: sum}))
+	_, err = ValidateBackup(notDump, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a pg_dump archive")
+}
+
+func TestManifest_ValidateEncryptedBackup(t *testing.T) {
+	dir := t.TempDir()
+	latest, err := migrate.Latest()
+	require.NoError(t, err)
+	key, err := ParseEncryptionKey(strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	otherKey, err := ParseEncryptionKey(strings.Repeat("cd", 32))
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, fmt.Sprintf(filePattern, "1.2.3", time.Now().Format(timestampLayout))+".enc")
+	var enc bytes.Buffer
+	require.NoError(t, encrypt(&enc, strings.NewReader(pgDumpMagic+"dump"), key))
+	require.NoError(t, os.WriteFile(path, enc.Bytes(), 0600))
+	sum, err := fileChecksum(path)
+	require.NoError(t, err)
+	require.NoError(t, writeManifest(path, Manifest{NodeVersion: "1.2.3", MigrationVersion: latest, Encrypted: true, SHA256: sum}))
+
+	_, err = ValidateBackup(path, key)
+	require.NoError(t, err)
+
+	_, err = ValidateBackup(path, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "set DATABASE_BACKUP_ENCRYPTION_KEY")
+
+	_, err = ValidateBackup(path, otherKey)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to decrypt backup")
+}
+
+func TestManifest_PruneBackups(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	lggr := logger.TestLogger(t)
+
+	setup := func(t *testing.T) (string, []string) {
+		dir := t.TempDir()
+		var paths []string
+		// newest first
+		for i := 0; i < 4; i++ {
+			paths = append(paths, writeTestBackup(t, dir, now.Add(-time.Duration(i)*time.Hour), 1))
+		}
+		return dir, paths
+	}
+	exists := func(path string) bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}
+
+	t.Run("no limits", func(t *testing.T) {
+		dir, paths := setup(t)
+		require.NoError(t, pruneBackups(dir, 0, 0, now, lggr))
+		for _, p := range paths {
+			assert.True(t, exists(p))
+		}
+	})
+
+	t.Run("count", func(t *testing.T) {
+		dir, paths := setup(t)
+		require.NoError(t, pruneBackups(dir, 2, 0, now, lggr))
+		assert.True(t, exists(paths[0]))
+		assert.True(t, exists(paths[1]))
+		assert.False(t, exists(paths[2]))
+		assert.False(t, exists(ManifestPath(paths[2])))
+		assert.False(t, exists(paths[3]))
+	})
+
+	t.Run("age", func(t *testing.T) {
+		dir, paths := setup(t)
+		require.NoError(t, pruneBackups(dir, 0, 90*time.Minute, now, lggr))
+		assert.True(t, exists(paths[0]))
+		assert.True(t, exists(paths[1]))
+		assert.False(t, exists(paths[2]))
+		assert.False(t, exists(paths[3]))
+	})
+
+	t.Run("keeps newest", func(t *testing.T) {
+		dir, paths := setup(t)
+		require.NoError(t, pruneBackups(dir, 0, time.Minute, now.Add(24*time.Hour), lggr))
+		assert.True(t, exists(paths[0]))
+		assert.False(t, exists(paths[1]))
+	})
+
+	t.Run("legacy backups without manifest", func(t *testing.T) {
+		dir, paths := setup(t)
+		legacy := filepath.Join(dir, "cl_backup_0.9.9.dump")
+		require.NoError(t, os.WriteFile(legacy, []byte("legacy"), 0600))
+		require.NoError(t, os.Chtimes(legacy, now.Add(-30*time.Minute), now.Add(-30*time.Minute)))
+		require.NoError(t, pruneBackups(dir, 2, 0, now, lggr))
+		assert.True(t, exists(paths[0]))
+		assert.True(t, exists(legacy))
+		assert.False(t, exists(paths[1]))
+
+		require.NoError(t, pruneBackups(dir, 0, 20*time.Minute, now, lggr))
+		assert.True(t, exists(paths[0]))
+		assert.False(t, exists(legacy))
+	})
+}
@@ -0,0 +1,136 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// RestoreConfig is the configuration needed to restore a backup.
+type RestoreConfig interface {
+	DatabaseURL() url.URL
+	DatabaseBackupEncryptionKey() string
+}
+
+// EncryptionKey returns the parsed DATABASE_BACKUP_ENCRYPTION_KEY, or nil if
+// it is not set.
+func EncryptionKey(cfg RestoreConfig) ([]byte, error) {
+	if cfg.DatabaseBackupEncryptionKey() == "" {
+		return nil, nil
+	}
+	return ParseEncryptionKey(cfg.DatabaseBackupEncryptionKey())
+}
+
+// Restore restores the backup at path, described by manifest, into the
+// database at DATABASE_URL. Existing objects in the database are replaced,
+// except for the lease_lock table, which holds the lease of the caller.
+// The backup should be checked with ValidateBackup first.
+func Restore(cfg RestoreConfig, path string, manifest Manifest, lggr logger.Logger) error {
+	dumpPath := path
+	if manifest.Encrypted {
+		key, err := EncryptionKey(cfg)
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			return errors.New("backup is encrypted; set DATABASE_BACKUP_ENCRYPTION_KEY to the key it was taken with")
+		}
+		dumpPath, err = decryptToTempFile(path, key)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(dumpPath)
+	}
+
+	listPath, err := restoreList(dumpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(listPath)
+
+	dbURL := cfg.DatabaseURL()
+	args := []string{
+		"-d", dbURL.String(),
+		"--clean",
+		"--if-exists",
+		"--no-owner",
+		"--single-transaction",
+		"-L", listPath,
+		dumpPath,
+	}
+	lggr.Debugw("Running pg_restore", "url", dbURL.Redacted(), "filePath", path)
+	_, err = exec.Command("pg_restore", args...).Output()
+	if err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
+
+// restoreList writes the table of contents of the dump at dumpPath to a
+// temporary file, for pg_restore -L, leaving out the lease_lock table. It
+// would otherwise be dropped and replaced with the lease of the node that took
+// the backup, while the restoring node holds its own lease.
+func restoreList(dumpPath string) (string, error) {
+	out, err := exec.Command("pg_restore", "-l", dumpPath).Output()
+	if err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return "", errors.Wrapf(err, "pg_restore failed to list the backup with output: %s", string(ee.Stderr))
+		}
+		return "", errors.Wrap(err, "pg_restore failed to list the backup")
+	}
+	var list bytes.Buffer
+	for _, line := range strings.SplitAfter(string(out), "\n") {
+		if strings.Contains(line, " public lease_lock ") || strings.Contains(line, " public only_one_lease_lock ") {
+			continue
+		}
+		list.WriteString(line)
+	}
+	f, err := ioutil.TempFile(filepath.Dir(dumpPath), "cl_restore_list_")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create a tmp file")
+	}
+	_, err = f.Write(list.Bytes())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func decryptToTempFile(path string, key []byte) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+	// ioutil.TempFile creates files with mode 0600
+	dst, err := ioutil.TempFile(filepath.Dir(path), "cl_restore_tmp_")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create a tmp file")
+	}
+	err = decrypt(dst, src, key)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+	return dst.Name(), nil
+}
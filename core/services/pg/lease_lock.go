@@ -10,11 +10,16 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 	"go.uber.org/multierr"
 )
 
+// leaseLostAlertTimeout is how long the node waits for the alert that it lost
+// the lease to be sent before exiting
+const leaseLostAlertTimeout = 5 * time.Second
+
 // LeaseLock handles taking an exclusive lease on database access. This is not
 // enforced by any database primitives, but rather voluntarily respected by
 // other instances of the Chainlink application.
@@ -63,16 +68,17 @@ type leaseLock struct {
 	refreshInterval time.Duration
 	leaseDuration   time.Duration
 	logger          logger.Logger
+	notifier        alerts.Notifier
 	stop            func()
 	wgReleased      sync.WaitGroup
 }
 
 // NewLeaseLock creates a "leaseLock" - an entity that tries to take an exclusive lease on the database
-func NewLeaseLock(db *sqlx.DB, appID uuid.UUID, lggr logger.Logger, refreshInterval, leaseDuration time.Duration) LeaseLock {
+func NewLeaseLock(db *sqlx.DB, appID uuid.UUID, lggr logger.Logger, refreshInterval, leaseDuration time.Duration, notifier alerts.Notifier) LeaseLock {
 	if refreshInterval > leaseDuration/2 {
 		panic("refresh interval must be <= half the lease duration")
 	}
-	return &leaseLock{appID, db, nil, refreshInterval, leaseDuration, lggr.Named("LeaseLock").With("appID", appID), func() {}, sync.WaitGroup{}}
+	return &leaseLock{appID, db, nil, refreshInterval, leaseDuration, lggr.Named("LeaseLock").With("appID", appID), notifier, func() {}, sync.WaitGroup{}}
 }
 
 // TakeAndHold will block and wait indefinitely until it can get its first lock or ctx is cancelled.
@@ -218,12 +224,28 @@ func (l *leaseLock) loop(ctx context.Context) {
 				if err := l.db.Close(); err != nil {
 					l.logger.Errorw("Failed to close DB", "err", err)
 				}
+				l.notifyLeaseLost()
 				l.logger.Fatal("Another node has taken the lease, exiting immediately")
 			}
 		}
 	}
 }
 
+// notifyLeaseLost raises an alert, and waits for it to be sent since the node
+// exits right after.
+func (l *leaseLock) notifyLeaseLost() {
+	l.notifier.Notify(alerts.Alert{
+		Type:     alerts.TypeLeaseLockLost,
+		Severity: alerts.SeverityCritical,
+		Key:      l.id.String(),
+		Summary:  "Another node has taken the database lease lock, this node is exiting",
+		Details:  map[string]string{"clientID": l.id.String()},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), leaseLostAlertTimeout)
+	defer cancel()
+	l.notifier.Flush(ctx)
+}
+
 // initialSQL is necessary because the application attempts to take the lease
 // lock BEFORE running migrations
 var initialSQL = []string{
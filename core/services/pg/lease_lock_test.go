@@ -14,11 +14,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
 func newLeaseLock(t *testing.T, db *sqlx.DB, cfg *configtest.TestGeneralConfig) pg.LeaseLock {
-	return pg.NewLeaseLock(db, uuid.NewV4(), logger.TestLogger(t), cfg.LeaseLockRefreshInterval(), cfg.LeaseLockDuration())
+	return pg.NewLeaseLock(db, uuid.NewV4(), logger.TestLogger(t), cfg.LeaseLockRefreshInterval(), cfg.LeaseLockDuration(), alerts.NullNotifier{})
 }
 
 func Test_LeaseLock(t *testing.T) {
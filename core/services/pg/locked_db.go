@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/sqlx"
 )
@@ -14,6 +15,8 @@ import (
 // LockedDB bounds DB connection and DB locks.
 type LockedDB interface {
 	Open(ctx context.Context) error
+	OpenStandby() error
+	Lock(ctx context.Context) error
 	Close() error
 	DB() *sqlx.DB
 }
@@ -21,16 +24,19 @@ type LockedDB interface {
 type lockedDb struct {
 	cfg          config.GeneralConfig
 	lggr         logger.Logger
+	notifier     alerts.Notifier
 	db           *sqlx.DB
 	leaseLock    LeaseLock
 	advisoryLock AdvisoryLock
 }
 
-// NewLockedDB creates a new instance of LockedDB.
-func NewLockedDB(cfg config.GeneralConfig, lggr logger.Logger) LockedDB {
+// NewLockedDB creates a new instance of LockedDB. The notifier raises an
+// alert when the lease lock is lost.
+func NewLockedDB(cfg config.GeneralConfig, lggr logger.Logger, notifier alerts.Notifier) LockedDB {
 	return &lockedDb{
-		cfg:  cfg,
-		lggr: lggr.Named("LockedDB"),
+		cfg:      cfg,
+		lggr:     lggr.Named("LockedDB"),
+		notifier: notifier,
 	}
 }
 
@@ -57,23 +63,46 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 		// l.db will be nil in case of error
 		return errors.Wrap(err, "failed to open db")
 	}
-	revert := func() {
+
+	// Step 2: acquire DB locks
+	if err = l.Lock(ctx); err != nil {
 		// Let Open() return the actual error, while l.Close() error is just logged.
 		if err2 := l.Close(); err2 != nil {
 			l.lggr.Errorf("failed to cleanup LockedDB: %v", err2)
 		}
 	}
+	return
+}
+
+// OpenStandby connects to DB without acquiring DB locks, so that a standby
+// node can read from the DB while another node holds the locks.
+// Lock must succeed before anything is written to the DB.
+// NOT THREAD SAFE
+func (l *lockedDb) OpenStandby() (err error) {
+	if l.db != nil {
+		l.lggr.Panic("calling OpenStandby() twice")
+	}
+	l.db, err = openDB(l.cfg, l.lggr)
+	return errors.Wrap(err, "failed to open db")
+}
+
+// Lock acquires DB locks based on configuration, on a DB connection opened by
+// OpenStandby. Any lock acquired before a failure is released by Close.
+// This is a blocking function and it may execute long due to DB locks acquisition.
+// NOT THREAD SAFE
+func (l *lockedDb) Lock(ctx context.Context) (err error) {
+	if l.db == nil {
+		l.lggr.Panic("calling Lock() before opening the db")
+	}
 
-	// Step 2: acquire DB locks
 	lockingMode := l.cfg.DatabaseLockingMode()
 	l.lggr.Debugf("Using database locking mode: %s", lockingMode)
 
 	// Take the lease before any other DB operations
 	switch lockingMode {
 	case "lease", "dual":
-		l.leaseLock = NewLeaseLock(l.db, l.cfg.AppID(), l.lggr, l.cfg.LeaseLockRefreshInterval(), l.cfg.LeaseLockDuration())
+		l.leaseLock = NewLeaseLock(l.db, l.cfg.AppID(), l.lggr, l.cfg.LeaseLockRefreshInterval(), l.cfg.LeaseLockDuration(), l.notifier)
 		if err = l.leaseLock.TakeAndHold(ctx); err != nil {
-			defer revert()
 			return errors.Wrap(err, "failed to take initial lease on database")
 		}
 	}
@@ -83,7 +112,6 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 	case "advisorylock", "dual":
 		l.advisoryLock = NewAdvisoryLock(l.db, l.cfg.AdvisoryLockID(), l.lggr, l.cfg.AdvisoryLockCheckInterval())
 		if err = l.advisoryLock.TakeAndHold(ctx); err != nil {
-			defer revert()
 			return errors.Wrap(err, "error acquiring lock")
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 
 	"github.com/stretchr/testify/require"
@@ -18,7 +19,7 @@ func TestLockedDB_HappyPath(t *testing.T) {
 	config := cltest.NewTestGeneralConfig(t)
 	config.Overrides.DatabaseLockingMode = null.StringFrom("dual")
 	lggr := logger.TestLogger(t)
-	ldb := pg.NewLockedDB(config, lggr)
+	ldb := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
 
 	err := ldb.Open(context.Background())
 	require.NoError(t, err)
@@ -34,7 +35,7 @@ func TestLockedDB_ContextCancelled(t *testing.T) {
 	config := cltest.NewTestGeneralConfig(t)
 	config.Overrides.DatabaseLockingMode = null.StringFrom("dual")
 	lggr := logger.TestLogger(t)
-	ldb := pg.NewLockedDB(config, lggr)
+	ldb := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -48,7 +49,7 @@ func TestLockedDB_OpenTwice(t *testing.T) {
 	config := cltest.NewTestGeneralConfig(t)
 	config.Overrides.DatabaseLockingMode = null.StringFrom("lease")
 	lggr := logger.TestLogger(t)
-	ldb := pg.NewLockedDB(config, lggr)
+	ldb := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
 
 	err := ldb.Open(context.Background())
 	require.NoError(t, err)
@@ -65,7 +66,7 @@ func TestLockedDB_TwoInstances(t *testing.T) {
 	config.Overrides.DatabaseLockingMode = null.StringFrom("dual")
 	lggr := logger.TestLogger(t)
 
-	ldb1 := pg.NewLockedDB(config, lggr)
+	ldb1 := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
 	err := ldb1.Open(context.Background())
 	require.NoError(t, err)
 	defer func() {
@@ -76,11 +77,37 @@ func TestLockedDB_TwoInstances(t *testing.T) {
 	// hence we use some timeout
 	ctx, cancel := context.WithTimeout(context.Background(), config.LeaseLockDuration())
 	defer cancel()
-	ldb2 := pg.NewLockedDB(config, lggr)
+	ldb2 := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
 	err = ldb2.Open(ctx)
 	require.Error(t, err)
 }
 
+func TestLockedDB_Standby(t *testing.T) {
+	testutils.SkipShortDB(t)
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.DatabaseLockingMode = null.StringFrom("dual")
+	lggr := logger.TestLogger(t)
+
+	leader := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
+	require.NoError(t, leader.Open(context.Background()))
+
+	// standby can connect while the leader holds the locks
+	standby := pg.NewLockedDB(config, lggr, alerts.NullNotifier{})
+	require.NoError(t, standby.OpenStandby())
+	defer func() {
+		require.NoError(t, standby.Close())
+	}()
+	require.NotNil(t, standby.DB())
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.LeaseLockDuration())
+	defer cancel()
+	require.Error(t, standby.Lock(ctx))
+
+	// standby takes over once the leader is gone
+	require.NoError(t, leader.Close())
+	require.NoError(t, standby.Lock(context.Background()))
+}
+
 func TestOpenUnlockedDB(t *testing.T) {
 	testutils.SkipShortDB(t)
 	config := cltest.NewTestGeneralConfig(t)
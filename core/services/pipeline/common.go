@@ -291,6 +291,8 @@ const (
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
+	TaskTypeETHSign          TaskType = "ethsign"
+	TaskTypeETHSignTypedData TaskType = "ethsigntypeddata"
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
@@ -298,6 +300,13 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeLowercase        TaskType = "lowercase"
 	TaskTypeUppercase        TaskType = "uppercase"
+	TaskTypeForEach          TaskType = "foreach"
+	TaskTypeSubpipeline      TaskType = "subpipeline"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeSolanaEncode     TaskType = "solencode"
+	TaskTypeSolanaTx         TaskType = "soltx"
+	TaskTypeTerraEncode      TaskType = "terraencode"
+	TaskTypeTerraTx          TaskType = "terratx"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -378,6 +387,24 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSubpipeline:
+		task = &SubpipelineTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSolanaEncode:
+		task = &SolanaEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSolanaTx:
+		task = &SolanaTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTerraEncode:
+		task = &TerraEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTerraTx:
+		task = &TerraTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHSign:
+		task = &ETHSignTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHSignTypedData:
+		task = &ETHSignTypedDataTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -408,6 +435,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 	if err != nil {
 		return nil, err
 	}
+
+	// Expressions are parsed up front, so that invalid ones are rejected when
+	// the job is created
+	if t, is := task.(*ExprTask); is {
+		if t.expression, err = parseExpression(t.Expr); err != nil {
+			return nil, errors.Wrap(err, "expr")
+		}
+	}
 	return task, nil
 }
 
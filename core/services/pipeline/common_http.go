@@ -20,8 +20,11 @@ func makeHTTPRequest(
 	method StringParam,
 	url URLParam,
 	requestData MapParam,
+	requestHeaders MapParam,
 	client *http.Client,
-	httpLimit int64,
+	config Config,
+	breakers *HostCircuitBreakers,
+	limiter *bridgeLimiter,
 ) ([]byte, int, http.Header, time.Duration, error) {
 
 	var bodyReader io.Reader
@@ -38,16 +41,45 @@ func makeHTTPRequest(
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
 	request.Header.Set("Content-Type", "application/json")
+	for name, value := range requestHeaders {
+		str, is := value.(string)
+		if !is {
+			return nil, 0, nil, 0, errors.Wrapf(ErrBadInput, "header %s must be a string, got %T", name, value)
+		}
+		request.Header.Set(name, str)
+	}
 
 	httpRequest := clhttp.HTTPRequest{
 		Client:  client,
 		Request: request,
-		Config:  clhttp.HTTPRequestConfig{SizeLimit: httpLimit},
+		Config:  clhttp.HTTPRequestConfig{SizeLimit: config.DefaultHTTPLimit()},
 		Logger:  lggr.Named("HTTPRequest"),
 	}
 
+	if limiter != nil {
+		release, err := limiter.acquire(ctx)
+		if err != nil {
+			return nil, 0, nil, 0, err
+		}
+		defer release()
+	}
+
+	breaker := breakers.get(url.Host)
+	if breaker != nil {
+		if err = breaker.allow(time.Now()); err != nil {
+			return nil, 0, nil, 0, err
+		}
+	}
+
 	start := time.Now()
 	responseBytes, statusCode, headers, err := httpRequest.SendRequest()
+	if breaker != nil {
+		if isHTTPOutcomeCounted(ctx, err) {
+			breaker.done(!isHTTPFailure(statusCode, err), time.Now())
+		} else {
+			breaker.release()
+		}
+	}
 	if ctx.Err() != nil {
 		return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
 	}
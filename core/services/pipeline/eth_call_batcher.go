@@ -0,0 +1,408 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+)
+
+// ethCallBatchWindow is how long the first ethcall task of a run waits for
+// the other ethcall tasks of the run to join its batch
+const ethCallBatchWindow = 10 * time.Millisecond
+
+// multicallABI is the aggregate3 method of the Multicall3 contract, see
+// https://github.com/mds1/multicall
+const multicallABI = `[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicallAggregate3 = func() abi.Method {
+	parsed, err := abi.JSON(strings.NewReader(multicallABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Methods["aggregate3"]
+}()
+
+type multicallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// ethCall is a call made by an ethcall task.
+type ethCall struct {
+	chain         evm.Chain
+	msg           ethereum.CallMsg
+	block         BlockNumberParam
+	stateOverride MapParam
+	// multicall is the address of the Multicall3 contract to aggregate the
+	// call with others, if set
+	multicall *common.Address
+
+	// ctx and taskID are of the task which made the call
+	ctx    context.Context
+	taskID int
+	done   chan struct{}
+	result []byte
+	err    error
+}
+
+type ethCallBatchKey struct {
+	chainID   string
+	multicall common.Address
+	// Calls aggregated by a multicall contract are made at the same block
+	block BlockNumberParam
+}
+
+// ethCallBatcher sends the calls of the ethcall tasks of a run which are
+// made at about the same time together, either as one JSON-RPC batch or as
+// one call to a Multicall3 contract.
+type ethCallBatcher struct {
+	// descendants are the ethcall tasks downstream of each ethcall task, by
+	// task ID. They cannot make their call until the call of the task has
+	// been sent.
+	descendants map[int]map[int]bool
+
+	mu sync.Mutex
+	// waiting are the ethcall tasks which haven't made their call yet
+	waiting map[int]bool
+	batches map[ethCallBatchKey][]*ethCall
+}
+
+// newETHCallBatcher returns a batcher for the ethcall tasks of the pipeline,
+// or nil if no two of them can run at the same time.
+func newETHCallBatcher(tasks []Task) *ethCallBatcher {
+	descendants := make(map[int]map[int]bool)
+	for _, task := range tasks {
+		if task.Type() == TaskTypeETHCall {
+			descendants[task.ID()] = ethCallDescendants(task, make(map[int]bool))
+		}
+	}
+
+	concurrent := false
+	for id := range descendants {
+		for otherID := range descendants {
+			if id != otherID && !descendants[id][otherID] && !descendants[otherID][id] {
+				concurrent = true
+			}
+		}
+	}
+	if !concurrent {
+		return nil
+	}
+
+	waiting := make(map[int]bool)
+	for id := range descendants {
+		waiting[id] = true
+	}
+	return &ethCallBatcher{
+		descendants: descendants,
+		waiting:     waiting,
+		batches:     make(map[ethCallBatchKey][]*ethCall),
+	}
+}
+
+func ethCallDescendants(task Task, found map[int]bool) map[int]bool {
+	for _, output := range task.Outputs() {
+		if output.Type() == TaskTypeETHCall {
+			found[output.ID()] = true
+		}
+		ethCallDescendants(output, found)
+	}
+	return found
+}
+
+// call adds the call of the task to a batch, and waits for the batch to be
+// sent. The batch is sent as soon as no other ethcall task can join it, or
+// after ethCallBatchWindow.
+func (b *ethCallBatcher) call(ctx context.Context, taskID int, call *ethCall) ([]byte, error) {
+	call.ctx = ctx
+	call.taskID = taskID
+	call.done = make(chan struct{})
+	key := ethCallBatchKey{chainID: call.chain.ID().String()}
+	if call.multicall != nil {
+		key.multicall = *call.multicall
+		key.block = call.block
+	}
+
+	b.mu.Lock()
+	delete(b.waiting, taskID)
+	batch := append(b.batches[key], call)
+	b.batches[key] = batch
+	// The call may complete other batches too, which were waiting for it
+	var full []ethCallBatchKey
+	for k, v := range b.batches {
+		if b.isComplete(v) {
+			full = append(full, k)
+		}
+	}
+	if len(batch) == 1 && !b.isComplete(batch) {
+		time.AfterFunc(ethCallBatchWindow, func() { b.flush(key, batch[0]) })
+	}
+	firsts := make([]*ethCall, len(full))
+	for i, k := range full {
+		firsts[i] = b.batches[k][0]
+	}
+	b.mu.Unlock()
+
+	for i, k := range full {
+		go b.flush(k, firsts[i])
+	}
+
+	select {
+	case <-call.done:
+		return call.result, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isComplete returns whether every waiting ethcall task is downstream of a
+// call of the batch, so none can join it.
+func (b *ethCallBatcher) isComplete(batch []*ethCall) bool {
+	for id := range b.waiting {
+		downstream := false
+		for _, call := range batch {
+			if b.descendants[call.taskID][id] {
+				downstream = true
+				break
+			}
+		}
+		if !downstream {
+			return false
+		}
+	}
+	return true
+}
+
+// flush sends the batch started by first, unless it has been sent already.
+// Calls whose task has given up are left out.
+func (b *ethCallBatcher) flush(key ethCallBatchKey, first *ethCall) {
+	b.mu.Lock()
+	batch := b.batches[key]
+	if len(batch) == 0 || batch[0] != first {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.batches, key)
+	b.mu.Unlock()
+
+	var calls []*ethCall
+	for _, call := range batch {
+		if err := call.ctx.Err(); err != nil {
+			call.err = err
+			close(call.done)
+		} else {
+			calls = append(calls, call)
+		}
+	}
+	if len(calls) == 0 {
+		return
+	}
+
+	ctx, cancel := batchContext(calls)
+	defer cancel()
+	executeETHCalls(ctx, calls)
+	for _, call := range calls {
+		close(call.done)
+	}
+}
+
+// batchContext returns the context to send a batch of calls with. It is not
+// canceled by the context of any one call, but has the latest of their
+// deadlines and is canceled once all of them are done.
+func batchContext(calls []*ethCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var deadline time.Time
+	for _, call := range calls {
+		callDeadline, ok := call.ctx.Deadline()
+		if !ok {
+			deadline = time.Time{}
+			break
+		}
+		if callDeadline.After(deadline) {
+			deadline = callDeadline
+		}
+	}
+	if !deadline.IsZero() {
+		var cancelDeadline context.CancelFunc
+		ctx, cancelDeadline = context.WithDeadline(ctx, deadline)
+		cancelParent := cancel
+		cancel = func() {
+			cancelDeadline()
+			cancelParent()
+		}
+	}
+
+	go func() {
+		for _, call := range calls {
+			select {
+			case <-call.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
+// executeETHCalls makes the calls, setting the result or error of each.
+// Calls to a multicall contract must have the same chain, contract and block.
+func executeETHCalls(ctx context.Context, calls []*ethCall) {
+	switch {
+	case calls[0].multicall != nil:
+		executeMulticall(ctx, calls)
+	case len(calls) == 1:
+		call := calls[0]
+		call.result, call.err = executeETHCall(ctx, call)
+	default:
+		executeETHCallBatch(ctx, calls)
+	}
+}
+
+func executeETHCall(ctx context.Context, call *ethCall) ([]byte, error) {
+	blockNumber := call.block.BigInt()
+	if call.stateOverride == nil && (call.block == BlockNumberLatest || blockNumber != nil) {
+		return call.chain.Client().CallContract(ctx, call.msg, blockNumber)
+	}
+
+	var result hexutil.Bytes
+	err := call.chain.Client().CallContext(ctx, &result, "eth_call", call.args()...)
+	return result, err
+}
+
+func executeETHCallBatch(ctx context.Context, calls []*ethCall) {
+	results := make([]hexutil.Bytes, len(calls))
+	elems := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		elems[i] = rpc.BatchElem{Method: "eth_call", Args: call.args(), Result: &results[i]}
+	}
+
+	err := calls[0].chain.Client().BatchCallContext(ctx, elems)
+	for i, call := range calls {
+		if err != nil {
+			call.err = err
+		} else if elems[i].Error != nil {
+			call.err = batchElemError(elems[i].Error)
+		} else {
+			call.result = results[i]
+		}
+	}
+}
+
+func executeMulticall(ctx context.Context, calls []*ethCall) {
+	setErr := func(err error) {
+		for _, call := range calls {
+			call.err = err
+		}
+	}
+
+	aggregated := make([]multicallCall, len(calls))
+	for i, call := range calls {
+		aggregated[i] = multicallCall{Target: *call.msg.To, AllowFailure: true, CallData: call.msg.Data}
+	}
+	args, err := multicallAggregate3.Inputs.Pack(aggregated)
+	if err != nil {
+		setErr(errors.Wrap(err, "multicall: failed to encode calls"))
+		return
+	}
+
+	first := calls[0]
+	resp, err := executeETHCall(ctx, &ethCall{
+		chain: first.chain,
+		msg:   ethereum.CallMsg{To: first.multicall, Data: append(append([]byte{}, multicallAggregate3.ID...), args...)},
+		block: first.block,
+	})
+	if err != nil {
+		setErr(errors.Wrap(err, "multicall"))
+		return
+	}
+
+	var results []multicallResult
+	unpacked, err := multicallAggregate3.Outputs.Unpack(resp)
+	if err == nil && len(unpacked) == 1 {
+		err = multicallAggregate3.Outputs.Copy(&results, unpacked)
+	}
+	if err != nil || len(results) != len(calls) {
+		setErr(errors.Errorf("multicall: unexpected response %s", hexutil.Encode(resp)))
+		return
+	}
+	for i, call := range calls {
+		if results[i].Success {
+			call.result = results[i].ReturnData
+		} else {
+			call.err = revertError(results[i].ReturnData)
+		}
+	}
+}
+
+// batchElemError returns the error of a call of a JSON-RPC batch as a
+// JsonError, as CallContract returns it.
+func batchElemError(err error) error {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+	jsonErr := &evmclient.JsonError{Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		jsonErr.Data = dataErr.ErrorData()
+	}
+	return jsonErr
+}
+
+// revertError returns the error of a call aggregated by a multicall contract
+// which reverted, as the eth_call of the call would have returned it.
+func revertError(data []byte) error {
+	message := "execution reverted"
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		message += ": " + reason
+	}
+	return &evmclient.JsonError{Code: 3, Message: message, Data: hexutil.Encode(data)}
+}
+
+// args returns the params of the eth_call JSON-RPC method for the call.
+func (call *ethCall) args() []interface{} {
+	arg := map[string]interface{}{
+		"to":   call.msg.To,
+		"data": hexutil.Bytes(call.msg.Data),
+	}
+	if call.msg.From != (common.Address{}) {
+		arg["from"] = call.msg.From
+	}
+	if call.msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(call.msg.Gas)
+	}
+	for name, value := range map[string]*big.Int{
+		"gasPrice":             call.msg.GasPrice,
+		"maxPriorityFeePerGas": call.msg.GasTipCap,
+		"maxFeePerGas":         call.msg.GasFeeCap,
+	} {
+		if value != nil {
+			arg[name] = (*hexutil.Big)(value)
+		}
+	}
+
+	args := []interface{}{arg, string(call.block)}
+	if call.stateOverride != nil {
+		args = append(args, call.stateOverride)
+	}
+	return args
+}
@@ -0,0 +1,59 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETHCallBatcher_batchContext(t *testing.T) {
+	t.Run("is not canceled with one call", func(t *testing.T) {
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+
+		ctx, cancel := batchContext([]*ethCall{{ctx: ctx1}, {ctx: ctx2}})
+		defer cancel()
+
+		cancel1()
+		select {
+		case <-ctx.Done():
+			t.Fatal("batch context was canceled with the context of one call")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		cancel2()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("batch context was not canceled with the contexts of all calls")
+		}
+	})
+
+	t.Run("has the latest deadline of the calls", func(t *testing.T) {
+		early, late := time.Now().Add(time.Minute), time.Now().Add(time.Hour)
+		ctx1, cancel1 := context.WithDeadline(context.Background(), early)
+		defer cancel1()
+		ctx2, cancel2 := context.WithDeadline(context.Background(), late)
+		defer cancel2()
+
+		ctx, cancel := batchContext([]*ethCall{{ctx: ctx1}, {ctx: ctx2}})
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.Equal(t, late, deadline)
+	})
+
+	t.Run("has no deadline if a call has none", func(t *testing.T) {
+		ctx1, cancel1 := context.WithDeadline(context.Background(), time.Now().Add(time.Minute))
+		defer cancel1()
+
+		ctx, cancel := batchContext([]*ethCall{{ctx: ctx1}, {ctx: context.Background()}})
+		defer cancel()
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,526 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// expression is a parsed expression of an expr task, e.g.
+//
+//     max(min(price * (1 + spread), ceiling), floor)
+//     abs(a - b) / b > 0.05 ? a : b
+//
+// Numbers are decimals, so arithmetic is exact except for division, which is
+// rounded to decimal.DivisionPrecision places. Identifiers refer to the
+// task's named inputs, or otherwise to pipeline variables such as ds1 or
+// jobRun.meta.
+type expression struct {
+	root      exprNode
+	variables []string
+}
+
+type exprNode interface {
+	eval(lookup func(name string) (interface{}, error)) (interface{}, error)
+}
+
+// maxExprPowExponent bounds the exponent of pow, so that an expression cannot
+// take arbitrarily long to evaluate
+const maxExprPowExponent = 1000
+
+// maxExprRoundPlaces bounds the number of decimal places of round, for the
+// same reason
+const maxExprRoundPlaces = 100
+
+type exprFunc struct {
+	minArgs, maxArgs int
+	call             func(args []decimal.Decimal) (decimal.Decimal, error)
+}
+
+var exprFuncs = map[string]exprFunc{
+	"min": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return decimal.Min(args[0], args[1:]...), nil
+	}},
+	"max": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return decimal.Max(args[0], args[1:]...), nil
+	}},
+	"abs": {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return args[0].Abs(), nil
+	}},
+	"pow": {2, 2, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		if !args[1].IsInteger() {
+			return decimal.Decimal{}, errors.Errorf("pow: exponent must be an integer, got %s", args[1])
+		}
+		if args[1].Abs().GreaterThan(decimal.NewFromInt(maxExprPowExponent)) {
+			return decimal.Decimal{}, errors.Errorf("pow: exponent must be at most %d, got %s", maxExprPowExponent, args[1])
+		}
+		if args[0].IsZero() && args[1].IsNegative() {
+			return decimal.Decimal{}, errors.New("pow: division by zero")
+		}
+		return args[0].Pow(args[1]), nil
+	}},
+	"round": {1, 2, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		places := decimal.Zero
+		if len(args) == 2 {
+			places = args[1]
+		}
+		if !places.IsInteger() {
+			return decimal.Decimal{}, errors.Errorf("round: places must be an integer, got %s", places)
+		}
+		if places.Abs().GreaterThan(decimal.NewFromInt(maxExprRoundPlaces)) {
+			return decimal.Decimal{}, errors.Errorf("round: places must be at most %d, got %s", maxExprRoundPlaces, places)
+		}
+		return args[0].Round(int32(places.IntPart())), nil
+	}},
+}
+
+// parseExpression parses the expression of an expr task.
+func parseExpression(source string) (*expression, error) {
+	tokens, err := lexExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens, variables: make(map[string]bool)}
+	root, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, p.errorf(tok, "unexpected %q", tok.text)
+	}
+
+	e := &expression{root: root}
+	for name := range p.variables {
+		e.variables = append(e.variables, name)
+	}
+	return e, nil
+}
+
+// Eval evaluates the expression, returning a decimal.Decimal or a bool.
+func (e *expression) Eval(lookup func(name string) (interface{}, error)) (interface{}, error) {
+	return e.root.eval(lookup)
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenIdent
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// Longer operators come first so that e.g. "<=" is not lexed as "<", "="
+var exprOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "!", "?", ":", "(", ")", ","}
+
+func lexExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, exprToken{exprTokenNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, exprToken{exprTokenIdent, string(runes[start:i]), start})
+		default:
+			var op string
+			for _, candidate := range exprOperators {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Wrapf(ErrBadInput, "expression: unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, exprToken{exprTokenOperator, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, exprToken{exprTokenEOF, "end of expression", len(runes)}), nil
+}
+
+type exprParser struct {
+	tokens    []exprToken
+	pos       int
+	variables map[string]bool
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given operators.
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		tok := p.peek()
+		return p.errorf(tok, "expected %q, got %q", op, tok.text)
+	}
+	return nil
+}
+
+func (p *exprParser) errorf(tok exprToken, format string, args ...interface{}) error {
+	return errors.Wrapf(ErrBadInput, "expression: %s at position %d", fmt.Sprintf(format, args...), tok.pos)
+}
+
+func (p *exprParser) parseTernary() (exprNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.accept("?"); !ok {
+		return cond, nil
+	}
+	ifTrue, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	ifFalse, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	return &exprTernary{cond, ifTrue, ifFalse}, nil
+}
+
+// exprPrecedence lists the binary operators from lowest to highest precedence
+var exprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprPrecedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(exprPrecedence[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{op, left, right}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if op, ok := p.accept("-", "!"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprUnary{op, operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenNumber:
+		value, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %q", tok.text)
+		}
+		return exprLiteral{value}, nil
+
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return exprLiteral{true}, nil
+		case "false":
+			return exprLiteral{false}, nil
+		}
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(tok)
+		}
+		p.variables[tok.text] = true
+		return exprVariable(tok.text), nil
+
+	case exprTokenOperator:
+		if tok.text == "(" {
+			node, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+	}
+	return nil, p.errorf(tok, "unexpected %q", tok.text)
+}
+
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	fn, exists := exprFuncs[name.text]
+	if !exists {
+		return nil, p.errorf(name, "unknown function %q", name.text)
+	}
+
+	var args []exprNode
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, p.errorf(name, "wrong number of arguments to %s (got %d)", name.text, len(args))
+	}
+	return &exprCall{name.text, fn, args}, nil
+}
+
+type exprLiteral struct {
+	value interface{}
+}
+
+func (n exprLiteral) eval(func(string) (interface{}, error)) (interface{}, error) {
+	return n.value, nil
+}
+
+type exprVariable string
+
+func (n exprVariable) eval(lookup func(string) (interface{}, error)) (interface{}, error) {
+	value, err := lookup(string(n))
+	if err != nil {
+		return nil, err
+	}
+	if b, is := value.(bool); is {
+		return b, nil
+	}
+	var d DecimalParam
+	if err := d.UnmarshalPipelineParam(value); err != nil {
+		return nil, errors.Wrapf(err, "%s: expected a number or a bool, got %T", string(n), value)
+	}
+	return d.Decimal(), nil
+}
+
+type exprUnary struct {
+	op      string
+	operand exprNode
+}
+
+func (n *exprUnary) eval(lookup func(string) (interface{}, error)) (interface{}, error) {
+	value, err := n.operand.eval(lookup)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, err := exprBool(n.op, value)
+		if err != nil {
+			return nil, err
+		}
+		return !b, nil
+	}
+	d, err := exprDecimal(n.op, value)
+	if err != nil {
+		return nil, err
+	}
+	return d.Neg(), nil
+}
+
+type exprBinary struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *exprBinary) eval(lookup func(string) (interface{}, error)) (interface{}, error) {
+	left, err := n.left.eval(lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || only evaluate their right operand when needed
+	if n.op == "&&" || n.op == "||" {
+		l, err := exprBool(n.op, left)
+		if err != nil {
+			return nil, err
+		}
+		if l == (n.op == "||") {
+			return l, nil
+		}
+		right, err := n.right.eval(lookup)
+		if err != nil {
+			return nil, err
+		}
+		return exprBool(n.op, right)
+	}
+
+	right, err := n.right.eval(lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "==" || n.op == "!=" {
+		lb, lIsBool := left.(bool)
+		rb, rIsBool := right.(bool)
+		if lIsBool || rIsBool {
+			if !lIsBool || !rIsBool {
+				return nil, errors.Wrapf(ErrBadInput, "%s: cannot compare a bool with a number", n.op)
+			}
+			return (lb == rb) == (n.op == "=="), nil
+		}
+	}
+
+	l, err := exprDecimal(n.op, left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := exprDecimal(n.op, right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "+":
+		return l.Add(r), nil
+	case "-":
+		return l.Sub(r), nil
+	case "*":
+		return l.Mul(r), nil
+	case "/":
+		if r.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "/: division by zero")
+		}
+		return l.Div(r), nil
+	case "%":
+		if r.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "%: division by zero")
+		}
+		return l.Mod(r), nil
+	case "==":
+		return l.Equal(r), nil
+	case "!=":
+		return !l.Equal(r), nil
+	case "<":
+		return l.LessThan(r), nil
+	case "<=":
+		return l.LessThanOrEqual(r), nil
+	case ">":
+		return l.GreaterThan(r), nil
+	case ">=":
+		return l.GreaterThanOrEqual(r), nil
+	}
+	return nil, errors.Errorf("unknown operator %s", n.op)
+}
+
+type exprTernary struct {
+	cond, ifTrue, ifFalse exprNode
+}
+
+func (n *exprTernary) eval(lookup func(string) (interface{}, error)) (interface{}, error) {
+	value, err := n.cond.eval(lookup)
+	if err != nil {
+		return nil, err
+	}
+	cond, err := exprBool("?:", value)
+	if err != nil {
+		return nil, err
+	}
+	if cond {
+		return n.ifTrue.eval(lookup)
+	}
+	return n.ifFalse.eval(lookup)
+}
+
+type exprCall struct {
+	name string
+	fn   exprFunc
+	args []exprNode
+}
+
+func (n *exprCall) eval(lookup func(string) (interface{}, error)) (interface{}, error) {
+	args := make([]decimal.Decimal, len(n.args))
+	for i, arg := range n.args {
+		value, err := arg.eval(lookup)
+		if err != nil {
+			return nil, err
+		}
+		if args[i], err = exprDecimal(n.name, value); err != nil {
+			return nil, err
+		}
+	}
+	result, err := n.fn.call(args)
+	if err != nil {
+		return nil, errors.Wrap(ErrBadInput, err.Error())
+	}
+	return result, nil
+}
+
+func exprBool(op string, value interface{}) (bool, error) {
+	b, is := value.(bool)
+	if !is {
+		return false, errors.Wrapf(ErrBadInput, "%s: expected a bool, got a number", op)
+	}
+	return b, nil
+}
+
+func exprDecimal(op string, value interface{}) (decimal.Decimal, error) {
+	d, is := value.(decimal.Decimal)
+	if !is {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "%s: expected a number, got a bool", op)
+	}
+	return d, nil
+}
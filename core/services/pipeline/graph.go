@@ -61,6 +61,20 @@ func (g *Graph) AddImplicitDependenciesAsEdges() {
 		params := make(map[string]bool)
 		// Walk through all attributes and find all params which this node depends on
 		for _, attr := range graphNode.Attributes() {
+			// The nested pipeline of a foreach task refers to its own tasks,
+			// which must not be confused with tasks of this pipeline
+			if graphNode.attrs["type"] == string(TaskTypeForEach) && attr.Key == "pipeline" {
+				continue
+			}
+			// The variables of an expression refer to tasks without $(...)
+			if graphNode.attrs["type"] == string(TaskTypeExpr) && attr.Key == "expr" {
+				if expression, err := parseExpression(attr.Value); err == nil {
+					for _, variable := range expression.variables {
+						params[strings.Split(variable, ".")[0]] = true
+					}
+				}
+				continue
+			}
 			for _, item := range variableRegexp.FindAll([]byte(attr.Value), -1) {
 				expr := strings.TrimSpace(string(item[2 : len(item)-1]))
 				param := strings.Split(expr, ".")[0]
@@ -239,8 +253,8 @@ func Parse(text string) (*Pipeline, error) {
 			panic("unreachable")
 		}
 
-		if node.dotID == InputTaskKey {
-			return nil, errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name", InputTaskKey)
+		if node.dotID == InputTaskKey || node.dotID == SecretsKey {
+			return nil, errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name", node.dotID)
 		}
 
 		task, err := UnmarshalTaskFromMap(TaskType(node.attrs["type"]), node.attrs, id, node.dotID)
@@ -7,6 +7,9 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 )
 
@@ -43,6 +46,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetCircuitBreakers(circuitBreakers *HostCircuitBreakers) {
+	t.circuitBreakers = circuitBreakers
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.chainSet = cc
 	t.config = config
@@ -52,3 +59,23 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore)
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (t *ETHSignTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore) {
+	t.chainSet = cc
+	t.keyStore = keyStore
+}
+
+func (t *ETHSignTypedDataTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore) {
+	t.chainSet = cc
+	t.keyStore = keyStore
+}
+
+func (t *SolanaTxTask) HelperSetDependencies(cs solana.ChainSet, keyStore SolanaKeyStore) {
+	t.chainSet = cs
+	t.keyStore = keyStore
+}
+
+func (t *TerraTxTask) HelperSetDependencies(cs terra.ChainSet, id uuid.UUID) {
+	t.chainSet = cs
+	t.uuid = id
+}
@@ -0,0 +1,331 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+// CircuitBreakerState is the state of the circuit breaker of the HTTP
+// requests made by pipeline tasks to a host.
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed lets all requests through.
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+	// CircuitBreakerOpen fails all requests without making them, after too
+	// many consecutive requests to the host failed.
+	CircuitBreakerOpen CircuitBreakerState = "open"
+	// CircuitBreakerHalfOpen lets one probe request through, once the breaker
+	// has been open for HTTP_CIRCUIT_BREAKER_TIMEOUT. The breaker closes if
+	// the probe succeeds, and opens again otherwise.
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+)
+
+// ErrCircuitBreakerOpen is returned instead of making a request to a host
+// whose circuit breaker is open.
+var ErrCircuitBreakerOpen = errors.New("circuit breaker open")
+
+var (
+	promHTTPCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_http_circuit_breaker_state",
+		Help: "State of the circuit breaker of the HTTP requests to a host: 0 closed, 1 half-open, 2 open",
+	},
+		[]string{"host"},
+	)
+	promHTTPCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_http_circuit_breaker_rejections",
+		Help: "Number of HTTP requests to a host which were not made because its circuit breaker was open",
+	},
+		[]string{"host"},
+	)
+	promBridgeRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_bridge_requests_in_flight",
+		Help: "Number of requests to a bridge which are in flight",
+	},
+		[]string{"bridge"},
+	)
+)
+
+// HostCircuitBreakers are the circuit breakers of the hosts which the http
+// and bridge tasks of a runner make requests to, shared by all its runs so
+// that a failing host is detected across jobs. A nil *HostCircuitBreakers
+// never opens.
+type HostCircuitBreakers struct {
+	threshold uint32
+	timeout   time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewHostCircuitBreakers returns circuit breakers which open after threshold
+// consecutive failed requests to a host, for timeout. They never open if
+// threshold is 0.
+func NewHostCircuitBreakers(threshold uint32, timeout time.Duration) *HostCircuitBreakers {
+	return &HostCircuitBreakers{
+		threshold: threshold,
+		timeout:   timeout,
+		breakers:  make(map[string]*circuitBreaker),
+	}
+}
+
+// State returns the state of the circuit breaker of the host, e.g.
+// "example.com:8080".
+func (cbs *HostCircuitBreakers) State(host string) CircuitBreakerState {
+	if cbs == nil {
+		return CircuitBreakerClosed
+	}
+	cbs.mu.Lock()
+	cb, exists := cbs.breakers[host]
+	cbs.mu.Unlock()
+	if !exists {
+		return CircuitBreakerClosed
+	}
+	return cb.getState(time.Now())
+}
+
+// get returns the circuit breaker of the host, or nil if the breakers are
+// disabled.
+func (cbs *HostCircuitBreakers) get(host string) *circuitBreaker {
+	if cbs == nil || cbs.threshold == 0 {
+		return nil
+	}
+	cbs.mu.Lock()
+	defer cbs.mu.Unlock()
+	cb, exists := cbs.breakers[host]
+	if !exists {
+		cb = &circuitBreaker{host: host, threshold: cbs.threshold, timeout: cbs.timeout}
+		cbs.breakers[host] = cb
+	}
+	return cb
+}
+
+type circuitBreaker struct {
+	host      string
+	threshold uint32
+	timeout   time.Duration
+
+	mu       sync.Mutex
+	failures uint32
+	// openUntil is set while the breaker is open or half-open
+	openUntil time.Time
+	probing   bool
+}
+
+func (cb *circuitBreaker) getState(now time.Time) CircuitBreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state(now)
+}
+
+func (cb *circuitBreaker) state(now time.Time) CircuitBreakerState {
+	switch {
+	case cb.openUntil.IsZero():
+		return CircuitBreakerClosed
+	case now.Before(cb.openUntil) && !cb.probing:
+		return CircuitBreakerOpen
+	default:
+		return CircuitBreakerHalfOpen
+	}
+}
+
+// allow returns ErrCircuitBreakerOpen if a request to the host must not be
+// made. Otherwise, done must be called with the outcome of the request, or
+// release if the outcome is not counted.
+func (cb *circuitBreaker) allow(now time.Time) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	state := cb.state(now)
+	if state == CircuitBreakerOpen || cb.probing {
+		// Only one probe is made at a time while half-open
+		promHTTPCircuitBreakerRejections.WithLabelValues(cb.host).Inc()
+		return errors.Wrapf(ErrCircuitBreakerOpen, "%d consecutive requests to %s failed", cb.failures, cb.host)
+	}
+	if state == CircuitBreakerHalfOpen {
+		cb.probing = true
+	}
+	return nil
+}
+
+// done records the outcome of a request. The breaker opens after threshold
+// consecutive failures, for timeout.
+func (cb *circuitBreaker) done(success bool, now time.Time) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.probing = false
+	if success {
+		cb.failures = 0
+		cb.openUntil = time.Time{}
+	} else {
+		cb.failures++
+		if cb.failures >= cb.threshold {
+			cb.openUntil = now.Add(cb.timeout)
+		}
+	}
+
+	var value float64
+	switch cb.state(now) {
+	case CircuitBreakerHalfOpen:
+		value = 1
+	case CircuitBreakerOpen:
+		value = 2
+	}
+	promHTTPCircuitBreakerState.WithLabelValues(cb.host).Set(value)
+}
+
+// release ends a request whose outcome is not counted, without changing the
+// state of the breaker. A probe made while half-open may be made again.
+func (cb *circuitBreaker) release() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.probing = false
+}
+
+// isHTTPOutcomeCounted returns whether the outcome of a request is counted by
+// the circuit breaker of the host. It is not when the run was canceled, or
+// when the request was never made as the IP of the host is disallowed.
+func isHTTPOutcomeCounted(ctx context.Context, err error) bool {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return false
+	}
+	return err == nil || !errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP)
+}
+
+// isHTTPFailure returns whether the outcome of a request counts as a failure
+// of the host for its circuit breaker: a transport error, including a timeout,
+// a server error or a rate limited request.
+func isHTTPFailure(statusCode int, err error) bool {
+	return err != nil || statusCode >= 500 || statusCode == http.StatusTooManyRequests
+}
+
+// bridgeLimiterRegistry holds the limiters of the bridges which the bridge
+// tasks of a runner make requests to. A nil *bridgeLimiterRegistry does not
+// limit requests.
+type bridgeLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[string]*bridgeLimiter
+}
+
+func newBridgeLimiters() *bridgeLimiterRegistry {
+	return &bridgeLimiterRegistry{limiters: make(map[string]*bridgeLimiter)}
+}
+
+// get returns the limiter of the bridge, updating its limits if the limits
+// of the bridge changed. The limiter is kept when the limits change, so that
+// the requests in flight still count towards the new limits.
+func (r *bridgeLimiterRegistry) get(name string, maxConcurrency uint32, rateLimit float64) *bridgeLimiter {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, exists := r.limiters[name]
+	if !exists {
+		l = newBridgeLimiter(name, maxConcurrency, rateLimit)
+		r.limiters[name] = l
+	} else {
+		l.setLimits(maxConcurrency, rateLimit)
+	}
+	return l
+}
+
+// bridgeLimiter limits the number of concurrent requests to a bridge, and
+// spaces out requests to at most rateLimit per second.
+type bridgeLimiter struct {
+	name string
+
+	mu             sync.Mutex
+	maxConcurrency uint32        // 0 if the concurrency is unlimited
+	interval       time.Duration // 0 if the rate is unlimited
+	inFlight       uint32
+	// released is closed, and replaced, when a request finishes or the
+	// limits change
+	released chan struct{}
+	next     time.Time
+}
+
+func newBridgeLimiter(name string, maxConcurrency uint32, rateLimit float64) *bridgeLimiter {
+	l := &bridgeLimiter{name: name, released: make(chan struct{})}
+	l.setLimits(maxConcurrency, rateLimit)
+	return l
+}
+
+func (l *bridgeLimiter) setLimits(maxConcurrency uint32, rateLimit float64) {
+	var interval time.Duration
+	if rateLimit > 0 {
+		interval = time.Duration(float64(time.Second) / rateLimit)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxConcurrency == maxConcurrency && l.interval == interval {
+		return
+	}
+	l.maxConcurrency = maxConcurrency
+	l.interval = interval
+	l.notify()
+}
+
+// notify wakes the requests waiting for a concurrent request to finish. It
+// must be called with mu held.
+func (l *bridgeLimiter) notify() {
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// acquire waits until a request to the bridge may be made, or until ctx is
+// done. The returned func must be called once the request is finished.
+func (l *bridgeLimiter) acquire(ctx context.Context) (release func(), err error) {
+	l.mu.Lock()
+	for l.maxConcurrency > 0 && l.inFlight >= l.maxConcurrency {
+		released := l.released
+		l.mu.Unlock()
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "timed out waiting for a concurrent request to bridge %s to finish", l.name)
+		}
+		l.mu.Lock()
+	}
+	l.inFlight++
+	var wait time.Duration
+	if l.interval > 0 {
+		now := time.Now()
+		at := l.next
+		if at.Before(now) {
+			at = now
+		}
+		l.next = at.Add(l.interval)
+		wait = at.Sub(now)
+	}
+	l.mu.Unlock()
+
+	release = func() {
+		l.mu.Lock()
+		l.inFlight--
+		l.notify()
+		l.mu.Unlock()
+		promBridgeRequestsInFlight.WithLabelValues(l.name).Dec()
+	}
+	promBridgeRequestsInFlight.WithLabelValues(l.name).Inc()
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			release()
+			return nil, errors.Wrapf(ctx.Err(), "timed out waiting for the rate limit of bridge %s", l.name)
+		}
+	}
+	return release, nil
+}
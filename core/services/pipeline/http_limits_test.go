@@ -0,0 +1,195 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cb := &circuitBreaker{host: "example.com", threshold: 3, timeout: time.Minute}
+	now := time.Now()
+	timeout := time.Minute
+
+	require.NoError(t, cb.allow(now))
+	cb.done(false, now)
+	require.NoError(t, cb.allow(now))
+	cb.done(true, now)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, CircuitBreakerClosed, cb.getState(now))
+		require.NoError(t, cb.allow(now))
+		cb.done(false, now)
+	}
+	assert.Equal(t, CircuitBreakerOpen, cb.getState(now))
+	err := cb.allow(now)
+	require.True(t, errors.Is(err, ErrCircuitBreakerOpen))
+	assert.EqualError(t, err, "3 consecutive requests to example.com failed: circuit breaker open")
+
+	// Only one probe at a time
+	now = now.Add(timeout)
+	assert.Equal(t, CircuitBreakerHalfOpen, cb.getState(now))
+	require.NoError(t, cb.allow(now))
+	assert.Equal(t, CircuitBreakerHalfOpen, cb.getState(now))
+	require.True(t, errors.Is(cb.allow(now), ErrCircuitBreakerOpen))
+
+	cb.done(false, now)
+	assert.Equal(t, CircuitBreakerOpen, cb.getState(now))
+
+	// A probe whose outcome is not counted leaves the breaker half-open
+	now = now.Add(timeout)
+	require.NoError(t, cb.allow(now))
+	cb.release()
+	assert.Equal(t, CircuitBreakerHalfOpen, cb.getState(now))
+	require.NoError(t, cb.allow(now))
+	cb.done(false, now)
+	assert.Equal(t, CircuitBreakerOpen, cb.getState(now))
+
+	now = now.Add(timeout)
+	require.NoError(t, cb.allow(now))
+	cb.done(true, now)
+	assert.Equal(t, CircuitBreakerClosed, cb.getState(now))
+	require.NoError(t, cb.allow(now))
+}
+
+func TestHostCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, NewHostCircuitBreakers(0, time.Minute).get("example.com"))
+		var cbs *HostCircuitBreakers
+		assert.Nil(t, cbs.get("example.com"))
+		assert.Equal(t, CircuitBreakerClosed, cbs.State("example.com"))
+	})
+
+	t.Run("per host", func(t *testing.T) {
+		cbs := NewHostCircuitBreakers(1, time.Minute)
+		cb := cbs.get("example.com")
+		assert.Same(t, cb, cbs.get("example.com"))
+		require.NoError(t, cb.allow(time.Now()))
+		cb.done(false, time.Now())
+		assert.Equal(t, CircuitBreakerOpen, cbs.State("example.com"))
+		assert.Equal(t, CircuitBreakerClosed, cbs.State("example.org"))
+	})
+}
+
+func TestIsHTTPFailure(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, isHTTPFailure(http.StatusOK, nil))
+	assert.False(t, isHTTPFailure(http.StatusNotFound, nil))
+	assert.True(t, isHTTPFailure(http.StatusTooManyRequests, nil))
+	assert.True(t, isHTTPFailure(http.StatusBadGateway, nil))
+	assert.True(t, isHTTPFailure(0, errors.New("connection refused")))
+	assert.True(t, isHTTPFailure(0, errors.Wrap(context.DeadlineExceeded, "Get")))
+}
+
+func TestIsHTTPOutcomeCounted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assert.True(t, isHTTPOutcomeCounted(ctx, nil))
+	assert.True(t, isHTTPOutcomeCounted(ctx, errors.New("connection refused")))
+	assert.False(t, isHTTPOutcomeCounted(ctx, errors.Wrap(clhttp.ErrDisallowedIP, "dial")))
+
+	// A request timed out by its own context is counted
+	timedOut, cancel := context.WithTimeout(ctx, 0)
+	defer cancel()
+	assert.True(t, isHTTPOutcomeCounted(timedOut, errors.Wrap(context.DeadlineExceeded, "Get")))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, isHTTPOutcomeCounted(canceled, errors.New("connection reset")))
+}
+
+func TestBridgeLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newBridgeLimiters().get("bridge", 0, 0)
+		for i := 0; i < 3; i++ {
+			_, err := l.acquire(context.Background())
+			require.NoError(t, err)
+		}
+		var limiters *bridgeLimiterRegistry
+		assert.Nil(t, limiters.get("bridge", 1, 0))
+	})
+
+	t.Run("keeps the requests in flight when the limits change", func(t *testing.T) {
+		limiters := newBridgeLimiters()
+		l := limiters.get("bridge", 2, 0)
+		release, err := l.acquire(context.Background())
+		require.NoError(t, err)
+
+		assert.Same(t, l, limiters.get("bridge", 1, 0))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx)
+		require.Error(t, err)
+
+		// A waiting request is let through once the limit is raised
+		acquired := make(chan struct{})
+		go func() {
+			release2, err2 := l.acquire(context.Background())
+			assert.NoError(t, err2)
+			release2()
+			close(acquired)
+		}()
+		assert.Same(t, l, limiters.get("bridge", 0, 0))
+		select {
+		case <-acquired:
+		case <-time.After(time.Second):
+			t.Fatal("request was not let through")
+		}
+		release()
+	})
+
+	t.Run("max concurrency", func(t *testing.T) {
+		l := newBridgeLimiter("bridge", 2, 0)
+
+		release1, err := l.acquire(context.Background())
+		require.NoError(t, err)
+		release2, err := l.acquire(context.Background())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out waiting for a concurrent request to bridge bridge to finish")
+
+		release1()
+		release3, err := l.acquire(context.Background())
+		require.NoError(t, err)
+		release2()
+		release3()
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		l := newBridgeLimiter("bridge", 0, 20)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			release, err := l.acquire(context.Background())
+			require.NoError(t, err)
+			release()
+		}
+		// The first request is made immediately, the next ones 50ms apart
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := l.acquire(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out waiting for the rate limit of bridge bridge")
+	})
+}
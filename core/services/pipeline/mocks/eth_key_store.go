@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
+	apitypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -43,3 +44,72 @@ func (_m *ETHKeyStore) GetRoundRobinAddress(chainID *big.Int, addrs ...common.Ad
 
 	return r0, r1
 }
+
+// SignMessage provides a mock function with given fields: address, chainID, message
+func (_m *ETHKeyStore) SignMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	ret := _m.Called(address, chainID, message)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, []byte) []byte); ok {
+		r0 = rf(address, chainID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, []byte) error); ok {
+		r1 = rf(address, chainID, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignRawMessage provides a mock function with given fields: address, chainID, message
+func (_m *ETHKeyStore) SignRawMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error) {
+	ret := _m.Called(address, chainID, message)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, []byte) []byte); ok {
+		r0 = rf(address, chainID, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, []byte) error); ok {
+		r1 = rf(address, chainID, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignTypedData provides a mock function with given fields: address, chainID, typedData
+func (_m *ETHKeyStore) SignTypedData(address common.Address, chainID *big.Int, typedData apitypes.TypedData) ([]byte, error) {
+	ret := _m.Called(address, chainID, typedData)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, apitypes.TypedData) []byte); ok {
+		r0 = rf(address, chainID, typedData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int, apitypes.TypedData) error); ok {
+		r1 = rf(address, chainID, typedData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
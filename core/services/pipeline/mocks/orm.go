@@ -43,27 +43,27 @@ func (_m *ORM) CreateRun(run *pipeline.Run, qopts ...pg.QOpt) error {
 	return r0
 }
 
-// CreateSpec provides a mock function with given fields: _a0, maxTaskTimeout, qopts
-func (_m *ORM) CreateSpec(_a0 pipeline.Pipeline, maxTaskTimeout models.Interval, qopts ...pg.QOpt) (int32, error) {
+// CreateSpec provides a mock function with given fields: _a0, maxTaskTimeout, allowedSecrets, qopts
+func (_m *ORM) CreateSpec(_a0 pipeline.Pipeline, maxTaskTimeout models.Interval, allowedSecrets []string, qopts ...pg.QOpt) (int32, error) {
 	_va := make([]interface{}, len(qopts))
 	for _i := range qopts {
 		_va[_i] = qopts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, _a0, maxTaskTimeout)
+	_ca = append(_ca, _a0, maxTaskTimeout, allowedSecrets)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 int32
-	if rf, ok := ret.Get(0).(func(pipeline.Pipeline, models.Interval, ...pg.QOpt) int32); ok {
-		r0 = rf(_a0, maxTaskTimeout, qopts...)
+	if rf, ok := ret.Get(0).(func(pipeline.Pipeline, models.Interval, []string, ...pg.QOpt) int32); ok {
+		r0 = rf(_a0, maxTaskTimeout, allowedSecrets, qopts...)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(pipeline.Pipeline, models.Interval, ...pg.QOpt) error); ok {
-		r1 = rf(_a0, maxTaskTimeout, qopts...)
+	if rf, ok := ret.Get(1).(func(pipeline.Pipeline, models.Interval, []string, ...pg.QOpt) error); ok {
+		r1 = rf(_a0, maxTaskTimeout, allowedSecrets, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,6 +71,27 @@ func (_m *ORM) CreateSpec(_a0 pipeline.Pipeline, maxTaskTimeout models.Interval,
 	return r0, r1
 }
 
+// CreateTemplate provides a mock function with given fields: template, qopts
+func (_m *ORM) CreateTemplate(template *pipeline.Template, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, template)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*pipeline.Template, ...pg.QOpt) error); ok {
+		r0 = rf(template, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRun provides a mock function with given fields: id
 func (_m *ORM) DeleteRun(id int64) error {
 	ret := _m.Called(id)
@@ -99,6 +120,27 @@ func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error
 	return r0
 }
 
+// DeleteTemplate provides a mock function with given fields: name, qopts
+func (_m *ORM) DeleteTemplate(name string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) error); ok {
+		r0 = rf(name, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -120,6 +162,93 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
+// FindTemplate provides a mock function with given fields: name, version, qopts
+func (_m *ORM) FindTemplate(name string, version int32, qopts ...pg.QOpt) (pipeline.Template, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 pipeline.Template
+	if rf, ok := ret.Get(0).(func(string, int32, ...pg.QOpt) pipeline.Template); ok {
+		r0 = rf(name, version, qopts...)
+	} else {
+		r0 = ret.Get(0).(pipeline.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int32, ...pg.QOpt) error); ok {
+		r1 = rf(name, version, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplateVersions provides a mock function with given fields: name, qopts
+func (_m *ORM) FindTemplateVersions(name string, qopts ...pg.QOpt) ([]pipeline.Template, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.Template
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) []pipeline.Template); ok {
+		r0 = rf(name, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplates provides a mock function with given fields: qopts
+func (_m *ORM) FindTemplates(qopts ...pg.QOpt) ([]pipeline.Template, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.Template
+	if rf, ok := ret.Get(0).(func(...pg.QOpt) []pipeline.Template); ok {
+		r0 = rf(qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...pg.QOpt) error); ok {
+		r1 = rf(qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllRuns provides a mock function with given fields:
 func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	ret := _m.Called()
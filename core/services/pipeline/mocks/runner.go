@@ -167,6 +167,27 @@ func (_m *Runner) Ready() error {
 	return r0
 }
 
+// ReplayRun provides a mock function with given fields: ctx, run, l
+func (_m *Runner) ReplayRun(ctx context.Context, run pipeline.Run, l logger.Logger) (pipeline.ReplayResult, error) {
+	ret := _m.Called(ctx, run, l)
+
+	var r0 pipeline.ReplayResult
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, logger.Logger) pipeline.ReplayResult); ok {
+		r0 = rf(ctx, run, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.ReplayResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run, logger.Logger) error); ok {
+		r1 = rf(ctx, run, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeRun provides a mock function with given fields: taskID, value, err
 func (_m *Runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(taskID, value, err)
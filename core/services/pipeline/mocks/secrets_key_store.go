@@ -0,0 +1,45 @@
+// Code generated by mockery v2.12.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	testing "testing"
+)
+
+// SecretsKeyStore is an autogenerated mock type for the SecretsKeyStore type
+type SecretsKeyStore struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: name
+func (_m *SecretsKeyStore) Get(name string) (string, error) {
+	ret := _m.Called(name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSecretsKeyStore creates a new instance of SecretsKeyStore. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSecretsKeyStore(t testing.TB) *SecretsKeyStore {
+	mock := &SecretsKeyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
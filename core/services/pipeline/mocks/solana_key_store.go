@@ -0,0 +1,47 @@
+// Code generated by mockery v2.12.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	testing "testing"
+
+	solkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
+)
+
+// SolanaKeyStore is an autogenerated mock type for the SolanaKeyStore type
+type SolanaKeyStore struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: id
+func (_m *SolanaKeyStore) Get(id string) (solkey.Key, error) {
+	ret := _m.Called(id)
+
+	var r0 solkey.Key
+	if rf, ok := ret.Get(0).(func(string) solkey.Key); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(solkey.Key)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSolanaKeyStore creates a new instance of SolanaKeyStore. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSolanaKeyStore(t testing.TB) *SolanaKeyStore {
+	mock := &SolanaKeyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
@@ -23,6 +24,9 @@ type Spec struct {
 	DotDagSource    string          `json:"dotDagSource"`
 	CreatedAt       time.Time       `json:"-"`
 	MaxTaskDuration models.Interval `json:"-"`
+	// AllowedSecrets are the names of the node secrets which the pipeline
+	// may refer to.
+	AllowedSecrets pq.StringArray `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
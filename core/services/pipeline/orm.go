@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
@@ -17,7 +18,7 @@ import (
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	CreateSpec(pipeline Pipeline, maxTaskTimeout models.Interval, qopts ...pg.QOpt) (int32, error)
+	CreateSpec(pipeline Pipeline, maxTaskTimeout models.Interval, allowedSecrets []string, qopts ...pg.QOpt) (int32, error)
 	CreateRun(run *Run, qopts ...pg.QOpt) (err error)
 	InsertRun(run *Run, qopts ...pg.QOpt) error
 	DeleteRun(id int64) error
@@ -33,6 +34,22 @@ type ORM interface {
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
+
+	// CreateTemplate inserts the template as the next version of the
+	// template with its name, setting its ID, Version and CreatedAt.
+	CreateTemplate(template *Template, qopts ...pg.QOpt) error
+	// FindTemplate returns the given version of the named template, or its
+	// latest version if version is 0.
+	FindTemplate(name string, version int32, qopts ...pg.QOpt) (Template, error)
+	// FindTemplates returns the latest version of every template.
+	FindTemplates(qopts ...pg.QOpt) ([]Template, error)
+	// FindTemplateVersions returns every version of the named template,
+	// latest first.
+	FindTemplateVersions(name string, qopts ...pg.QOpt) ([]Template, error)
+	// DeleteTemplate deletes every version of the named template. It fails
+	// with ErrTemplateInUse while the pipeline of a job invokes the template.
+	DeleteTemplate(name string, qopts ...pg.QOpt) error
+
 	GetQ() pg.Q
 }
 
@@ -47,12 +64,12 @@ func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *orm {
 	return &orm{pg.NewQ(db, lggr, cfg), lggr}
 }
 
-func (o *orm) CreateSpec(pipeline Pipeline, maxTaskDuration models.Interval, qopts ...pg.QOpt) (id int32, err error) {
+func (o *orm) CreateSpec(pipeline Pipeline, maxTaskDuration models.Interval, allowedSecrets []string, qopts ...pg.QOpt) (id int32, err error) {
 	q := o.q.WithOpts(qopts...)
-	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
-	VALUES ($1, $2, NOW())
+	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, allowed_secrets, created_at)
+	VALUES ($1, $2, $3, NOW())
 	RETURNING id;`
-	err = q.Get(&id, sql, pipeline.Source, maxTaskDuration)
+	err = q.Get(&id, sql, pipeline.Source, maxTaskDuration, pq.StringArray(allowedSecrets))
 	return id, errors.WithStack(err)
 }
 
@@ -465,6 +482,162 @@ func loadAssociations(q pg.Queryer, runs []Run) error {
 	return nil
 }
 
+func (o *orm) CreateTemplate(template *Template, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO pipeline_templates (name, version, description, parameters, dot_dag_source, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, $4, NOW() FROM pipeline_templates WHERE name = $1
+	RETURNING id, version, created_at;`
+	err := q.Get(template, sql, template.Name, template.Description, template.Parameters, template.DotDagSource)
+	return errors.Wrap(err, "CreateTemplate failed")
+}
+
+func (o *orm) FindTemplate(name string, version int32, qopts ...pg.QOpt) (template Template, err error) {
+	q := o.q.WithOpts(qopts...)
+	if version == 0 {
+		err = q.Get(&template, `SELECT * FROM pipeline_templates WHERE name = $1 ORDER BY version DESC LIMIT 1`, name)
+	} else {
+		err = q.Get(&template, `SELECT * FROM pipeline_templates WHERE name = $1 AND version = $2`, name, version)
+	}
+	return template, errors.Wrap(err, "FindTemplate failed")
+}
+
+func (o *orm) FindTemplates(qopts ...pg.QOpt) (templates []Template, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&templates, `SELECT DISTINCT ON (name) * FROM pipeline_templates ORDER BY name ASC, version DESC`)
+	return templates, errors.Wrap(err, "FindTemplates failed")
+}
+
+func (o *orm) FindTemplateVersions(name string, qopts ...pg.QOpt) (templates []Template, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&templates, `SELECT * FROM pipeline_templates WHERE name = $1 ORDER BY version DESC`, name)
+	return templates, errors.Wrap(err, "FindTemplateVersions failed")
+}
+
+func (o *orm) DeleteTemplate(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		jobIDs, err := o.findJobIDsWithTemplate(tx, name)
+		if err != nil {
+			return err
+		}
+		if len(jobIDs) > 0 {
+			return errors.Wrapf(ErrTemplateInUse, "jobs %v", jobIDs)
+		}
+
+		result, err := tx.Exec(`DELETE FROM pipeline_templates WHERE name = $1`, name)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	return errors.Wrap(err, "DeleteTemplate failed")
+}
+
+// findJobIDsWithTemplate returns the IDs of the jobs whose pipeline invokes
+// the named template, either directly or through foreach tasks and other
+// templates. Pipelines which cannot be parsed are skipped.
+func (o *orm) findJobIDsWithTemplate(tx pg.Queryer, name string) (jobIDs []int32, err error) {
+	var templates []Template
+	err = tx.Select(&templates, `SELECT name, version, dot_dag_source FROM pipeline_templates
+		WHERE dot_dag_source ILIKE '%subpipeline%'`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load templates")
+	}
+	invokedByTemplate := make(map[string]map[string]struct{})
+	for _, template := range templates {
+		invoked, err := invokedTemplates(template.DotDagSource)
+		if err != nil {
+			o.lggr.Warnw("Skipping template whose pipeline cannot be parsed", "template", template.Name, "version", template.Version, "err", err)
+			continue
+		}
+		if invokedByTemplate[template.Name] == nil {
+			invokedByTemplate[template.Name] = make(map[string]struct{})
+		}
+		for t := range invoked {
+			invokedByTemplate[template.Name][t] = struct{}{}
+		}
+	}
+
+	// Find every template which invokes the named template, however deeply
+	uses := map[string]struct{}{name: {}}
+	for found := true; found; {
+		found = false
+		for template, invoked := range invokedByTemplate {
+			if _, exists := uses[template]; !exists && invokesAny(invoked, uses) {
+				uses[template] = struct{}{}
+				found = true
+			}
+		}
+	}
+
+	var rows []struct {
+		ID           int32
+		DotDagSource string `db:"dot_dag_source"`
+	}
+	err = tx.Select(&rows, `SELECT jobs.id, pipeline_specs.dot_dag_source FROM jobs
+		JOIN pipeline_specs ON pipeline_specs.id = jobs.pipeline_spec_id
+		WHERE pipeline_specs.dot_dag_source ILIKE '%subpipeline%' ORDER BY jobs.id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load job pipelines")
+	}
+	for _, row := range rows {
+		invoked, err := invokedTemplates(row.DotDagSource)
+		if err != nil {
+			o.lggr.Warnw("Skipping job whose pipeline cannot be parsed", "jobID", row.ID, "err", err)
+			continue
+		}
+		if invokesAny(invoked, uses) {
+			jobIDs = append(jobIDs, row.ID)
+		}
+	}
+	return jobIDs, nil
+}
+
+// invokedTemplates returns the names of the templates which the subpipeline
+// tasks of the pipeline invoke, including those in the pipelines of foreach
+// tasks.
+func invokedTemplates(source string) (map[string]struct{}, error) {
+	p, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{})
+	for _, task := range p.Tasks {
+		switch t := task.(type) {
+		case *SubpipelineTask:
+			names[t.Template] = struct{}{}
+		case *ForEachTask:
+			nested, err := invokedTemplates(t.Pipeline)
+			if err != nil {
+				return nil, errors.Wrapf(err, "task %s", t.DotID())
+			}
+			for name := range nested {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	return names, nil
+}
+
+func invokesAny(invoked, names map[string]struct{}) bool {
+	for name := range invoked {
+		if _, exists := names[name]; exists {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *orm) GetQ() pg.Q {
 	return o.q
 }
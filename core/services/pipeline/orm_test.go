@@ -2,9 +2,12 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -30,13 +33,14 @@ func Test_PipelineORM_CreateSpec(t *testing.T) {
 		Source: source,
 	}
 
-	id, err := orm.CreateSpec(p, maxTaskDuration)
+	id, err := orm.CreateSpec(p, maxTaskDuration, []string{"apiKey"})
 	require.NoError(t, err)
 
 	actual := pipeline.Spec{}
 	err = db.Get(&actual, "SELECT * FROM pipeline_specs WHERE pipeline_specs.id = $1", id)
 	require.NoError(t, err)
 	assert.Equal(t, source, actual.DotDagSource)
+	assert.Equal(t, pq.StringArray{"apiKey"}, actual.AllowedSecrets)
 	assert.Equal(t, maxTaskDuration, actual.MaxTaskDuration)
 }
 
@@ -96,7 +100,7 @@ answer2 [type=bridge name=election_winner index=1];
 	require.NotNil(t, p)
 
 	maxTaskDuration := models.Interval(1 * time.Minute)
-	specID, err := orm.CreateSpec(*p, maxTaskDuration)
+	specID, err := orm.CreateSpec(*p, maxTaskDuration, nil)
 	require.NoError(t, err)
 
 	run := &pipeline.Run{
@@ -448,3 +452,94 @@ func Test_PipelineORM_DeleteRunsOlderThan(t *testing.T) {
 		require.Error(t, err, "not found")
 	}
 }
+
+func Test_PipelineORM_Templates(t *testing.T) {
+	_, orm := setupORM(t)
+
+	v1 := pipeline.Template{Name: "fetch", Parameters: []string{"symbol"}, DotDagSource: `ds [type=memo value="$(symbol)"]`}
+	require.NoError(t, orm.CreateTemplate(&v1))
+	assert.Equal(t, int32(1), v1.Version)
+	assert.NotZero(t, v1.ID)
+	assert.False(t, v1.CreatedAt.IsZero())
+
+	v2 := pipeline.Template{Name: "fetch", Description: "v2", Parameters: []string{"symbol"}, DotDagSource: `ds [type=memo value="$(symbol)"]`}
+	require.NoError(t, orm.CreateTemplate(&v2))
+	assert.Equal(t, int32(2), v2.Version)
+
+	other := pipeline.Template{Name: "other", Parameters: []string{}, DotDagSource: `ds [type=memo value=1]`}
+	require.NoError(t, orm.CreateTemplate(&other))
+	assert.Equal(t, int32(1), other.Version)
+
+	t.Run("finds the latest version", func(t *testing.T) {
+		template, err := orm.FindTemplate("fetch", 0)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), template.Version)
+		assert.Equal(t, "v2", template.Description)
+		assert.Equal(t, []string{"symbol"}, []string(template.Parameters))
+	})
+
+	t.Run("finds a version", func(t *testing.T) {
+		template, err := orm.FindTemplate("fetch", 1)
+		require.NoError(t, err)
+		assert.Equal(t, v1.ID, template.ID)
+
+		_, err = orm.FindTemplate("fetch", 3)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("lists templates and versions", func(t *testing.T) {
+		templates, err := orm.FindTemplates()
+		require.NoError(t, err)
+		require.Len(t, templates, 2)
+		assert.Equal(t, "fetch", templates[0].Name)
+		assert.Equal(t, int32(2), templates[0].Version)
+		assert.Equal(t, "other", templates[1].Name)
+
+		versions, err := orm.FindTemplateVersions("fetch")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, int32(2), versions[0].Version)
+		assert.Equal(t, int32(1), versions[1].Version)
+	})
+
+	t.Run("deletes every version", func(t *testing.T) {
+		require.NoError(t, orm.DeleteTemplate("fetch"))
+		_, err := orm.FindTemplate("fetch", 0)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.ErrorIs(t, orm.DeleteTemplate("fetch"), sql.ErrNoRows)
+	})
+}
+
+func Test_PipelineORM_DeleteTemplate_InUse(t *testing.T) {
+	db, orm := setupORM(t)
+
+	for _, template := range []pipeline.Template{
+		{Name: "fetch", Parameters: []string{}, DotDagSource: `ds [type=memo value=1]`},
+		{Name: "wrap", Parameters: []string{}, DotDagSource: `sub [type=subpipeline template=fetch]`},
+		{Name: "unused", Parameters: []string{}, DotDagSource: `ds [type=memo value=2]`},
+	} {
+		template := template
+		require.NoError(t, orm.CreateTemplate(&template))
+	}
+
+	mustInsertJobWithPipeline := func(source string) int32 {
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		_, err := db.Exec(`UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`, source, jb.PipelineSpecID)
+		require.NoError(t, err)
+		return jb.ID
+	}
+	// Invokes fetch through a foreach task and the wrap template
+	jobID := mustInsertJobWithPipeline(`each [type=foreach values=<[ 1, 2 ]> pipeline="sub [type=subpipeline template=wrap]"]`)
+	// Cannot be parsed, so is skipped
+	mustInsertJobWithPipeline(`sub [type=subpipeline template=fetch`)
+
+	err := orm.DeleteTemplate("fetch")
+	require.ErrorIs(t, err, pipeline.ErrTemplateInUse)
+	assert.Contains(t, err.Error(), fmt.Sprintf("jobs [%d]", jobID))
+
+	err = orm.DeleteTemplate("wrap")
+	require.ErrorIs(t, err, pipeline.ErrTemplateInUse)
+
+	require.NoError(t, orm.DeleteTemplate("unused"))
+}
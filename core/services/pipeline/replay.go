@@ -0,0 +1,230 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ErrNoRecordedOutput is returned when replaying a run which has no recorded
+// output for some of its tasks whose output depends on the outside world.
+// This is the case for runs of jobs which don't save successful task runs.
+var ErrNoRecordedOutput = errors.New("no recorded output")
+
+// recomputedTaskTypes are the types of the tasks whose output only depends on
+// their inputs and the vars of the run. They are run again when replaying a
+// run, while all other tasks return their recorded output.
+var recomputedTaskTypes = map[TaskType]bool{
+	TaskTypeMean:            true,
+	TaskTypeMedian:          true,
+	TaskTypeMode:            true,
+	TaskTypeSum:             true,
+	TaskTypeMultiply:        true,
+	TaskTypeDivide:          true,
+	TaskTypeJSONParse:       true,
+	TaskTypeCBORParse:       true,
+	TaskTypeETHABIEncode:    true,
+	TaskTypeETHABIEncode2:   true,
+	TaskTypeETHABIDecode:    true,
+	TaskTypeETHABIDecodeLog: true,
+	TaskTypeMerge:           true,
+	TaskTypeLowercase:       true,
+	TaskTypeUppercase:       true,
+	TaskTypeExpr:            true,
+	TaskTypeSolanaEncode:    true,
+	TaskTypeTerraEncode:     true,
+	TaskTypeMemo:            true,
+	TaskTypeFail:            true,
+	TaskTypePanic:           true,
+}
+
+// ReplayResult is the outcome of replaying a run.
+type ReplayResult struct {
+	// Run is the replayed run. It is never saved.
+	Run Run
+	// Divergences are the task runs of the replayed run whose output or error
+	// differs from the recorded run.
+	Divergences []TaskRunDivergence
+	// OutputsDiverged is true if the outputs or fatal errors of the replayed
+	// run differ from the recorded run.
+	OutputsDiverged bool
+}
+
+// Diverged returns true if the replayed run differs from the recorded run.
+func (rr ReplayResult) Diverged() bool {
+	return rr.OutputsDiverged || len(rr.Divergences) > 0
+}
+
+// TaskRunDivergence is a task whose replayed output or error differs from the
+// recorded one.
+type TaskRunDivergence struct {
+	DotID          string
+	Type           TaskType
+	RecordedOutput JSONSerializable
+	RecordedError  null.String
+	ReplayedOutput JSONSerializable
+	ReplayedError  null.String
+}
+
+// recordedTask returns the recorded result of the wrapped task instead of
+// running it.
+type recordedTask struct {
+	Task
+	taskRun *TaskRun
+}
+
+func (t *recordedTask) Run(context.Context, logger.Logger, Vars, []Result) (Result, RunInfo) {
+	var result Result
+	if t.taskRun.Error.Valid {
+		result.Error = errors.New(t.taskRun.Error.String)
+	}
+	if t.taskRun.Output.Valid {
+		result.Value = t.taskRun.Output.Val
+	}
+	return result, RunInfo{}
+}
+
+// TaskRetries returns 0 since retrying a recorded error returns it again.
+func (t *recordedTask) TaskRetries() uint32 {
+	return 0
+}
+
+// ReplayRun executes a finished run again in memory, from its spec and inputs.
+// Tasks whose output depends on the outside world, such as http, bridge and
+// ethcall tasks, return their recorded output or error instead of being run,
+// while the other tasks are computed again.
+func (r *runner) ReplayRun(ctx context.Context, run Run, l logger.Logger) (ReplayResult, error) {
+	if !run.FinishedAt.Valid {
+		return ReplayResult{}, errors.Errorf("run %d is not finished", run.ID)
+	}
+	inputs := map[string]interface{}{}
+	if run.Inputs.Valid {
+		var is bool
+		inputs, is = run.Inputs.Val.(map[string]interface{})
+		if !is {
+			return ReplayResult{}, errors.Errorf("run %d has inputs of unexpected type %T", run.ID, run.Inputs.Val)
+		}
+	}
+	vars := NewVarsFrom(inputs)
+
+	replay := NewRun(run.PipelineSpec, vars)
+	pipeline, err := r.initializePipeline(&replay)
+	if err != nil {
+		return ReplayResult{}, err
+	}
+
+	recorded := make(map[string]*TaskRun)
+	for i, taskRun := range run.PipelineTaskRuns {
+		// task runs of nested pipelines are replayed as part of their foreach
+		// or subpipeline task, whose output is recorded
+		if isNestedTaskRunDotID(taskRun.DotID) || taskRun.IsPending() {
+			continue
+		}
+		recorded[taskRun.DotID] = &run.PipelineTaskRuns[i]
+	}
+	var missing []string
+	for id, task := range pipeline.Tasks {
+		if recomputedTaskTypes[task.Type()] {
+			continue
+		}
+		taskRun, exists := recorded[task.DotID()]
+		if !exists {
+			missing = append(missing, task.DotID())
+			continue
+		}
+		pipeline.Tasks[id] = &recordedTask{Task: task, taskRun: taskRun}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return ReplayResult{}, errors.Wrapf(ErrNoRecordedOutput, "run %d cannot be replayed, its task runs %s were not saved; set saveSuccessfulTaskRuns on the job to replay its runs", run.ID, strings.Join(missing, ", "))
+	}
+
+	l = l.With("replayedRunID", run.ID)
+	if _, err = r.run(ctx, pipeline, &replay, vars, l); err != nil {
+		return ReplayResult{}, errors.Wrapf(err, "failed to replay run %d", run.ID)
+	}
+
+	result := ReplayResult{Run: replay}
+	for _, taskRun := range replay.PipelineTaskRuns {
+		if isNestedTaskRunDotID(taskRun.DotID) {
+			continue
+		}
+		recordedTaskRun, exists := recorded[taskRun.DotID]
+		if !exists {
+			// Errors are always saved, so a task without a recorded run
+			// only diverged if it failed
+			if taskRun.Error.Valid {
+				result.Divergences = append(result.Divergences, TaskRunDivergence{
+					DotID:          taskRun.DotID,
+					Type:           taskRun.Type,
+					ReplayedOutput: taskRun.Output,
+					ReplayedError:  taskRun.Error,
+				})
+			}
+			continue
+		}
+		same, err := sameReplayValue(recordedTaskRun.Output, taskRun.Output)
+		if err != nil {
+			return ReplayResult{}, errors.Wrapf(err, "failed to compare the output of task %s", taskRun.DotID)
+		}
+		if !same || recordedTaskRun.Error != taskRun.Error {
+			result.Divergences = append(result.Divergences, TaskRunDivergence{
+				DotID:          taskRun.DotID,
+				Type:           taskRun.Type,
+				RecordedOutput: recordedTaskRun.Output,
+				RecordedError:  recordedTaskRun.Error,
+				ReplayedOutput: taskRun.Output,
+				ReplayedError:  taskRun.Error,
+			})
+		}
+	}
+
+	same, err := sameReplayValue(run.Outputs, replay.Outputs)
+	if err != nil {
+		return ReplayResult{}, errors.Wrap(err, "failed to compare the outputs of the run")
+	}
+	sameErrors, err := sameReplayValue(
+		JSONSerializable{Val: run.StringFatalErrors(), Valid: true},
+		JSONSerializable{Val: replay.StringFatalErrors(), Valid: true},
+	)
+	if err != nil {
+		return ReplayResult{}, errors.Wrap(err, "failed to compare the fatal errors of the run")
+	}
+	result.OutputsDiverged = !same || !sameErrors
+
+	return result, nil
+}
+
+// sameReplayValue compares a recorded value with a replayed one. Recorded
+// values were saved as JSON, so the replayed value is compared once it has
+// been through the same encoding.
+func sameReplayValue(recorded, replayed JSONSerializable) (bool, error) {
+	a, err := normalizedJSON(recorded)
+	if err != nil {
+		return false, err
+	}
+	b, err := normalizedJSON(replayed)
+	if err != nil {
+		return false, err
+	}
+	return a == b, nil
+}
+
+func normalizedJSON(js JSONSerializable) (string, error) {
+	bs, err := js.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	var val interface{}
+	if err = json.Unmarshal(bs, &val); err != nil {
+		return "", err
+	}
+	bs, err = json.Marshal(val)
+	return string(bs), err
+}
@@ -12,13 +12,21 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/recovery"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -46,6 +54,11 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
+	// ReplayRun executes a finished run again in memory, using the recorded
+	// outputs of the tasks which depend on the outside world, and reports how
+	// the replayed run differs from the recorded one.
+	ReplayRun(ctx context.Context, run Run, l logger.Logger) (ReplayResult, error)
+
 	OnRunFinished(func(*Run))
 }
 
@@ -53,12 +66,18 @@ type runner struct {
 	orm                    ORM
 	config                 Config
 	chainSet               evm.ChainSet
+	solanaChainSet         solana.ChainSet // nil if disabled
+	terraChainSet          terra.ChainSet  // nil if disabled
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
+	solanaKeyStore         SolanaKeyStore
+	secretsKeyStore        SecretsKeyStore
 	runReaperWorker        utils.SleeperTask
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *HostCircuitBreakers
+	bridgeLimiters         *bridgeLimiterRegistry
 
 	// test helper
 	runFinished func(*Run)
@@ -98,19 +117,28 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
+// tracerName is the name of the tracer of pipeline runs and their tasks
+const tracerName = "github.com/smartcontractkit/chainlink/core/services/pipeline"
+
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, solanaChainSet solana.ChainSet, terraChainSet terra.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, solanaks SolanaKeyStore, secretsks SecretsKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client, circuitBreakers *HostCircuitBreakers) *runner {
 	r := &runner{
 		orm:                    orm,
 		config:                 config,
 		chainSet:               chainSet,
+		solanaChainSet:         solanaChainSet,
+		terraChainSet:          terraChainSet,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
+		solanaKeyStore:         solanaks,
+		secretsKeyStore:        secretsks,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		circuitBreakers:        circuitBreakers,
+		bridgeLimiters:         newBridgeLimiters(),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -228,6 +256,25 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		return nil, err
 	}
 
+	r.initializeTasks(pipeline, run.PipelineSpec)
+
+	// retain old UUID values
+	for _, taskRun := range run.PipelineTaskRuns {
+		// task runs of nested pipelines have no task in the outer pipeline
+		if isNestedTaskRunDotID(taskRun.DotID) {
+			continue
+		}
+		task := pipeline.ByDotID(taskRun.DotID)
+		task.Base().uuid = taskRun.ID
+	}
+
+	return pipeline, nil
+}
+
+func (r *runner) initializeTasks(pipeline *Pipeline, spec Spec) {
+	// the calls of ethcall tasks are batched if more than one can run at once
+	batcher := newETHCallBatcher(pipeline.Tasks)
+
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.NewV4()
@@ -237,6 +284,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).circuitBreakers = r.circuitBreakers
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.GetQ()
@@ -244,9 +292,12 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.circuitBreakers
+			task.(*BridgeTask).bridgeLimiters = r.bridgeLimiters
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
+			task.(*ETHCallTask).batcher = batcher
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
 		case TaskTypeVRFV2:
@@ -256,17 +307,26 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
+		case TaskTypeETHSign:
+			task.(*ETHSignTask).keyStore = r.ethKeyStore
+			task.(*ETHSignTask).chainSet = r.chainSet
+		case TaskTypeETHSignTypedData:
+			task.(*ETHSignTypedDataTask).keyStore = r.ethKeyStore
+			task.(*ETHSignTypedDataTask).chainSet = r.chainSet
+		case TaskTypeSolanaTx:
+			task.(*SolanaTxTask).keyStore = r.solanaKeyStore
+			task.(*SolanaTxTask).chainSet = r.solanaChainSet
+		case TaskTypeTerraTx:
+			task.(*TerraTxTask).chainSet = r.terraChainSet
+		case TaskTypeForEach:
+			task.(*ForEachTask).runner = r
+			task.(*ForEachTask).spec = spec
+		case TaskTypeSubpipeline:
+			task.(*SubpipelineTask).runner = r
+			task.(*SubpipelineTask).spec = spec
 		default:
 		}
 	}
-
-	// retain old UUID values
-	for _, taskRun := range run.PipelineTaskRuns {
-		task := pipeline.ByDotID(taskRun.DotID)
-		task.Base().uuid = taskRun.ID
-	}
-
-	return pipeline, nil
 }
 
 func (r *runner) run(
@@ -279,6 +339,18 @@ func (r *runner) run(
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "pipeline run", trace.WithAttributes(
+		attribute.Int64("job.id", int64(run.PipelineSpec.JobID)),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+		attribute.Int64("pipeline.run.id", run.ID),
+	))
+	defer span.End()
+
+	// secrets are looked up when first used by a task, never stored on the
+	// run, and redacted from everything logged by its tasks
+	vars.secrets = newSecretsResolver(r.secretsKeyStore, run.PipelineSpec.AllowedSecrets)
+	l = newSecretsLogger(l, vars.secrets)
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -292,26 +364,7 @@ func (r *runner) run(
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.NewV4(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	r.executeTaskRuns(ctx, reportCtx, scheduler, run.PipelineSpec, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -327,6 +380,16 @@ func (r *runner) run(
 		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
 	}
 
+	// Task runs of nested pipelines are only recorded once the foreach or
+	// subpipeline task has finished, so any already present on a resumed run
+	// are kept as is
+	var nestedTaskRuns []TaskRun
+	for _, taskRun := range run.PipelineTaskRuns {
+		if isNestedTaskRunDotID(taskRun.DotID) {
+			nestedTaskRuns = append(nestedTaskRuns, taskRun)
+		}
+	}
+
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
@@ -348,6 +411,9 @@ func (r *runner) run(
 			return run.PipelineTaskRuns[i].task.OutputIndex() < run.PipelineTaskRuns[j].task.OutputIndex()
 		})
 	}
+	for i := range run.PipelineTaskRuns {
+		vars.secrets.redactTaskRun(&run.PipelineTaskRuns[i])
+	}
 
 	// Update run errors/outputs
 	if run.FinishedAt.Valid {
@@ -371,12 +437,24 @@ func (r *runner) run(
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
+			span.SetStatus(codes.Error, "run has fatal errors")
 			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
 		} else {
 			run.State = RunStatusCompleted
 		}
 	}
 
+	// Record the task runs of nested pipelines. This happens after the
+	// outputs have been computed, since their terminal tasks are not
+	// terminal tasks of the run.
+	run.PipelineTaskRuns = append(run.PipelineTaskRuns, nestedTaskRuns...)
+	for _, result := range scheduler.results {
+		for _, taskRun := range nestedTaskRunsOf(run.ID, result.Task) {
+			vars.secrets.redactTaskRun(&taskRun)
+			run.PipelineTaskRuns = append(run.PipelineTaskRuns, taskRun)
+		}
+	}
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
@@ -386,6 +464,104 @@ func (r *runner) run(
 	return taskRunResults, nil
 }
 
+// executeTaskRuns executes the task runs handed out by the scheduler until it
+// has no more work.
+func (r *runner) executeTaskRuns(ctx, reportCtx context.Context, scheduler *scheduler, spec Spec, l logger.Logger) {
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			logTaskRunToPrometheus(result, spec)
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.NewV4(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+}
+
+// executeNestedRun runs the pipeline of one iteration of a foreach task, or
+// the template of a subpipeline task, to completion and returns its task run
+// results.
+func (r *runner) executeNestedRun(ctx context.Context, spec Spec, source string, vars Vars, l logger.Logger) (TaskRunResults, error) {
+	pipeline, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	r.initializeTasks(pipeline, spec)
+
+	run := NewRun(spec, vars)
+	scheduler := newScheduler(pipeline, &run, vars, l)
+	go scheduler.Run()
+
+	reportCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r.executeTaskRuns(ctx, reportCtx, scheduler, spec, l)
+
+	if scheduler.pending {
+		return nil, errors.New("nested pipeline unexpectedly suspended")
+	}
+
+	var taskRunResults TaskRunResults
+	for _, result := range scheduler.results {
+		taskRunResults = append(taskRunResults, result)
+	}
+	sort.Slice(taskRunResults, func(i, j int) bool {
+		return taskRunResults[i].Task.ID() < taskRunResults[j].Task.ID()
+	})
+	return taskRunResults, nil
+}
+
+// nestedRunTask is a task which runs nested pipelines, such as a foreach or
+// subpipeline task.
+type nestedRunTask interface {
+	Task
+	Iterations() []TaskRunResults
+}
+
+// nestedTaskRunsOf returns the task runs of every nested pipeline run by the
+// given task, including those of any nested pipelines they run in turn.
+func nestedTaskRunsOf(runID int64, task Task) []TaskRun {
+	nested, is := task.(nestedRunTask)
+	if !is {
+		return nil
+	}
+
+	var taskRuns []TaskRun
+	for i, iteration := range nested.Iterations() {
+		for _, result := range iteration {
+			dotID := nestedTaskRunDotID(nested.DotID(), i, result.Task.DotID())
+			taskRuns = append(taskRuns, TaskRun{
+				ID:            result.ID,
+				PipelineRunID: runID,
+				Type:          result.Task.Type(),
+				Index:         result.Task.OutputIndex(),
+				Output:        result.Result.OutputDB(),
+				Error:         result.Result.ErrorDB(),
+				DotID:         dotID,
+				CreatedAt:     result.CreatedAt,
+				FinishedAt:    result.FinishedAt,
+				task:          result.Task,
+			})
+			for _, taskRun := range nestedTaskRunsOf(runID, result.Task) {
+				taskRun.DotID = nestedTaskRunDotID(nested.DotID(), i, taskRun.DotID)
+				taskRuns = append(taskRuns, taskRun)
+			}
+		}
+	}
+	return taskRuns
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -413,7 +589,19 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "pipeline task", trace.WithAttributes(
+		attribute.String("task.dot_id", taskRun.task.DotID()),
+		attribute.String("task.type", string(taskRun.task.Type())),
+		attribute.Int64("task.attempt", int64(taskRun.attempts)),
+	))
+	defer span.End()
+
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	// The error is returned to the caller of the run, which may log it
+	result.Error = taskRun.vars.secrets.redactError(result.Error)
+	if result.Error != nil {
+		tracing.RecordError(span, result.Error)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
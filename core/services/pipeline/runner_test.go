@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -14,19 +15,26 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
 
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/tracingtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -42,7 +50,7 @@ func newRunner(t testing.TB, db *sqlx.DB, cfg *configtest.TestGeneralConfig) (pi
 	orm.On("GetQ").Return(q)
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, cfg, cc, nil, nil, ethKeyStore, nil, nil, nil, logger.TestLogger(t), c, c, nil)
 	return r, orm
 }
 
@@ -461,7 +469,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, cfg, cc, nil, nil, ethKeyStore, nil, nil, nil, lggr, nil, nil, nil)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -813,3 +821,298 @@ a [type=uppercase input="$(first)"]
 	require.NoError(t, err)
 	assert.Equal(t, "SOMERANDOMTEST", result.Value.(string))
 }
+
+func Test_PipelineRunner_Secrets(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	secretsKeyStore := new(mocks.SecretsKeyStore)
+	secretsKeyStore.On("Get", "apiKey").Return("s3cr3t", nil)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, nil, nil, nil, secretsKeyStore, logger.TestLogger(t), c, c, nil)
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "s3cr3t", req.Header.Get("X-Api-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"price": 42, "echo": "s3cr3t"}`))
+	}))
+	defer s.Close()
+
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds    [type=http method=GET url="%s" headers=<{"X-Api-Key": $(secrets.apiKey)}>]
+parse [type=jsonparse path="price"]
+ds -> parse
+`, s.URL),
+	}
+
+	// the job must allow the secret
+	_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	for _, trr := range trrs {
+		if trr.Task.DotID() == "ds" {
+			require.Error(t, trr.Result.Error)
+			assert.Contains(t, trr.Result.Error.Error(), "allowedSecrets")
+		}
+	}
+
+	spec.AllowedSecrets = []string{"apiKey"}
+	run, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+
+	result, err := trrs.FinalResult(lggr).SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, float64(42), result.Value)
+
+	// the results passed between tasks contain the secret, but it is redacted
+	// from what is stored
+	for _, trr := range trrs {
+		if trr.Task.DotID() == "ds" {
+			assert.Contains(t, trr.Result.Value, "s3cr3t")
+		}
+	}
+	require.Len(t, run.PipelineTaskRuns, 2)
+	for _, taskRun := range run.PipelineTaskRuns {
+		if taskRun.DotID == "ds" {
+			assert.Equal(t, `{"price": 42, "echo": "[redacted]"}`, taskRun.Output.Val)
+		}
+	}
+}
+
+type testRPCError struct {
+	code int
+	data interface{}
+}
+
+func (e testRPCError) Error() string          { return "execution reverted" }
+func (e testRPCError) ErrorCode() int         { return e.code }
+func (e testRPCError) ErrorData() interface{} { return e.data }
+
+func Test_PipelineRunner_ETHCallBatching(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": []byte{0x01}, "bar": []byte{0x02}})
+
+	t.Run("batches the calls of tasks which run at once", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2
+		})).Run(func(args mock.Arguments) {
+			for _, elem := range args.Get(1).([]rpc.BatchElem) {
+				require.Equal(t, "eth_call", elem.Method)
+				arg := elem.Args[0].(map[string]interface{})
+				*elem.Result.(*hexutil.Bytes) = []byte(arg["data"].(hexutil.Bytes))
+			}
+		}).Return(nil).Once()
+		defer ethClient.AssertExpectations(t)
+
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+		r := pipeline.NewRunner(new(mocks.ORM), cfg, cc, nil, nil, nil, nil, nil, nil, lggr, nil, nil, nil)
+
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+a [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(foo)" index=0]
+b [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(bar)" block=100 index=1]
+`,
+		}, vars, lggr)
+		require.NoError(t, err)
+
+		results := trrs.FinalResult(lggr)
+		require.False(t, results.HasErrors(), results.AllErrors)
+		assert.Equal(t, []interface{}{[]byte{0x01}, []byte{0x02}}, results.Values)
+	})
+
+	t.Run("makes the calls of tasks which run one after the other without waiting", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).
+			Return([]byte{0x01}, nil).Twice()
+		defer ethClient.AssertExpectations(t)
+
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+		r := pipeline.NewRunner(new(mocks.ORM), cfg, cc, nil, nil, nil, nil, nil, nil, lggr, nil, nil, nil)
+
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+a [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(foo)"]
+b [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(a)"]
+a -> b
+`,
+		}, vars, lggr)
+		require.NoError(t, err)
+
+		results := trrs.FinalResult(lggr)
+		require.False(t, results.HasErrors(), results.AllErrors)
+		ethClient.AssertNotCalled(t, "BatchCallContext", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns the revert of a call in a batch as CallContract does", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			for i, elem := range args.Get(1).([]rpc.BatchElem) {
+				arg := elem.Args[0].(map[string]interface{})
+				if arg["data"].(hexutil.Bytes)[0] == 0x01 {
+					*elem.Result.(*hexutil.Bytes) = []byte{0x01}
+				} else {
+					args.Get(1).([]rpc.BatchElem)[i].Error = testRPCError{code: 3, data: "0xdead"}
+				}
+			}
+		}).Return(nil).Once()
+		defer ethClient.AssertExpectations(t)
+
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+		r := pipeline.NewRunner(new(mocks.ORM), cfg, cc, nil, nil, nil, nil, nil, nil, lggr, nil, nil, nil)
+
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+a [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(foo)" index=0]
+b [type=ethcall contract="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(bar)" index=1]
+`,
+		}, vars, lggr)
+		require.NoError(t, err)
+
+		results := trrs.FinalResult(lggr)
+		assert.Equal(t, []byte{0x01}, results.Values[0])
+		require.Error(t, results.AllErrors[1])
+		jsonErr := evmclient.ExtractRPCError(results.AllErrors[1])
+		require.NotNil(t, jsonErr)
+		assert.Equal(t, 3, jsonErr.Code)
+		assert.Equal(t, "0xdead", jsonErr.Data)
+	})
+}
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, nil, nil, nil, nil, logger.TestLogger(t), c, c, nil)
+
+	var requests int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"price": %d}`, 41+requests)
+	}))
+	defer s.Close()
+
+	lggr := logger.TestLogger(t)
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds       [type=http method=GET url="%s"]
+parse    [type=jsonparse path="price"]
+multiply [type=multiply times="$(times)"]
+ds -> parse -> multiply
+`, s.URL),
+	}
+	run, _, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(map[string]interface{}{"times": 100}), lggr)
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+
+	// the run is replayed as loaded from the database
+	recorded := func() pipeline.Run {
+		var loaded pipeline.Run
+		bs, err := json.Marshal(run)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bs, &loaded))
+		loaded.ID = 1
+		return loaded
+	}
+
+	t.Run("replays the recorded outputs of external tasks", func(t *testing.T) {
+		result, err := r.ReplayRun(context.Background(), recorded(), lggr)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, requests)
+		assert.False(t, result.Diverged())
+		require.Len(t, result.Run.Outputs.Val, 1)
+		assert.Equal(t, "4200", result.Run.Outputs.Val.([]interface{})[0].(decimal.Decimal).String())
+	})
+
+	t.Run("reports divergent tasks", func(t *testing.T) {
+		run := recorded()
+		for i := range run.PipelineTaskRuns {
+			if run.PipelineTaskRuns[i].DotID == "multiply" {
+				run.PipelineTaskRuns[i].Output = pipeline.JSONSerializable{Val: "4300", Valid: true}
+			}
+		}
+		run.Outputs = pipeline.JSONSerializable{Val: []interface{}{"4300"}, Valid: true}
+
+		result, err := r.ReplayRun(context.Background(), run, lggr)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, requests)
+		assert.True(t, result.OutputsDiverged)
+		require.Len(t, result.Divergences, 1)
+		assert.Equal(t, "multiply", result.Divergences[0].DotID)
+		assert.Equal(t, "4300", result.Divergences[0].RecordedOutput.Val)
+		assert.Equal(t, "4200", result.Divergences[0].ReplayedOutput.Val.(decimal.Decimal).String())
+	})
+
+	t.Run("rejects runs without the recorded output of external tasks", func(t *testing.T) {
+		run := recorded()
+		var taskRuns []pipeline.TaskRun
+		for _, taskRun := range run.PipelineTaskRuns {
+			if taskRun.DotID != "ds" {
+				taskRuns = append(taskRuns, taskRun)
+			}
+		}
+		run.PipelineTaskRuns = taskRuns
+
+		_, err := r.ReplayRun(context.Background(), run, lggr)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, pipeline.ErrNoRecordedOutput))
+		assert.Contains(t, err.Error(), "its task runs ds were not saved")
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("rejects unfinished runs", func(t *testing.T) {
+		run := recorded()
+		run.FinishedAt = null.Time{}
+
+		_, err := r.ReplayRun(context.Background(), run, lggr)
+		require.EqualError(t, err, "run 1 is not finished")
+	})
+}
+
+func Test_PipelineRunner_Tracing(t *testing.T) {
+	recorder := tracingtest.NewSpanRecorder(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var traceparent string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("traceparent")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"result": 42}}`))
+	}))
+	defer s.Close()
+	bt, _ := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL}, cfg)
+
+	r, _ := newRunner(t, db, cfg)
+	lggr := logger.TestLogger(t)
+	_, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds    [type=bridge name="%s"]
+parse [type=jsonparse path="data,result"]
+ds -> parse
+`, bt.Name.String()),
+	}, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	require.False(t, trrs.FinalResult(lggr).HasErrors())
+
+	runSpan := tracingtest.SpanNamed(recorder, "pipeline run")
+	require.NotNil(t, runSpan)
+	bridgeSpan := tracingtest.SpanNamed(recorder, "bridge request")
+	require.NotNil(t, bridgeSpan)
+	var taskSpans []sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "pipeline task" {
+			taskSpans = append(taskSpans, span)
+			assert.Equal(t, runSpan.SpanContext().SpanID(), span.Parent().SpanID())
+		}
+	}
+	require.Len(t, taskSpans, 2)
+	assert.Equal(t, runSpan.SpanContext().TraceID(), bridgeSpan.SpanContext().TraceID())
+	assert.Contains(t, bridgeSpan.Attributes(), attribute.String("bridge.name", bt.Name.String()))
+
+	// The bridge request carries the trace context of its span
+	require.NotEmpty(t, traceparent)
+	assert.Equal(t, fmt.Sprintf("00-%s-%s-01", bridgeSpan.SpanContext().TraceID(), bridgeSpan.SpanContext().SpanID()), traceparent)
+}
@@ -121,6 +121,11 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 func (s *scheduler) reconstructResults() {
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	for _, r := range s.run.PipelineTaskRuns {
+		// task runs of nested pipelines are not part of the pipeline
+		if isNestedTaskRunDotID(r.DotID) {
+			continue
+		}
+
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
@@ -0,0 +1,311 @@
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// SecretsKey is the first part of the keypath of a variable expression
+	// referring to a node secret, e.g. $(secrets.apiKey)
+	SecretsKey = "secrets"
+
+	// RedactedSecret replaces the values of secrets in task run outputs and
+	// errors, and in logs
+	RedactedSecret = "[redacted]"
+)
+
+//go:generate mockery --name SecretsKeyStore --output ./mocks/ --case=underscore
+
+// SecretsKeyStore looks up node secrets by name.
+type SecretsKeyStore interface {
+	Get(name string) (string, error)
+}
+
+// secretsResolver looks up the secrets referred to by a run when they are
+// first used, and remembers their values so that they can be redacted from
+// what is stored and logged. Only the secrets allowed by the job can be
+// looked up.
+type secretsResolver struct {
+	keyStore SecretsKeyStore
+	allowed  map[string]struct{}
+
+	mu       sync.RWMutex
+	resolved map[string]string
+}
+
+func newSecretsResolver(keyStore SecretsKeyStore, allowed []string) *secretsResolver {
+	s := &secretsResolver{
+		keyStore: keyStore,
+		allowed:  make(map[string]struct{}, len(allowed)),
+		resolved: make(map[string]string),
+	}
+	for _, name := range allowed {
+		s.allowed[name] = struct{}{}
+	}
+	return s
+}
+
+func (s *secretsResolver) get(name string) (string, error) {
+	if s == nil || s.keyStore == nil {
+		return "", errors.Wrapf(ErrKeypathNotFound, "secrets are not available, cannot resolve secret %q", name)
+	}
+	if _, allowed := s.allowed[name]; !allowed {
+		return "", errors.Wrapf(ErrKeypathNotFound, "secret %q is not in the allowedSecrets of the job", name)
+	}
+
+	s.mu.RLock()
+	value, exists := s.resolved[name]
+	s.mu.RUnlock()
+	if exists {
+		return value, nil
+	}
+
+	value, err := s.keyStore.Get(name)
+	if err != nil {
+		return "", errors.Wrapf(ErrKeypathNotFound, "secret %q: %v", name, err)
+	}
+
+	s.mu.Lock()
+	s.resolved[name] = value
+	s.mu.Unlock()
+	return value, nil
+}
+
+// redactString replaces the values of all secrets resolved so far in s.
+func (s *secretsResolver) redactString(str string) string {
+	if s == nil {
+		return str
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, value := range s.resolved {
+		str = strings.ReplaceAll(str, value, RedactedSecret)
+	}
+	return str
+}
+
+// redact returns a copy of val with the values of all secrets resolved so far
+// replaced in its strings, recursing into maps and slices. val itself is not
+// modified, since the unredacted value may still be needed by later tasks.
+func (s *secretsResolver) redact(val interface{}) interface{} {
+	if s == nil {
+		return val
+	}
+	s.mu.RLock()
+	empty := len(s.resolved) == 0
+	s.mu.RUnlock()
+	if empty {
+		return val
+	}
+
+	switch v := val.(type) {
+	case string:
+		return s.redactString(v)
+	case []byte:
+		return []byte(s.redactString(string(v)))
+	case error:
+		return errors.New(s.redactString(v.Error()))
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = s.redact(value)
+		}
+		return m
+	case []interface{}:
+		slice := make([]interface{}, len(v))
+		for i, value := range v {
+			slice[i] = s.redact(value)
+		}
+		return slice
+	default:
+		return val
+	}
+}
+
+// redactError returns err with the values of all secrets resolved so far
+// replaced in its message. The original error can still be matched with
+// errors.Is and errors.As.
+func (s *secretsResolver) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := s.redactString(err.Error())
+	if msg == err.Error() {
+		return err
+	}
+	return &redactedError{msg: msg, err: err}
+}
+
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string { return e.msg }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactTaskRun redacts the output and error of a task run before it is
+// stored.
+func (s *secretsResolver) redactTaskRun(taskRun *TaskRun) {
+	taskRun.Output.Val = s.redact(taskRun.Output.Val)
+	if taskRun.Error.Valid {
+		taskRun.Error.String = s.redactString(taskRun.Error.String)
+	}
+}
+
+// redactLogValue redacts a value which is about to be logged. Values of
+// other types are redacted from their formatted string, since they may still
+// contain a secret, e.g. a URL with a secret query parameter.
+func (s *secretsResolver) redactLogValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case string, []byte, error, map[string]interface{}, []interface{}:
+		return s.redact(v)
+	case nil:
+		return nil
+	default:
+		str := fmt.Sprintf("%v", v)
+		if redacted := s.redactString(str); redacted != str {
+			return redacted
+		}
+		return val
+	}
+}
+
+func (s *secretsResolver) redactLogValues(vals []interface{}) []interface{} {
+	if s == nil {
+		return vals
+	}
+	s.mu.RLock()
+	empty := len(s.resolved) == 0
+	s.mu.RUnlock()
+	if empty {
+		return vals
+	}
+
+	redacted := make([]interface{}, len(vals))
+	for i, val := range vals {
+		redacted[i] = s.redactLogValue(val)
+	}
+	return redacted
+}
+
+// newSecretsLogger returns a logger which redacts the values of the secrets
+// resolved by the run from everything it logs, so that tasks cannot leak them
+// in their logs.
+func newSecretsLogger(l logger.Logger, secrets *secretsResolver) logger.Logger {
+	if secrets == nil {
+		return l
+	}
+	return &secretsLogger{h: l.Helper(1), secrets: secrets}
+}
+
+type secretsLogger struct {
+	h       logger.Logger
+	secrets *secretsResolver
+}
+
+func (l *secretsLogger) With(args ...interface{}) logger.Logger {
+	return &secretsLogger{h: l.h.With(l.secrets.redactLogValues(args)...), secrets: l.secrets}
+}
+
+func (l *secretsLogger) Named(name string) logger.Logger {
+	return &secretsLogger{h: l.h.Named(name), secrets: l.secrets}
+}
+
+func (l *secretsLogger) NewRootLogger(lvl zapcore.Level) (logger.Logger, error) {
+	h, err := l.h.NewRootLogger(lvl)
+	if err != nil {
+		return nil, err
+	}
+	return &secretsLogger{h: h, secrets: l.secrets}, nil
+}
+
+func (l *secretsLogger) SetLogLevel(lvl zapcore.Level) { l.h.SetLogLevel(lvl) }
+
+func (l *secretsLogger) Trace(args ...interface{}) { l.h.Trace(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Debug(args ...interface{}) { l.h.Debug(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Info(args ...interface{})  { l.h.Info(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Warn(args ...interface{})  { l.h.Warn(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Error(args ...interface{}) { l.h.Error(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Critical(args ...interface{}) {
+	l.h.Critical(l.secrets.redactLogValues(args)...)
+}
+func (l *secretsLogger) Panic(args ...interface{}) { l.h.Panic(l.secrets.redactLogValues(args)...) }
+func (l *secretsLogger) Fatal(args ...interface{}) { l.h.Fatal(l.secrets.redactLogValues(args)...) }
+
+func (l *secretsLogger) Tracef(format string, values ...interface{}) {
+	l.h.Tracef(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Debugf(format string, values ...interface{}) {
+	l.h.Debugf(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Infof(format string, values ...interface{}) {
+	l.h.Infof(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Warnf(format string, values ...interface{}) {
+	l.h.Warnf(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Errorf(format string, values ...interface{}) {
+	l.h.Errorf(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Criticalf(format string, values ...interface{}) {
+	l.h.Criticalf(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Panicf(format string, values ...interface{}) {
+	l.h.Panicf(format, l.secrets.redactLogValues(values)...)
+}
+func (l *secretsLogger) Fatalf(format string, values ...interface{}) {
+	l.h.Fatalf(format, l.secrets.redactLogValues(values)...)
+}
+
+func (l *secretsLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	l.h.Tracew(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	l.h.Debugw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Infow(msg string, keysAndValues ...interface{}) {
+	l.h.Infow(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.h.Warnw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	l.h.Errorw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Criticalw(msg string, keysAndValues ...interface{}) {
+	l.h.Criticalw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Panicw(msg string, keysAndValues ...interface{}) {
+	l.h.Panicw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+func (l *secretsLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	l.h.Fatalw(l.secrets.redactString(msg), l.secrets.redactLogValues(keysAndValues)...)
+}
+
+func (l *secretsLogger) ErrorIf(err error, msg string) {
+	l.h.ErrorIf(l.secrets.redactError(err), l.secrets.redactString(msg))
+}
+
+func (l *secretsLogger) ErrorIfClosing(c io.Closer, name string) {
+	if err := c.Close(); err != nil {
+		l.h.Errorw(fmt.Sprintf("Error closing %s", name), "err", l.secrets.redactError(err))
+	}
+}
+
+func (l *secretsLogger) Sync() error { return l.h.Sync() }
+
+func (l *secretsLogger) Helper(skip int) logger.Logger {
+	return &secretsLogger{h: l.h.Helper(skip), secrets: l.secrets}
+}
+
+func (l *secretsLogger) Recover(panicErr interface{}) { l.h.Recover(panicErr) }
@@ -0,0 +1,120 @@
+package pipeline
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type testSecretsKeyStore map[string]string
+
+func (ks testSecretsKeyStore) Get(name string) (string, error) {
+	value, exists := ks[name]
+	if !exists {
+		return "", errors.Errorf("secret %s not found", name)
+	}
+	return value, nil
+}
+
+func TestVars_Secrets(t *testing.T) {
+	t.Parallel()
+
+	vars := NewVarsFrom(map[string]interface{}{"foo": "bar"})
+	vars.secrets = newSecretsResolver(testSecretsKeyStore{"apiKey": "s3cr3t", "other": "0th3r"}, []string{"apiKey", "missing"})
+
+	value, err := vars.Get("secrets.apiKey")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	value, err = vars.Copy().Get("secrets.apiKey")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+
+	_, err = vars.Get("secrets.missing")
+	assert.ErrorIs(t, err, ErrKeypathNotFound)
+	// secrets which the job does not allow cannot be resolved
+	_, err = vars.Get("secrets.other")
+	assert.ErrorIs(t, err, ErrKeypathNotFound)
+	assert.Contains(t, err.Error(), "allowedSecrets")
+	_, err = vars.Get("secrets")
+	assert.Error(t, err)
+
+	_, err = NewVarsFrom(nil).Get("secrets.apiKey")
+	assert.ErrorIs(t, err, ErrKeypathNotFound)
+}
+
+func TestSecretsResolver_Redact(t *testing.T) {
+	t.Parallel()
+
+	s := newSecretsResolver(testSecretsKeyStore{"apiKey": "s3cr3t"}, []string{"apiKey"})
+
+	// nothing is redacted until a secret has been used
+	assert.Equal(t, "key=s3cr3t", s.redact("key=s3cr3t"))
+
+	_, err := s.get("apiKey")
+	require.NoError(t, err)
+
+	original := map[string]interface{}{
+		"header": "Bearer s3cr3t",
+		"list":   []interface{}{"s3cr3t", 42},
+	}
+	redacted := s.redact(original)
+	assert.Equal(t, map[string]interface{}{
+		"header": "Bearer [redacted]",
+		"list":   []interface{}{"[redacted]", 42},
+	}, redacted)
+	assert.Equal(t, "Bearer s3cr3t", original["header"])
+
+	assert.Equal(t, []byte("[redacted]"), s.redact([]byte("s3cr3t")))
+	assert.EqualError(t, s.redact(errors.New("bad key s3cr3t")).(error), "bad key [redacted]")
+
+	err = s.redactError(errors.Wrap(ErrTimeout, "GET https://example.com?key=s3cr3t"))
+	assert.EqualError(t, err, "GET https://example.com?key=[redacted]: timeout")
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.Nil(t, s.redactError(nil))
+
+	var nilResolver *secretsResolver
+	assert.Equal(t, "s3cr3t", nilResolver.redactString("s3cr3t"))
+}
+
+func TestSecretsLogger(t *testing.T) {
+	t.Parallel()
+
+	s := newSecretsResolver(testSecretsKeyStore{"apiKey": "s3cr3t"}, []string{"apiKey"})
+	lggr, observed := logger.TestLoggerObserved(t, zapcore.DebugLevel)
+	l := newSecretsLogger(lggr, s).Named("Task").With("header", "Bearer s3cr3t")
+
+	// nothing is redacted until a secret has been used
+	l.Debugw("before", "key", "s3cr3t")
+
+	_, err := s.get("apiKey")
+	require.NoError(t, err)
+
+	u, err := url.Parse("https://example.com/price?key=s3cr3t")
+	require.NoError(t, err)
+	l.Debugw("sending request", "url", u, "err", errors.New("bad key s3cr3t"))
+	l.Warnf("request to %s failed", u)
+	l.Info("key ", "s3cr3t")
+
+	entries := observed.All()
+	require.Len(t, entries, 4)
+	assert.Equal(t, "s3cr3t", entries[0].ContextMap()["key"])
+	for _, entry := range entries[1:] {
+		assert.NotContains(t, entry.Message, "s3cr3t")
+		for key, value := range entry.ContextMap() {
+			if key == "header" {
+				// added before the secret was used
+				continue
+			}
+			assert.NotContains(t, fmt.Sprintf("%v", value), "s3cr3t")
+		}
+	}
+	assert.Equal(t, "https://example.com/price?key=[redacted]", entries[1].ContextMap()["url"])
+	assert.Equal(t, "request to https://example.com/price?key=[redacted] failed", entries[2].Message)
+}
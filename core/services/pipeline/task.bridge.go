@@ -8,11 +8,16 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 )
 
 //
@@ -27,9 +32,11 @@ type BridgeTask struct {
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
 
-	queryer    pg.Queryer
-	config     Config
-	httpClient *http.Client
+	queryer         pg.Queryer
+	config          Config
+	httpClient      *http.Client
+	circuitBreakers *HostCircuitBreakers
+	bridgeLimiters  *bridgeLimiterRegistry
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -60,10 +67,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bridge, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bridge.URL)
 
 	var metaMap MapParam
 
@@ -106,8 +114,30 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	limiter := t.bridgeLimiters.get(bridge.Name.String(), bridge.MaxConcurrency, bridge.RateLimit)
+
+	requestCtx, span := otel.Tracer(tracerName).Start(requestCtx, "bridge request", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("bridge.name", bridge.Name.String()),
+		attribute.String("http.method", "POST"),
+		attribute.String("net.peer.name", url.Host),
+	))
+	defer span.End()
+
+	// The trace context is propagated to the external adapter in the W3C
+	// traceparent header
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(requestCtx, carrier)
+	requestHeaders := make(MapParam, len(carrier))
+	for name, value := range carrier {
+		requestHeaders[name] = value
+	}
+
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, requestData, requestHeaders, t.httpClient, t.config, t.circuitBreakers, limiter)
+	if statusCode != 0 {
+		span.SetAttributes(attribute.Int("http.status_code", statusCode))
+	}
 	if err != nil {
+		tracing.RecordError(span, err)
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
@@ -142,13 +172,13 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	var bt bridges.BridgeType
 	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -20,6 +20,10 @@ import (
 // Return types:
 //     []byte
 //
+// The calls of the ethcall tasks of a run which are made at about the same
+// time are sent as one JSON-RPC batch, or, if they set the address of a
+// Multicall3 contract with multicall, aggregated into one call to it.
+//
 type ETHCallTask struct {
 	BaseTask            `mapstructure:",squash"`
 	Contract            string `json:"contract"`
@@ -30,9 +34,14 @@ type ETHCallTask struct {
 	GasFeeCap           string `json:"gasFeeCap"`
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
+	Block               string `json:"block"`
+	From                string `json:"from"`
+	StateOverride       string `json:"stateOverride"`
+	Multicall           string `json:"multicall"`
 
 	chainSet evm.ChainSet
 	config   Config
+	batcher  *ethCallBatcher
 }
 
 var _ Task = (*ETHCallTask)(nil)
@@ -64,6 +73,10 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		gasTipCap    MaybeBigIntParam
 		gasFeeCap    MaybeBigIntParam
 		chainID      StringParam
+		block        BlockNumberParam
+		from         AddressParam
+		override     MapParam
+		multicall    AddressParam
 	)
 
 	err = multierr.Combine(
@@ -74,14 +87,23 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&gasTipCap, From(VarExpr(t.GasTipCap, vars), t.GasTipCap)), "gasTipCap"),
 		errors.Wrap(ResolveParam(&gasFeeCap, From(VarExpr(t.GasFeeCap, vars), t.GasFeeCap)), "gasFeeCap"),
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), NonemptyString(t.Block), "latest")), "block"),
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From), common.Address{})), "from"),
+		errors.Wrap(ResolveParam(&override, From(VarExpr(t.StateOverride, vars), JSONWithVarExprs(t.StateOverride, vars, false), nil)), "stateOverride"),
+		errors.Wrap(ResolveParam(&multicall, From(VarExpr(t.Multicall, vars), NonemptyString(t.Multicall), common.Address{})), "multicall"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	} else if len(data) == 0 {
 		return Result{Error: errors.Wrapf(ErrBadInput, "data param must not be empty")}, runInfo
 	}
+	useMulticall := common.Address(multicall) != common.Address{}
+	if useMulticall && (common.Address(from) != common.Address{} || override != nil || gas != 0 || t.GasPrice != "" || t.GasTipCap != "" || t.GasFeeCap != "") {
+		return Result{Error: errors.Wrap(ErrBadInput, "multicall cannot be combined with from, stateOverride or gas params")}, runInfo
+	}
 
 	call := ethereum.CallMsg{
+		From:      common.Address(from),
 		To:        (*common.Address)(&contractAddr),
 		Data:      []byte(data),
 		Gas:       uint64(gas),
@@ -93,7 +115,8 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	lggr = lggr.With("gas", call.Gas).
 		With("gasPrice", call.GasPrice).
 		With("gasTipCap", call.GasTipCap).
-		With("gasFeeCap", call.GasFeeCap)
+		With("gasFeeCap", call.GasFeeCap).
+		With("block", block)
 
 	chain, err := getChainByString(t.chainSet, string(chainID))
 	if err != nil {
@@ -101,8 +124,19 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		return Result{Error: err}, runInfo
 	}
 
+	request := &ethCall{chain: chain, msg: call, block: block, stateOverride: override}
+	if useMulticall {
+		request.multicall = (*common.Address)(&multicall)
+	}
+
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	var resp []byte
+	if t.batcher != nil {
+		resp, err = t.batcher.call(ctx, t.ID(), request)
+	} else {
+		executeETHCalls(ctx, []*ethCall{request})
+		resp, err = request.result, request.err
+	}
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
@@ -1,18 +1,23 @@
 package pipeline_test
 
 import (
+	"bytes"
 	"context"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -173,3 +178,159 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_BlockFromAndStateOverride(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	fromAddr := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"data":   []byte("foo"),
+		"jobRun": map[string]interface{}{"logBlockNumber": uint64(123)},
+	})
+
+	t.Run("calls at a block number from a given address", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.
+			On("CallContract", mock.Anything, ethereum.CallMsg{From: fromAddr, To: &contractAddr, Data: []byte("foo")}, big.NewInt(123)).
+			Return([]byte("bar"), nil).Once()
+		defer ethClient.AssertExpectations(t)
+
+		task := pipeline.ETHCallTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract: contractAddr.Hex(),
+			Data:     "$(data)",
+			Block:    "$(jobRun.logBlockNumber)",
+			From:     fromAddr.Hex(),
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, []byte("bar"), result.Value)
+	})
+
+	t.Run("calls with a block tag and a state override", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.
+			On("CallContext", mock.Anything, mock.Anything, "eth_call",
+				mock.MatchedBy(func(arg map[string]interface{}) bool {
+					return *arg["to"].(*common.Address) == contractAddr
+				}),
+				"pending",
+				pipeline.MapParam{contractAddr.Hex(): map[string]interface{}{"code": "0x00"}},
+			).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*hexutil.Bytes) = []byte("bar")
+			}).
+			Return(nil).Once()
+		defer ethClient.AssertExpectations(t)
+
+		task := pipeline.ETHCallTask{
+			BaseTask:      pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:      contractAddr.Hex(),
+			Data:          "$(data)",
+			Block:         "pending",
+			StateOverride: `{"` + contractAddr.Hex() + `": {"code": "0x00"}}`,
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, []byte("bar"), result.Value)
+	})
+
+	t.Run("rejects an invalid block", func(t *testing.T) {
+		task := pipeline.ETHCallTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract: contractAddr.Hex(),
+			Data:     "$(data)",
+			Block:    "yesterday",
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, new(evmmocks.Client), evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		assert.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
+
+func TestETHCallTask_Multicall(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	multicallAddr := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+	multicallABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`))
+	require.NoError(t, err)
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+
+	t.Run("aggregates the call", func(t *testing.T) {
+		response, err := multicallABI.Methods["aggregate3"].Outputs.Pack([]result{{true, []byte("bar")}})
+		require.NoError(t, err)
+
+		ethClient := new(evmmocks.Client)
+		ethClient.
+			On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+				return *msg.To == multicallAddr && bytes.HasPrefix(msg.Data, multicallABI.Methods["aggregate3"].ID)
+			}), (*big.Int)(nil)).
+			Return(response, nil).Once()
+		defer ethClient.AssertExpectations(t)
+
+		task := pipeline.ETHCallTask{
+			BaseTask:  pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:  contractAddr.Hex(),
+			Data:      "$(data)",
+			Multicall: multicallAddr.Hex(),
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		res, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo")}), nil)
+		require.NoError(t, res.Error)
+		assert.Equal(t, []byte("bar"), res.Value)
+	})
+
+	t.Run("returns the revert of the call as CallContract does", func(t *testing.T) {
+		errorABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"reason","type":"string"}],"name":"Error","type":"function"}]`))
+		require.NoError(t, err)
+		revert, err := errorABI.Pack("Error", "boom")
+		require.NoError(t, err)
+		response, err := multicallABI.Methods["aggregate3"].Outputs.Pack([]result{{false, revert}})
+		require.NoError(t, err)
+
+		ethClient := new(evmmocks.Client)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Return(response, nil).Once()
+
+		task := pipeline.ETHCallTask{
+			BaseTask:  pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:  contractAddr.Hex(),
+			Data:      "$(data)",
+			Multicall: multicallAddr.Hex(),
+
+			ExtractRevertReason: true,
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		res, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo")}), nil)
+		require.Error(t, res.Error)
+		assert.Contains(t, res.Error.Error(), "execution reverted: boom")
+		jsonErr := evmclient.ExtractRPCError(res.Error)
+		require.NotNil(t, jsonErr)
+		assert.Equal(t, 3, jsonErr.Code)
+		assert.Equal(t, hexutil.Encode(revert), jsonErr.Data)
+	})
+
+	t.Run("cannot be combined with from", func(t *testing.T) {
+		task := pipeline.ETHCallTask{
+			BaseTask:  pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:  contractAddr.Hex(),
+			Data:      "$(data)",
+			From:      contractAddr.Hex(),
+			Multicall: multicallAddr.Hex(),
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, new(evmmocks.Client), evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+		res, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"data": []byte("foo")}), nil)
+		assert.ErrorIs(t, res.Error, pipeline.ErrBadInput)
+	})
+}
@@ -0,0 +1,128 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+//
+// Return types:
+//     []byte
+//
+// ETHSignTask signs data with an eth key of the node, for consumers which
+// verify it off-chain, e.g. with ecrecover. The signature is the 65 bytes
+// r ‖ s ‖ v, with v 27 or 28.
+//
+// In the default "eip191" mode, the EIP-191 personal message hash of data is
+// signed. In the "raw" mode, the keccak256 hash of data is signed, unless data
+// is an encoded transaction.
+//
+// The key is one of the sending keys of the chain listed in from, which must
+// be set in the job spec rather than from variables, so that the job decides
+// which keys it is allowed to sign with. Signing must also be enabled for the
+// key, see keystore.Eth.EnableSigning.
+type ETHSignTask struct {
+	BaseTask   `mapstructure:",squash"`
+	From       string `json:"from"`
+	Data       string `json:"data"`
+	Mode       string `json:"mode"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	keyStore ETHKeyStore
+	chainSet evm.ChainSet
+}
+
+const (
+	ETHSignModeEIP191 = "eip191"
+	ETHSignModeRaw    = "raw"
+)
+
+var _ Task = (*ETHSignTask)(nil)
+
+func (t *ETHSignTask) Type() TaskType {
+	return TaskTypeETHSign
+}
+
+func (t *ETHSignTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		data BytesParam
+		mode StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
+		errors.Wrap(ResolveParam(&mode, From(NonemptyString(t.Mode), ETHSignModeEIP191)), "mode"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, fromAddr, err := resolveSigningKey(t.keyStore, t.chainSet, t.From, t.EVMChainID, vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var signature []byte
+	switch mode {
+	case ETHSignModeEIP191:
+		signature, err = t.keyStore.SignMessage(fromAddr, chain.ID(), data)
+	case ETHSignModeRaw:
+		signature, err = t.keyStore.SignRawMessage(fromAddr, chain.ID(), data)
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "mode must be %s or %s, got %s", ETHSignModeEIP191, ETHSignModeRaw, mode)}, runInfo
+	}
+	if errors.Is(err, keystore.ErrSignTransaction) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "data: %v", err)}, runInfo
+	} else if err != nil {
+		err = errors.Wrapf(ErrTaskRunFailed, "while signing: %v", err)
+		lggr.Error(err)
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: signature}, runInfo
+}
+
+// resolveSigningKey returns the chain of evmChainID, and the least recently
+// used of its sending keys which are listed in from. The keys must be set in
+// the job spec, since a signing key chosen by the inputs of a run could sign
+// on behalf of another job.
+func resolveSigningKey(keyStore ETHKeyStore, chainSet evm.ChainSet, from string, evmChainID string, vars Vars) (evm.Chain, common.Address, error) {
+	if strings.Contains(from, "$(") {
+		return nil, common.Address{}, errors.Wrap(ErrBadInput, "from: signing keys must be set in the job spec, not from variables")
+	}
+	var (
+		fromAddrs AddressSliceParam
+		chainID   StringParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&fromAddrs, From(JSONWithVarExprs(from, vars, false), NonemptyString(from))), "from"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(evmChainID, vars), NonemptyString(evmChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	if len(fromAddrs) == 0 {
+		return nil, common.Address{}, errors.Wrap(ErrBadInput, "from: at least one signing key is required")
+	}
+
+	chain, err := getChainByString(chainSet, string(chainID))
+	if err != nil {
+		return nil, common.Address{}, errors.Wrapf(err, "failed to get chain by id: %v", chainID)
+	}
+	fromAddr, err := keyStore.GetRoundRobinAddress(chain.ID(), fromAddrs...)
+	if err != nil {
+		return nil, common.Address{}, errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)
+	}
+	return chain, fromAddr, nil
+}
@@ -0,0 +1,235 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func newSigningChainSet(t *testing.T, chainID *big.Int) *evmmocks.ChainSet {
+	chain := new(evmmocks.Chain)
+	chain.Test(t)
+	chain.On("ID").Return(chainID)
+	cc := new(evmmocks.ChainSet)
+	cc.Test(t)
+	cc.On("Get", chainID).Return(chain, nil).Maybe()
+	cc.On("Default").Return(chain, nil).Maybe()
+	return cc
+}
+
+func TestETHSignTask(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	other := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	signature := []byte{1, 2, 3}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"data": []byte("hello"),
+		"from": other.Hex(),
+	})
+
+	tests := []struct {
+		name               string
+		from               string
+		mode               string
+		evmChainID         string
+		setupKeyStore      func(keyStore *mocks.ETHKeyStore)
+		expected           interface{}
+		expectedErrorCause error
+	}{
+		{
+			"eip191",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+				keyStore.On("SignMessage", from, chainID, []byte("hello")).Return(signature, nil)
+			},
+			signature, nil,
+		},
+		{
+			"raw on the default chain",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"]`,
+			"raw",
+			"",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from, other).Return(other, nil)
+				keyStore.On("SignRawMessage", other, chainID, []byte("hello")).Return(signature, nil)
+			},
+			signature, nil,
+		},
+		{
+			"raw transaction",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"raw",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+				keyStore.On("SignRawMessage", from, chainID, []byte("hello")).Return(nil, keystore.ErrSignTransaction)
+			},
+			nil, pipeline.ErrBadInput,
+		},
+		{
+			"raw signing disabled",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"raw",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+				keyStore.On("SignRawMessage", from, chainID, []byte("hello")).Return(nil, keystore.ErrSigningDisabled)
+			},
+			nil, pipeline.ErrTaskRunFailed,
+		},
+		{
+			"unknown mode",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"eip1559",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+			},
+			nil, pipeline.ErrBadInput,
+		},
+		{
+			"signing disabled",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+				keyStore.On("SignMessage", from, chainID, []byte("hello")).Return(nil, keystore.ErrSigningDisabled)
+			},
+			nil, pipeline.ErrTaskRunFailed,
+		},
+		{
+			"from variables",
+			`["$(from)"]`,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {},
+			nil, pipeline.ErrBadInput,
+		},
+		{
+			"no from",
+			``,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {},
+			nil, pipeline.ErrParameterEmpty,
+		},
+		{
+			"empty from",
+			`[]`,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {},
+			nil, pipeline.ErrBadInput,
+		},
+		{
+			"no sending key",
+			`["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+			"",
+			"1337",
+			func(keyStore *mocks.ETHKeyStore) {
+				keyStore.On("GetRoundRobinAddress", chainID, from).Return(common.Address{}, errors.New("no sending keys available"))
+			},
+			nil, pipeline.ErrTaskRunFailed,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHSignTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ethsign", nil, nil, 0),
+				From:       test.from,
+				Data:       "$(data)",
+				Mode:       test.mode,
+				EVMChainID: test.evmChainID,
+			}
+			keyStore := new(mocks.ETHKeyStore)
+			keyStore.Test(t)
+			test.setupKeyStore(keyStore)
+			task.HelperSetDependencies(newSigningChainSet(t, chainID), keyStore)
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+			assert.False(t, runInfo.IsPending)
+			if test.expectedErrorCause != nil {
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+			keyStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestETHSignTypedDataTask(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	signature := []byte{1, 2, 3}
+
+	task := pipeline.ETHSignTypedDataTask{
+		BaseTask: pipeline.NewBaseTask(0, "ethsigntypeddata", nil, nil, 0),
+		From:     `["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]`,
+		TypedData: `{
+			"types": {
+				"EIP712Domain": [{"name": "name", "type": "string"}, {"name": "chainId", "type": "uint256"}],
+				"Answer": [{"name": "value", "type": "uint256"}, {"name": "data", "type": "bytes"}]
+			},
+			"primaryType": "Answer",
+			"domain": {"name": "Test", "chainId": 1337},
+			"message": {"value": $(value), "data": $(data)}
+		}`,
+		EVMChainID: "1337",
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"value": "42",
+		"data":  []byte{0xbe, 0xef},
+	})
+
+	keyStore := new(mocks.ETHKeyStore)
+	keyStore.Test(t)
+	keyStore.On("GetRoundRobinAddress", chainID, from).Return(from, nil)
+	keyStore.On("SignTypedData", from, chainID, mock.MatchedBy(func(typedData apitypes.TypedData) bool {
+		return typedData.PrimaryType == "Answer" &&
+			typedData.Domain.Name == "Test" &&
+			(*big.Int)(typedData.Domain.ChainId).Cmp(chainID) == 0 &&
+			typedData.Message["value"] == "42" &&
+			typedData.Message["data"] == "0xbeef"
+	})).Return(signature, nil)
+	task.HelperSetDependencies(newSigningChainSet(t, chainID), keyStore)
+
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, signature, result.Value)
+	keyStore.AssertExpectations(t)
+
+	t.Run("invalid typed data", func(t *testing.T) {
+		task := task
+		task.TypedData = `{"domain": {"chainId": "not a number"}}`
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []byte
+//
+// ETHSignTypedDataTask signs EIP-712 typed data with an eth key of the node,
+// which is chosen as in ETHSignTask. The signature is the 65 bytes
+// r ‖ s ‖ v, with v 27 or 28.
+//
+// Typed data is an object with the same "types", "primaryType", "domain" and
+// "message" fields as the eth_signTypedData_v4 JSON-RPC method. If the domain
+// has a chainId, it must be the chain of the task.
+type ETHSignTypedDataTask struct {
+	BaseTask   `mapstructure:",squash"`
+	From       string `json:"from"`
+	TypedData  string `json:"typedData" mapstructure:"typedData"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	keyStore ETHKeyStore
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHSignTypedDataTask)(nil)
+
+func (t *ETHSignTypedDataTask) Type() TaskType {
+	return TaskTypeETHSignTypedData
+}
+
+func (t *ETHSignTypedDataTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var typedDataMap MapParam
+	err = errors.Wrap(ResolveParam(&typedDataMap, From(VarExpr(t.TypedData, vars), JSONWithVarExprs(t.TypedData, vars, false))), "typedData")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	typedData, err := decodeTypedData(typedDataMap)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "typedData: %v", err)}, runInfo
+	}
+
+	chain, fromAddr, err := resolveSigningKey(t.keyStore, t.chainSet, t.From, t.EVMChainID, vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	signature, err := t.keyStore.SignTypedData(fromAddr, chain.ID(), typedData)
+	if err != nil {
+		err = errors.Wrapf(ErrTaskRunFailed, "while signing: %v", err)
+		lggr.Error(err)
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: signature}, runInfo
+}
+
+func decodeTypedData(typedDataMap MapParam) (typedData apitypes.TypedData, err error) {
+	m := replaceBytesWithHex(map[string]interface{}(typedDataMap)).(map[string]interface{})
+	// The chain ID of the domain is only decoded from a string
+	if domain, is := m["domain"].(map[string]interface{}); is && domain["chainId"] != nil {
+		var chainID DecimalParam
+		if err = chainID.UnmarshalPipelineParam(domain["chainId"]); err != nil {
+			return typedData, errors.Wrap(err, "domain.chainId")
+		}
+		domain["chainId"] = chainID.Decimal().String()
+	}
+	bs, err := json.Marshal(m)
+	if err != nil {
+		return typedData, err
+	}
+	err = json.Unmarshal(bs, &typedData)
+	return typedData, err
+}
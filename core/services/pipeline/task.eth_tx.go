@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -40,6 +41,9 @@ type ETHTxTask struct {
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(chainID *big.Int, addrs ...common.Address) (common.Address, error)
+	SignMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error)
+	SignTypedData(address common.Address, chainID *big.Int, typedData apitypes.TypedData) ([]byte, error)
+	SignRawMessage(address common.Address, chainID *big.Int, message []byte) ([]byte, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     decimal.Decimal
+//     bool
+//
+// Evaluates an arithmetic or boolean expression, e.g.
+//
+//     clamp [type=expr expr="max(min(price * (1 + spread), ceiling), 0)"
+//            inputs=<{"price": $(ds1), "spread": $(ds2), "ceiling": 100}>]
+//
+// The expression is parsed when the job is created, see parseExpression.
+//
+type ExprTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Expr        string `json:"expr"`
+	NamedInputs string `json:"inputs" mapstructure:"inputs"`
+
+	expression *expression
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var namedInputs MapParam
+	err = errors.Wrap(ResolveParam(&namedInputs, From(VarExpr(t.NamedInputs, vars), JSONWithVarExprs(t.NamedInputs, vars, false), nil)), "inputs")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	expression := t.expression
+	if expression == nil {
+		if expression, err = parseExpression(t.Expr); err != nil {
+			return Result{Error: errors.Wrap(err, "expr")}, runInfo
+		}
+	}
+
+	value, err := expression.Eval(func(name string) (interface{}, error) {
+		if value, exists := namedInputs[name]; exists {
+			return value, nil
+		}
+		return vars.Get(name)
+	})
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,123 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"ds1":    "101.5",
+		"ds2":    map[string]interface{}{"spread": 0.02},
+		"active": true,
+	})
+
+	tests := []struct {
+		name        string
+		expr        string
+		inputs      string
+		expected    interface{}
+		expectedErr error
+	}{
+		{"arithmetic", "1 + 2 * 3 - 4 / 8", "", mustDecimal(t, "6.5"), nil},
+		{"parentheses", "(1 + 2) * 3", "", mustDecimal(t, "9"), nil},
+		{"unary minus", "-2 * -(3 - 1)", "", mustDecimal(t, "4"), nil},
+		{"modulo", "17 % 5", "", mustDecimal(t, "2"), nil},
+		{"decimal precision", "0.1 + 0.2", "", mustDecimal(t, "0.3"), nil},
+		{"min and max", "max(min(price, 100), 0)", `{"price": 150}`, mustDecimal(t, "100"), nil},
+		{"abs", "abs(a - b)", `{"a": 1, "b": 3.5}`, mustDecimal(t, "2.5"), nil},
+		{"pow", "pow(2, 10)", "", mustDecimal(t, "1024"), nil},
+		{"round", "round(2.345, 2)", "", mustDecimal(t, "2.35"), nil},
+		{"round to integer", "round(2.5)", "", mustDecimal(t, "3"), nil},
+		{"round to tens", "round(1234, -1)", "", mustDecimal(t, "1230"), nil},
+		{"comparison", "1.0 == 1 && 2 > 1 && 2 >= 2 && 1 < 2 && 1 <= 1 && 1 != 2", "", true, nil},
+		{"boolean operators", "!(true && false) || false", "", true, nil},
+		{"ternary", "a > b ? a : b", `{"a": 1, "b": 2}`, mustDecimal(t, "2"), nil},
+		{"nested ternary", "a > 10 ? 1 : a > 5 ? 2 : 3", `{"a": 7}`, mustDecimal(t, "2"), nil},
+		{"vars", "ds1 * (1 + ds2.spread)", "", mustDecimal(t, "103.53"), nil},
+		{"bool vars", "active ? 1 : 0", "", mustDecimal(t, "1"), nil},
+		{"inputs from vars", "price * 2", `{"price": $(ds1)}`, mustDecimal(t, "203"), nil},
+		{"short circuit", "false && missing", "", false, nil},
+
+		{"division by zero", "1 / (2 - 2)", "", nil, pipeline.ErrBadInput},
+		{"modulo by zero", "1 % 0", "", nil, pipeline.ErrBadInput},
+		{"non-integer exponent", "pow(2, 0.5)", "", nil, pipeline.ErrBadInput},
+		{"huge exponent", "pow(2, 100000)", "", nil, pipeline.ErrBadInput},
+		{"non-integer places", "round(2.5, 0.5)", "", nil, pipeline.ErrBadInput},
+		{"huge places", "round(2.5, 1000000000)", "", nil, pipeline.ErrBadInput},
+		{"huge negative places", "round(2.5, -10000000000)", "", nil, pipeline.ErrBadInput},
+		{"bool arithmetic", "true + 1", "", nil, pipeline.ErrBadInput},
+		{"number condition", "1 ? 2 : 3", "", nil, pipeline.ErrBadInput},
+		{"compare bool and number", "true == 1", "", nil, pipeline.ErrBadInput},
+		{"missing variable", "missing + 1", "", nil, pipeline.ErrKeypathNotFound},
+		{"non-numeric input", "a + 1", `{"a": "foo"}`, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:    pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expr:        test.expr,
+				NamedInputs: test.inputs,
+			}
+			result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+			if test.expectedErr != nil {
+				require.Error(t, result.Error)
+				assert.ErrorIs(t, result.Error, test.expectedErr)
+				return
+			}
+			require.NoError(t, result.Error)
+			if expected, is := test.expected.(*decimal.Decimal); is {
+				require.IsType(t, decimal.Decimal{}, result.Value)
+				assert.Equal(t, expected.String(), result.Value.(decimal.Decimal).String())
+			} else {
+				assert.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rejects invalid expressions", func(t *testing.T) {
+		for _, expr := range []string{
+			"1 +",
+			"(1 + 2",
+			"1 2",
+			"foo(1)",
+			"min()",
+			"abs(1, 2)",
+			"a ? b",
+			"$(ds1) + 1",
+			"1.2.3",
+		} {
+			_, err := pipeline.Parse(`a [type=expr expr="` + expr + `"]`)
+			assert.Error(t, err, expr)
+		}
+	})
+
+	t.Run("adds dependencies on the tasks used by the expression", func(t *testing.T) {
+		p, err := pipeline.Parse(`
+ds1 [type=memo value=10]
+ds2 [type=memo value=<{"spread": 0.5}>]
+a   [type=expr expr="ds1 * (1 + ds2.spread)"]
+`)
+		require.NoError(t, err)
+		for _, task := range p.Tasks {
+			if task.DotID() == "a" {
+				assert.Len(t, task.Inputs(), 2)
+			}
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// ForEachItemKey is the name of the variable holding the current element
+	// in each iteration of a foreach task
+	ForEachItemKey = "item"
+	// ForEachIndexKey is the name of the variable holding the index of the
+	// current element in each iteration of a foreach task
+	ForEachIndexKey = "index"
+)
+
+//
+// Return types:
+//     []interface{}
+//
+// Each element of the returned slice is the result of the nested pipeline
+// for the corresponding input element: the value of its terminal task (or a
+// slice of values if it has more than one), or an error if it failed.
+//
+type ForEachTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Values      string `json:"values"`
+	MaxParallel string `json:"maxParallel"`
+	Pipeline    string `json:"pipeline"`
+
+	runner *runner
+	spec   Spec
+
+	mu         sync.Mutex
+	iterations []TaskRunResults
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+func (t *ForEachTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		values      SliceParam
+		maxParallel MaybeUint64Param
+		source      StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Input(inputs, 0))), "values"),
+		errors.Wrap(ResolveParam(&maxParallel, From(t.MaxParallel)), "maxParallel"),
+		errors.Wrap(ResolveParam(&source, From(NonemptyString(t.Pipeline))), "pipeline"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	// Parse once up front so that a malformed nested pipeline fails the task
+	// instead of every element
+	pipelineSource := unquoteDOTString(string(source))
+	nested, err := Parse(pipelineSource)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "pipeline")}, runInfo
+	}
+	if nested.RequiresPreInsert() {
+		return Result{Error: errors.Wrap(ErrBadInput, "pipeline: async tasks are not supported in a foreach pipeline")}, runInfo
+	}
+
+	parallel := len(values)
+	if max, isSet := maxParallel.Uint64(); isSet && max > 0 && int(max) < parallel {
+		parallel = int(max)
+	}
+
+	results := make([]interface{}, len(values))
+	iterations := make([]TaskRunResults, len(values))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, value := range values {
+		select {
+		case <-ctx.Done():
+			results[i] = errors.Wrap(ctx.Err(), "foreach cancelled")
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, value interface{}) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			iterationVars := vars.Copy()
+			if err := multierr.Combine(
+				iterationVars.Set(ForEachItemKey, value),
+				iterationVars.Set(ForEachIndexKey, i),
+			); err != nil {
+				results[i] = err
+				return
+			}
+
+			trrs, err := t.runner.executeNestedRun(ctx, t.spec, pipelineSource, iterationVars, lggr.With("index", i))
+			iterations[i] = trrs
+			if err != nil {
+				results[i] = err
+				return
+			}
+			results[i] = trrs.terminalValue()
+		}(i, value)
+	}
+	wg.Wait()
+
+	t.mu.Lock()
+	t.iterations = iterations
+	t.mu.Unlock()
+
+	return Result{Value: results}, runInfo
+}
+
+// Iterations returns the task run results of each element's nested pipeline,
+// in element order, once the task has run.
+func (t *ForEachTask) Iterations() []TaskRunResults {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.iterations
+}
+
+// terminalValue returns the value of the terminal task of a nested run, a
+// slice of values if there are several terminal tasks, or the first error
+// among them.
+func (trrs TaskRunResults) terminalValue() interface{} {
+	var terminals TaskRunResults
+	for _, trr := range trrs {
+		if trr.IsTerminal() {
+			terminals = append(terminals, trr)
+		}
+	}
+	sort.Slice(terminals, func(i, j int) bool {
+		return terminals[i].Task.OutputIndex() < terminals[j].Task.OutputIndex()
+	})
+
+	var values []interface{}
+	for _, trr := range terminals {
+		if trr.Result.Error != nil {
+			return trr.Result.Error
+		}
+		values = append(values, trr.Result.Value)
+	}
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+// unquoteDOTString removes the quotes around a multi-line DOT string, which
+// are kept by the DOT parser (single-line strings are unquoted by the parser
+// already).
+func unquoteDOTString(s string) string {
+	trimmed := strings.TrimSpace(s)
+	if len(trimmed) < 2 || trimmed[0] != '"' || trimmed[len(trimmed)-1] != '"' {
+		return s
+	}
+	inner := trimmed[1 : len(trimmed)-1]
+	// e.g. `"a" -> "b"` starts and ends with quotes but is not a quoted string
+	if strings.Contains(strings.ReplaceAll(inner, `\"`, ""), `"`) {
+		return s
+	}
+	inner = strings.ReplaceAll(inner, "\\\n", "")
+	return strings.ReplaceAll(inner, `\"`, `"`)
+}
+
+// nestedTaskRunDotID returns the dot ID recorded for a task of a nested
+// pipeline, e.g. "fetch[2].parse". Because "[" is not valid in
+// a DOT ID it can never collide with a task of the outer pipeline.
+func nestedTaskRunDotID(parentDotID string, index int, dotID string) string {
+	return fmt.Sprintf("%s[%d].%s", parentDotID, index, dotID)
+}
+
+// isNestedTaskRunDotID returns true if the dot ID belongs to a task run of a
+// nested pipeline.
+func isNestedTaskRunDotID(dotID string) bool {
+	return strings.Contains(dotID, "[")
+}
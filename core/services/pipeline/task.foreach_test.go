@@ -0,0 +1,108 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestForEachTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), cfg)
+	lggr := logger.TestLogger(t)
+
+	t.Run("runs the nested pipeline once per element", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+fetch [type=foreach values="$(vals)" maxParallel=2 pipeline="
+	mul [type=multiply input=\"$(item)\" times=2];
+	add [type=sum values=<[ $(mul), $(index) ]>];
+	mul -> add;
+"]
+median [type=median values="$(fetch)"]
+fetch -> median
+`,
+		}, pipeline.NewVarsFrom(map[string]interface{}{"vals": []interface{}{1, 2, 3, 4, 5}}), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 2)
+
+		result, err := trrs.FinalResult(lggr).SingularResult()
+		require.NoError(t, err)
+		// 2+0, 4+1, 6+2, 8+3, 10+4
+		assert.Equal(t, "8", result.Value.(decimal.Decimal).String())
+
+		for _, trr := range trrs {
+			if trr.Task.Type() != pipeline.TaskTypeForEach {
+				continue
+			}
+			values := trr.Result.Value.([]interface{})
+			require.Len(t, values, 5)
+			assert.Equal(t, "2", values[0].(decimal.Decimal).String())
+			assert.Equal(t, "14", values[4].(decimal.Decimal).String())
+		}
+
+		// 2 outer task runs, and 2 task runs per element
+		require.Len(t, run.PipelineTaskRuns, 12)
+		dotIDs := make(map[string]bool)
+		for _, taskRun := range run.PipelineTaskRuns {
+			dotIDs[taskRun.DotID] = true
+		}
+		for _, dotID := range []string{"fetch", "median", "fetch[0].mul", "fetch[0].add", "fetch[4].mul", "fetch[4].add"} {
+			assert.True(t, dotIDs[dotID], "missing task run %s", dotID)
+		}
+	})
+
+	t.Run("returns per element errors", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+fetch [type=foreach values=<[ 2, "foo", 8 ]> pipeline="div [type=divide input=\"$(item)\" divisor=2]"]
+median [type=median values="$(fetch)" allowedFaults=1]
+fetch -> median
+`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		for _, trr := range trrs {
+			switch trr.Task.Type() {
+			case pipeline.TaskTypeForEach:
+				require.NoError(t, trr.Result.Error)
+				values := trr.Result.Value.([]interface{})
+				require.Len(t, values, 3)
+				assert.Equal(t, "1", values[0].(decimal.Decimal).String())
+				assert.Error(t, values[1].(error))
+				assert.Equal(t, "4", values[2].(decimal.Decimal).String())
+			case pipeline.TaskTypeMedian:
+				require.NoError(t, trr.Result.Error)
+				assert.Equal(t, "2.5", trr.Result.Value.(decimal.Decimal).String())
+			}
+		}
+	})
+
+	t.Run("errors on an invalid nested pipeline", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `fetch [type=foreach values=<[ 1, 2 ]> pipeline="a [type=nonexistent]"]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Error(t, trrs[0].Result.Error)
+	})
+
+	t.Run("rejects async tasks in the nested pipeline", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `fetch [type=foreach values=<[ 1, 2 ]> pipeline="a [type=ethtx]"]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.ErrorIs(t, trrs[0].Result.Error, pipeline.ErrBadInput)
+	})
+}
@@ -23,11 +23,13 @@ type HTTPTask struct {
 	Method                         string
 	URL                            string
 	RequestData                    string `json:"requestData"`
+	Headers                        string `json:"headers"`
 	AllowUnrestrictedNetworkAccess string
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	circuitBreakers        *HostCircuitBreakers
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -61,12 +63,14 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		method                         StringParam
 		url                            URLParam
 		requestData                    MapParam
+		headers                        MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
 		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
+		errors.Wrap(ResolveParam(&headers, From(VarExpr(t.Headers, vars), JSONWithVarExprs(t.Headers, vars, false), nil)), "headers"),
 		// Any hardcoded strings used for URL uses the unrestricted HTTP adapter
 		// Interpolated variable URLs use restricted HTTP adapter by default
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
@@ -96,7 +100,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, requestData, client, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, requestData, headers, client, t.config, t.circuitBreakers, nil)
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, "connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess=true in the pipeline task spec")
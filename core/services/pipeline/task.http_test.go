@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -306,3 +308,61 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Contains(t, result.Error.Error(), "RequestId")
 	require.Nil(t, result.Value)
 }
+
+func TestHTTPTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	timeout := 100 * time.Millisecond
+	circuitBreakers := pipeline.NewHostCircuitBreakers(2, timeout)
+
+	var requests, failing int32 = 0, 1
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if atomic.LoadInt32(&failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, err := w.Write([]byte(`{"result": 1}`))
+		require.NoError(t, err)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task := pipeline.HTTPTask{Method: "GET", URL: server.URL}
+	task.HelperSetDependencies(config, c, c)
+	task.HelperSetCircuitBreakers(circuitBreakers)
+	run := func() pipeline.Result {
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	// Opens after 2 consecutive failures
+	for i := 0; i < 2; i++ {
+		require.Contains(t, run().Error.Error(), "status code 503")
+	}
+	assert.Equal(t, pipeline.CircuitBreakerOpen, circuitBreakers.State(serverURL.Host))
+
+	result := run()
+	require.True(t, errors.Is(result.Error, pipeline.ErrCircuitBreakerOpen))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// A failed probe opens it again
+	time.Sleep(timeout)
+	assert.Equal(t, pipeline.CircuitBreakerHalfOpen, circuitBreakers.State(serverURL.Host))
+	require.Contains(t, run().Error.Error(), "status code 503")
+	assert.Equal(t, pipeline.CircuitBreakerOpen, circuitBreakers.State(serverURL.Host))
+	require.True(t, errors.Is(run().Error, pipeline.ErrCircuitBreakerOpen))
+
+	// A successful probe closes it
+	atomic.StoreInt32(&failing, 0)
+	time.Sleep(timeout)
+	result = run()
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"result": 1}`, result.Value)
+	assert.Equal(t, pipeline.CircuitBreakerClosed, circuitBreakers.State(serverURL.Host))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
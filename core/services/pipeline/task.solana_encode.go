@@ -0,0 +1,231 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []byte
+//
+// SolanaEncodeTask encodes the data of a Solana instruction, to be sent to a
+// program by a soltx task. The arguments are Borsh-encoded in the order of
+// the ABI string, e.g. `submit(u64 roundId, i128 answer, pubkey feed)`. If the
+// ABI string has a method name, the data is prefixed with its Anchor
+// discriminator, the first 8 bytes of sha256("global:<method>").
+//
+// Supported types are u8 to u128, i8 to i128, bool, string, bytes and pubkey.
+type SolanaEncodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	ABI      string `json:"abi"`
+	Data     string `json:"data"`
+}
+
+var _ Task = (*SolanaEncodeTask)(nil)
+
+func (t *SolanaEncodeTask) Type() TaskType {
+	return TaskTypeSolanaEncode
+}
+
+func (t *SolanaEncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		inputValues MapParam
+		theABI      StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&inputValues, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false), nil)), "data"),
+		errors.Wrap(ResolveParam(&theABI, From(NonemptyString(t.ABI))), "abi"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	method, args, err := parseSolanaABIString(string(theABI))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "SolanaEncode: while parsing ABI string: %v", err)}, runInfo
+	}
+
+	var data []byte
+	if method != "" {
+		discriminator := sha256.Sum256([]byte("global:" + method))
+		data = append(data, discriminator[:8]...)
+	}
+	for _, arg := range args {
+		val, exists := inputValues[arg.name]
+		if !exists {
+			return Result{Error: errors.Wrapf(ErrBadInput, "SolanaEncode: argument '%v' is missing", arg.name)}, runInfo
+		}
+		data, err = appendBorsh(data, arg.typ, val)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "SolanaEncode: while encoding argument '%v' as %v: %v", arg.name, arg.typ, err)}, runInfo
+		}
+	}
+	return Result{Value: data}, runInfo
+}
+
+var solanaABIRegex = regexp.MustCompile(`^\s*([a-zA-Z_][a-zA-Z0-9_]*)?\s*\((.*)\)\s*$`)
+
+type solanaABIArg struct {
+	typ  string
+	name string
+}
+
+func parseSolanaABIString(theABI string) (method string, args []solanaABIArg, err error) {
+	matches := solanaABIRegex.FindStringSubmatch(theABI)
+	if matches == nil {
+		return "", nil, errors.Errorf("bad ABI specification: %s", theABI)
+	}
+	method = matches[1]
+	if strings.TrimSpace(matches[2]) == "" {
+		return method, nil, nil
+	}
+	for _, argStr := range strings.Split(matches[2], ",") {
+		parts := strings.Fields(argStr)
+		if len(parts) != 2 {
+			return "", nil, errors.Errorf("bad ABI argument, expected '<type> <name>': %s", strings.TrimSpace(argStr))
+		}
+		if _, _, err = solanaIntegerSize(parts[0]); err != nil && !isSolanaNonIntegerType(parts[0]) {
+			return "", nil, errors.Errorf("unsupported type: %s", parts[0])
+		}
+		args = append(args, solanaABIArg{typ: parts[0], name: parts[1]})
+	}
+	return method, args, nil
+}
+
+func isSolanaNonIntegerType(typ string) bool {
+	switch typ {
+	case "bool", "string", "bytes", "pubkey":
+		return true
+	}
+	return false
+}
+
+// solanaIntegerSize returns the size in bits of an integer type like u64 or
+// i128, and whether it is signed.
+func solanaIntegerSize(typ string) (bits int, signed bool, err error) {
+	if len(typ) < 2 || (typ[0] != 'u' && typ[0] != 'i') {
+		return 0, false, errors.Errorf("not an integer type: %s", typ)
+	}
+	bits, err = strconv.Atoi(typ[1:])
+	if err != nil || (bits != 8 && bits != 16 && bits != 32 && bits != 64 && bits != 128) {
+		return 0, false, errors.Errorf("not an integer type: %s", typ)
+	}
+	return bits, typ[0] == 'i', nil
+}
+
+func appendBorsh(data []byte, typ string, val interface{}) ([]byte, error) {
+	switch typ {
+	case "bool":
+		var b BoolParam
+		if err := b.UnmarshalPipelineParam(val); err != nil {
+			return nil, err
+		}
+		if b {
+			return append(data, 1), nil
+		}
+		return append(data, 0), nil
+
+	case "string", "bytes":
+		var b BytesParam
+		if typ == "string" {
+			var s StringParam
+			if err := s.UnmarshalPipelineParam(val); err != nil {
+				return nil, err
+			}
+			b = BytesParam(s)
+		} else if err := b.UnmarshalPipelineParam(val); err != nil {
+			return nil, err
+		}
+		var length [4]byte
+		binary.LittleEndian.PutUint32(length[:], uint32(len(b)))
+		return append(append(data, length[:]...), b...), nil
+
+	case "pubkey":
+		switch v := val.(type) {
+		case string:
+			key, err := solanaGo.PublicKeyFromBase58(v)
+			if err != nil {
+				return nil, err
+			}
+			return append(data, key[:]...), nil
+		case []byte:
+			if len(v) != solanaGo.PublicKeyLength {
+				return nil, errors.Errorf("expected %d bytes, got %d", solanaGo.PublicKeyLength, len(v))
+			}
+			return append(data, v...), nil
+		case solanaGo.PublicKey:
+			return append(data, v[:]...), nil
+		}
+		return nil, errors.Errorf("expected base58 string or bytes, got %T", val)
+	}
+
+	bits, signed, err := solanaIntegerSize(typ)
+	if err != nil {
+		return nil, err
+	}
+	n, err := solanaBigInt(val)
+	if err != nil {
+		return nil, err
+	}
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return nil, errors.Errorf("%s out of range", n)
+	}
+	// Two's complement, little-endian
+	if n.Sign() < 0 {
+		n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+	be := n.FillBytes(make([]byte, bits/8))
+	for i := len(be) - 1; i >= 0; i-- {
+		data = append(data, be[i])
+	}
+	return data, nil
+}
+
+func solanaBigInt(val interface{}) (*big.Int, error) {
+	switch v := val.(type) {
+	case float64:
+		val = decimal.NewFromFloat(v)
+	case string:
+		if d, err := decimal.NewFromString(v); err == nil {
+			val = d
+		}
+	}
+	if d, ok := val.(decimal.Decimal); ok {
+		if !d.Equal(d.Truncate(0)) {
+			return nil, errors.Errorf("%s is not an integer", d)
+		}
+		return d.BigInt(), nil
+	}
+
+	var p MaybeBigIntParam
+	if err := p.UnmarshalPipelineParam(val); err != nil {
+		return nil, errors.Errorf("expected integer, got %T", val)
+	}
+	if p.BigInt() == nil {
+		return nil, errors.New("expected integer, got empty string")
+	}
+	return p.BigInt(), nil
+}
@@ -0,0 +1,116 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestSolanaEncodeTask(t *testing.T) {
+	feed := solanaGo.MustPublicKeyFromBase58("7Y9uW1VUnjk5dLPKTXQBS8VVuCCKYFK5nNgwnXzUXGcK")
+
+	tests := []struct {
+		name                  string
+		abi                   string
+		data                  string
+		vars                  pipeline.Vars
+		expected              string
+		expectedErrorCause    error
+		expectedErrorContains string
+	}{
+		{
+			"method, integers, bool and string",
+			"submit(u64 roundId, i16 answer, bool ok, string note)",
+			`{ "roundId": $(roundId), "answer": $(answer), "ok": true, "note": "hi" }`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"roundId": big.NewInt(1),
+				"answer":  decimal.NewFromInt(-2),
+			}),
+			"58a666b5a27faa30" + "0100000000000000" + "feff" + "01" + "020000006869",
+			nil,
+			"",
+		},
+		{
+			"no method, i128, bytes and pubkey",
+			"(i128 answer, bytes data, pubkey feed)",
+			`{ "answer": $(answer), "data": "0x0102", "feed": $(feed) }`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"answer": "-1",
+				"feed":   feed.String(),
+			}),
+			"ffffffffffffffffffffffffffffffff" + "020000000102" + hex.EncodeToString(feed[:]),
+			nil,
+			"",
+		},
+		{
+			"integer out of range",
+			"(u8 n)",
+			`{ "n": 256 }`,
+			pipeline.NewVarsFrom(nil),
+			"",
+			pipeline.ErrBadInput,
+			"out of range",
+		},
+		{
+			"not an integer",
+			"(u64 n)",
+			`{ "n": 1.5 }`,
+			pipeline.NewVarsFrom(nil),
+			"",
+			pipeline.ErrBadInput,
+			"not an integer",
+		},
+		{
+			"missing argument",
+			"(u64 n, bool b)",
+			`{ "n": 1 }`,
+			pipeline.NewVarsFrom(nil),
+			"",
+			pipeline.ErrBadInput,
+			"argument 'b' is missing",
+		},
+		{
+			"unsupported type",
+			"(u256 n)",
+			`{ "n": 1 }`,
+			pipeline.NewVarsFrom(nil),
+			"",
+			pipeline.ErrBadInput,
+			"unsupported type: u256",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.SolanaEncodeTask{
+				BaseTask: pipeline.NewBaseTask(0, "solencode", nil, nil, 0),
+				ABI:      test.abi,
+				Data:     test.data,
+			}
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.expectedErrorCause != nil {
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, hex.EncodeToString(result.Value.([]byte)))
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package pipeline
+
+import (
+	"context"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
+)
+
+//
+// Return types:
+//     string
+//
+// SolanaTxTask sends a transaction with one instruction, e.g. with data
+// encoded by a solencode task, to a Solana program. The fee payer and the
+// signer accounts must be Solana keys of the node. It returns the signature
+// of the fee payer, which identifies the transaction.
+//
+// Accounts are a list of `{"publicKey": "<base58>", "isSigner": bool, "isWritable": bool}`.
+type SolanaTxTask struct {
+	BaseTask      `mapstructure:",squash"`
+	From          string `json:"from"`
+	ProgramID     string `json:"programID" mapstructure:"programID"`
+	Accounts      string `json:"accounts"`
+	Data          string `json:"data"`
+	SolanaChainID string `json:"solanaChainID" mapstructure:"solanaChainID"`
+
+	keyStore SolanaKeyStore
+	chainSet solana.ChainSet
+}
+
+//go:generate mockery --name SolanaKeyStore --output ./mocks/ --case=underscore
+
+type SolanaKeyStore interface {
+	Get(id string) (solkey.Key, error)
+}
+
+var _ Task = (*SolanaTxTask)(nil)
+
+func (t *SolanaTxTask) Type() TaskType {
+	return TaskTypeSolanaTx
+}
+
+func (t *SolanaTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		from      StringParam
+		programID StringParam
+		accounts  SliceParam
+		data      BytesParam
+		chainID   StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From))), "from"),
+		errors.Wrap(ResolveParam(&programID, From(VarExpr(t.ProgramID, vars), NonemptyString(t.ProgramID))), "programID"),
+		errors.Wrap(ResolveParam(&accounts, From(VarExpr(t.Accounts, vars), JSONWithVarExprs(t.Accounts, vars, false), nil)), "accounts"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), BytesParam{})), "data"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.SolanaChainID, vars), NonemptyString(t.SolanaChainID))), "solanaChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	payer, err := solanaGo.PublicKeyFromBase58(string(from))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "from: %v", err)}, runInfo
+	}
+	program, err := solanaGo.PublicKeyFromBase58(string(programID))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "programID: %v", err)}, runInfo
+	}
+	accountMetas, err := decodeSolanaAccounts(accounts)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "accounts: %v", err)}, runInfo
+	}
+
+	if t.chainSet == nil {
+		return Result{Error: errors.New("Solana is not enabled")}, runInfo
+	}
+	chain, err := t.chainSet.Chain(ctx, string(chainID))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to get chain by id: %v", chainID)}, retryableRunInfo()
+	}
+	reader, err := chain.Reader()
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "chain unreachable")}, retryableRunInfo()
+	}
+	blockhash, err := reader.LatestBlockhash()
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to get latest block hash")}, retryableRunInfo()
+	}
+
+	tx, err := solanaGo.NewTransaction(
+		[]solanaGo.Instruction{solanaGo.NewInstruction(program, accountMetas, data)},
+		blockhash.Value.Blockhash,
+		solanaGo.TransactionPayer(payer),
+	)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "failed to create tx: %v", err)}, runInfo
+	}
+
+	msg, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to marshal tx")}, runInfo
+	}
+	// The fee payer is the first signer
+	for _, signer := range tx.Message.Signers() {
+		key, err := t.keyStore.Get(signer.String())
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "failed to get key for signer %s: %v", signer, err)}, runInfo
+		}
+		sigBytes, err := key.Sign(msg)
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "failed to sign tx with key %s", signer)}, runInfo
+		}
+		var sig solanaGo.Signature
+		copy(sig[:], sigBytes)
+		tx.Signatures = append(tx.Signatures, sig)
+	}
+
+	if err = chain.TxManager().Enqueue("", tx); err != nil {
+		err = errors.Wrapf(ErrTaskRunFailed, "while enqueueing transaction: %v", err)
+		lggr.Error(err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: tx.Signatures[0].String()}, runInfo
+}
+
+func decodeSolanaAccounts(accounts SliceParam) (solanaGo.AccountMetaSlice, error) {
+	var metas solanaGo.AccountMetaSlice
+	for i, account := range accounts {
+		var m MapParam
+		if err := m.UnmarshalPipelineParam(account); err != nil {
+			return nil, errors.Wrapf(err, "account %d", i)
+		}
+		pubKeyStr, ok := m["publicKey"].(string)
+		if !ok {
+			return nil, errors.Errorf("account %d: publicKey must be a base58 string", i)
+		}
+		pubKey, err := solanaGo.PublicKeyFromBase58(pubKeyStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "account %d", i)
+		}
+		var isSigner, isWritable BoolParam
+		if m["isSigner"] != nil {
+			if err = isSigner.UnmarshalPipelineParam(m["isSigner"]); err != nil {
+				return nil, errors.Wrapf(err, "account %d: isSigner", i)
+			}
+		}
+		if m["isWritable"] != nil {
+			if err = isWritable.UnmarshalPipelineParam(m["isWritable"]); err != nil {
+				return nil, errors.Wrapf(err, "account %d: isWritable", i)
+			}
+		}
+		metas = append(metas, &solanaGo.AccountMeta{PublicKey: pubKey, IsSigner: bool(isSigner), IsWritable: bool(isWritable)})
+	}
+	return metas, nil
+}
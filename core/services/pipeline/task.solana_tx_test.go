@@ -0,0 +1,119 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	solanaGo "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	solanamocks "github.com/smartcontractkit/chainlink/core/chains/solana/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestSolanaTxTask(t *testing.T) {
+	payer, err := solkey.New()
+	require.NoError(t, err)
+	signer, err := solkey.New()
+	require.NoError(t, err)
+	program := solanaGo.MustPublicKeyFromBase58("7Y9uW1VUnjk5dLPKTXQBS8VVuCCKYFK5nNgwnXzUXGcK")
+	account := solanaGo.MustPublicKeyFromBase58("9xQeWvG816bUx9EPjHmaT23yvVM2ZWbrrpZb9PusVFin")
+	blockhash := solanaGo.MustHashFromBase58("EkSnNWid2cvwEVnVx9aBqawnmiCNiDgp3gUdkDPTKN1N")
+
+	newTask := func(accounts string) pipeline.SolanaTxTask {
+		return pipeline.SolanaTxTask{
+			BaseTask:      pipeline.NewBaseTask(0, "soltx", nil, nil, 0),
+			From:          "$(from)",
+			ProgramID:     program.String(),
+			Accounts:      accounts,
+			Data:          "$(data)",
+			SolanaChainID: "localnet",
+		}
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"from": payer.PublicKeyStr(),
+		"data": []byte{1, 2, 3},
+	})
+
+	t.Run("sends a transaction signed by the payer and signers", func(t *testing.T) {
+		chainSet := solanamocks.NewChainSet(t)
+		chain := solanamocks.NewChain(t)
+		reader := solanamocks.NewReader(t)
+		txManager := solanamocks.NewTxManager(t)
+		keyStore := new(mocks.SolanaKeyStore)
+		chainSet.On("Chain", mock.Anything, "localnet").Return(chain, nil)
+		chain.On("Reader").Return(reader, nil)
+		chain.On("TxManager").Return(txManager)
+		reader.On("LatestBlockhash").Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{Blockhash: blockhash}}, nil)
+		keyStore.On("Get", payer.PublicKeyStr()).Return(payer, nil)
+		keyStore.On("Get", signer.PublicKeyStr()).Return(signer, nil)
+
+		var sent *solanaGo.Transaction
+		txManager.On("Enqueue", "", mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).(*solanaGo.Transaction)
+		}).Return(nil)
+
+		task := newTask(`[
+			{ "publicKey": "` + signer.PublicKeyStr() + `", "isSigner": true },
+			{ "publicKey": "` + account.String() + `", "isWritable": true }
+		]`)
+		task.HelperSetDependencies(chainSet, keyStore)
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+
+		require.NotNil(t, sent)
+		require.Equal(t, sent.Signatures[0].String(), result.Value)
+		require.NoError(t, sent.VerifySignatures())
+		require.Len(t, sent.Signatures, 2)
+		require.Equal(t, payer.PublicKey(), sent.Message.AccountKeys[0])
+		require.Equal(t, blockhash, sent.Message.RecentBlockhash)
+		require.Len(t, sent.Message.Instructions, 1)
+		require.Equal(t, solanaGo.Base58{1, 2, 3}, sent.Message.Instructions[0].Data)
+		keyStore.AssertExpectations(t)
+	})
+
+	t.Run("signer key not found", func(t *testing.T) {
+		chainSet := solanamocks.NewChainSet(t)
+		chain := solanamocks.NewChain(t)
+		reader := solanamocks.NewReader(t)
+		keyStore := new(mocks.SolanaKeyStore)
+		chainSet.On("Chain", mock.Anything, "localnet").Return(chain, nil)
+		chain.On("Reader").Return(reader, nil)
+		reader.On("LatestBlockhash").Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{Blockhash: blockhash}}, nil)
+		keyStore.On("Get", payer.PublicKeyStr()).Return(payer, nil)
+		keyStore.On("Get", account.String()).Return(solkey.Key{}, errors.New("not found"))
+
+		task := newTask(`[{ "publicKey": "` + account.String() + `", "isSigner": true }]`)
+		task.HelperSetDependencies(chainSet, keyStore)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "failed to get key for signer")
+	})
+
+	t.Run("bad account", func(t *testing.T) {
+		task := newTask(`[{ "publicKey": 1 }]`)
+		task.HelperSetDependencies(solanamocks.NewChainSet(t), new(mocks.SolanaKeyStore))
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "publicKey must be a base58 string")
+	})
+
+	t.Run("solana disabled", func(t *testing.T) {
+		task := newTask("")
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.EqualError(t, result.Error, "Solana is not enabled")
+	})
+}
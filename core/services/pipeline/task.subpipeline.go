@@ -0,0 +1,115 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// MaxSubpipelineDepth is the maximum number of subpipeline tasks which may be
+// nested in each other, so that templates calling each other cannot recurse
+// forever.
+const MaxSubpipelineDepth = 10
+
+type subpipelineDepthKey struct{}
+
+//
+// Return types:
+//     interface{}
+//
+// The value of the terminal task of the template's pipeline, or a slice of
+// values if it has more than one terminal task.
+//
+type SubpipelineTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Template       string `json:"template"`
+	Version        string `json:"version"`
+	TemplateInputs string `json:"inputs" mapstructure:"inputs"`
+
+	runner *runner
+	spec   Spec
+
+	mu         sync.Mutex
+	iterations []TaskRunResults
+}
+
+var _ Task = (*SubpipelineTask)(nil)
+
+func (t *SubpipelineTask) Type() TaskType {
+	return TaskTypeSubpipeline
+}
+
+func (t *SubpipelineTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		name          StringParam
+		version       MaybeInt32Param
+		templateInput MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Template))), "template"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), t.Version)), "version"),
+		errors.Wrap(ResolveParam(&templateInput, From(VarExpr(t.TemplateInputs, vars), JSONWithVarExprs(t.TemplateInputs, vars, false), nil)), "inputs"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	depth, _ := ctx.Value(subpipelineDepthKey{}).(int)
+	if depth >= MaxSubpipelineDepth {
+		return Result{Error: errors.Errorf("subpipeline tasks are nested more than %d deep", MaxSubpipelineDepth)}, runInfo
+	}
+	ctx = context.WithValue(ctx, subpipelineDepthKey{}, depth+1)
+
+	v, _ := version.Int32()
+	template, err := t.runner.orm.FindTemplate(string(name), v, pg.WithParentCtx(ctx))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "template %s", name)}, runInfo
+	}
+
+	// The template only sees its own inputs, not the variables of the caller
+	templateVars := NewVarsFrom(nil)
+	templateVars.secrets = vars.secrets
+	for _, param := range template.Parameters {
+		value, exists := templateInput[param]
+		if !exists {
+			return Result{Error: errors.Wrapf(ErrBadInput, "template %s version %d: missing input %q", template.Name, template.Version, param)}, runInfo
+		}
+		if err = templateVars.Set(param, value); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	lggr = lggr.With("template", template.Name, "templateVersion", template.Version)
+	trrs, err := t.runner.executeNestedRun(ctx, t.spec, template.DotDagSource, templateVars, lggr)
+
+	t.mu.Lock()
+	t.iterations = []TaskRunResults{trrs}
+	t.mu.Unlock()
+
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	value := trrs.terminalValue()
+	if err, is := value.(error); is {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// Iterations returns the task run results of the template's pipeline, once
+// the task has run.
+func (t *SubpipelineTask) Iterations() []TaskRunResults {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.iterations
+}
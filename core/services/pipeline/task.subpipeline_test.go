@@ -0,0 +1,112 @@
+package pipeline_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestSubpipelineTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, orm := newRunner(t, pgtest.NewSqlxDB(t), cfg)
+	lggr := logger.TestLogger(t)
+
+	orm.On("FindTemplate", "scale", int32(0), mock.Anything).Return(pipeline.Template{
+		Name:       "scale",
+		Version:    2,
+		Parameters: []string{"value", "times"},
+		DotDagSource: `
+mul [type=multiply input="$(value)" times="$(times)"]
+add [type=sum values=<[ $(mul), 1 ]>]
+mul -> add
+`,
+	}, nil)
+	orm.On("FindTemplate", "scale", int32(1), mock.Anything).Return(pipeline.Template{
+		Name:         "scale",
+		Version:      1,
+		Parameters:   []string{"value", "times"},
+		DotDagSource: `mul [type=multiply input="$(value)" times="$(times)"]`,
+	}, nil)
+	orm.On("FindTemplate", "recurse", int32(0), mock.Anything).Return(pipeline.Template{
+		Name:         "recurse",
+		Version:      1,
+		DotDagSource: `sub [type=subpipeline template=recurse]`,
+	}, nil)
+	orm.On("FindTemplate", "missing", int32(0), mock.Anything).Return(pipeline.Template{}, errors.Wrap(sql.ErrNoRows, "FindTemplate failed"))
+
+	t.Run("runs the latest version of the template", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+a   [type=memo value=3]
+sub [type=subpipeline template=scale inputs=<{"value": $(a), "times": 2}>]
+a -> sub
+`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult(lggr).SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "7", result.Value.(decimal.Decimal).String())
+
+		// 2 outer task runs, and the 2 task runs of the template
+		require.Len(t, run.PipelineTaskRuns, 4)
+		dotIDs := make(map[string]bool)
+		for _, taskRun := range run.PipelineTaskRuns {
+			dotIDs[taskRun.DotID] = true
+		}
+		for _, dotID := range []string{"a", "sub", "sub[0].mul", "sub[0].add"} {
+			assert.True(t, dotIDs[dotID], "missing task run %s", dotID)
+		}
+	})
+
+	t.Run("runs a version of the template", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `sub [type=subpipeline template=scale version=1 inputs=<{"value": 3, "times": 2}>]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult(lggr).SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "6", result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("errors on a missing input", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `sub [type=subpipeline template=scale inputs=<{"value": 3}>]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.ErrorIs(t, trrs[0].Result.Error, pipeline.ErrBadInput)
+	})
+
+	t.Run("errors on a missing template", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `sub [type=subpipeline template=missing]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.ErrorIs(t, trrs[0].Result.Error, sql.ErrNoRows)
+	})
+
+	t.Run("errors on unbounded recursion", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `sub [type=subpipeline template=recurse]`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Contains(t, trrs[0].Result.Error.Error(), "nested more than 10 deep")
+	})
+}
@@ -0,0 +1,81 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     string
+//
+// TerraEncodeTask encodes a CosmWasm execute message, i.e. `{"<method>": <args>}`,
+// to be sent to a Terra contract by a terratx task. Byte arrays are encoded
+// as base64 and big integers as decimal strings, like the CosmWasm Binary and
+// Uint128 types.
+type TerraEncodeTask struct {
+	BaseTask `mapstructure:",squash"`
+	Method   string `json:"method"`
+	Args     string `json:"args"`
+}
+
+var _ Task = (*TerraEncodeTask)(nil)
+
+func (t *TerraEncodeTask) Type() TaskType {
+	return TaskTypeTerraEncode
+}
+
+func (t *TerraEncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		method StringParam
+		args   MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&method, From(VarExpr(t.Method, vars), NonemptyString(t.Method))), "method"),
+		errors.Wrap(ResolveParam(&args, From(VarExpr(t.Args, vars), JSONWithVarExprs(t.Args, vars, false), MapParam{})), "args"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if args == nil {
+		args = MapParam{}
+	}
+
+	msg, err := json.Marshal(map[string]interface{}{string(method): terraJSONValue(args.Map())})
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "TerraEncode: could not encode message: %v", err)}, runInfo
+	}
+	return Result{Value: string(msg)}, runInfo
+}
+
+func terraJSONValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case *big.Int:
+		return v.String()
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = terraJSONValue(item)
+		}
+		return list
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = terraJSONValue(item)
+		}
+		return m
+	default:
+		return val
+	}
+}
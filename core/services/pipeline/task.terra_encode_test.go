@@ -0,0 +1,59 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestTerraEncodeTask(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		args     string
+		vars     pipeline.Vars
+		expected string
+	}{
+		{
+			"args",
+			"transmit",
+			`{ "round_id": $(roundID), "answer": $(answer), "report": $(report) }`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"roundID": 7,
+				"answer":  new(big.Int).Lsh(big.NewInt(1), 100),
+				"report":  []byte{1, 2, 3},
+			}),
+			`{"transmit":{"answer":"1267650600228229401496703205376","report":"AQID","round_id":7}}`,
+		},
+		{
+			"no args",
+			"$(method)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"method": "latest_round"}),
+			`{"latest_round":{}}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.TerraEncodeTask{
+				BaseTask: pipeline.NewBaseTask(0, "terraencode", nil, nil, 0),
+				Method:   test.method,
+				Args:     test.args,
+			}
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			require.JSONEq(t, test.expected, result.Value.(string))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     int64
+//
+// TerraTxTask sends an execute message, e.g. encoded by a terraencode task,
+// to a Terra contract from one of the node's Terra keys. It returns the ID of
+// the message in the Terra transaction manager.
+type TerraTxTask struct {
+	BaseTask     `mapstructure:",squash"`
+	From         string `json:"from"`
+	Contract     string `json:"contract"`
+	Msg          string `json:"msg"`
+	TerraChainID string `json:"terraChainID" mapstructure:"terraChainID"`
+
+	chainSet terra.ChainSet
+}
+
+var _ Task = (*TerraTxTask)(nil)
+
+func (t *TerraTxTask) Type() TaskType {
+	return TaskTypeTerraTx
+}
+
+func (t *TerraTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		from     StringParam
+		contract StringParam
+		msg      MapParam
+		chainID  StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&from, From(VarExpr(t.From, vars), NonemptyString(t.From))), "from"),
+		errors.Wrap(ResolveParam(&contract, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
+		errors.Wrap(ResolveParam(&msg, From(VarExpr(t.Msg, vars), JSONWithVarExprs(t.Msg, vars, false))), "msg"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.TerraChainID, vars), NonemptyString(t.TerraChainID))), "terraChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sender, err := sdk.AccAddressFromBech32(string(from))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "from: %v", err)}, runInfo
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(string(contract))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "contract: %v", err)}, runInfo
+	}
+	execMsg, err := json.Marshal(terraJSONValue(msg.Map()))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "msg: %v", err)}, runInfo
+	}
+
+	if t.chainSet == nil {
+		return Result{Error: errors.New("Terra is not enabled")}, runInfo
+	}
+	chain, err := t.chainSet.Chain(ctx, string(chainID))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to get chain by id: %v", chainID)}, retryableRunInfo()
+	}
+
+	// Enqueueing cancels the unstarted messages with the same contract ID, so
+	// each task run uses its own to not drop the messages of other runs
+	msgID, err := chain.TxManager().Enqueue(t.uuid.String(), wasmtypes.NewMsgExecuteContract(sender, contractAddr, execMsg, sdk.Coins{}))
+	if err != nil {
+		err = errors.Wrapf(ErrTaskRunFailed, "while enqueueing message: %v", err)
+		lggr.Error(err)
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: msgID}, runInfo
+}
@@ -0,0 +1,90 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	wasmtypes "github.com/terra-money/core/x/wasm/types"
+
+	terramocks "github.com/smartcontractkit/chainlink/core/chains/terra/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestTerraTxTask(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________")).String()
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	id := uuid.NewV4()
+
+	newTask := func(contract string) pipeline.TerraTxTask {
+		return pipeline.TerraTxTask{
+			BaseTask:     pipeline.NewBaseTask(0, "terratx", nil, nil, 0),
+			From:         sender,
+			Contract:     contract,
+			Msg:          "$(encode)",
+			TerraChainID: "$(chainID)",
+		}
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"encode":  `{"transmit":{"answer":"42"}}`,
+		"chainID": "Bombay-12",
+	})
+
+	t.Run("enqueues an execute message", func(t *testing.T) {
+		chainSet := terramocks.NewChainSet(t)
+		chain := terramocks.NewChain(t)
+		txManager := terramocks.NewTxManager(t)
+		chainSet.On("Chain", mock.Anything, "Bombay-12").Return(chain, nil)
+		chain.On("TxManager").Return(txManager)
+		txManager.On("Enqueue", id.String(), mock.MatchedBy(func(msg *wasmtypes.MsgExecuteContract) bool {
+			return msg.Sender == sender && msg.Contract == contract && string(msg.ExecuteMsg) == `{"transmit":{"answer":"42"}}`
+		})).Return(int64(5), nil)
+
+		task := newTask(contract)
+		task.HelperSetDependencies(chainSet, id)
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsPending)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		require.Equal(t, int64(5), result.Value)
+	})
+
+	t.Run("enqueue fails", func(t *testing.T) {
+		chainSet := terramocks.NewChainSet(t)
+		chain := terramocks.NewChain(t)
+		txManager := terramocks.NewTxManager(t)
+		chainSet.On("Chain", mock.Anything, "Bombay-12").Return(chain, nil)
+		chain.On("TxManager").Return(txManager)
+		txManager.On("Enqueue", id.String(), mock.Anything).Return(int64(0), errors.New("uh oh"))
+
+		task := newTask(contract)
+		task.HelperSetDependencies(chainSet, id)
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.True(t, runInfo.IsRetryable)
+		require.Equal(t, pipeline.ErrTaskRunFailed, errors.Cause(result.Error))
+	})
+
+	t.Run("bad contract address", func(t *testing.T) {
+		task := newTask("not an address")
+		task.HelperSetDependencies(terramocks.NewChainSet(t), id)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "contract")
+	})
+
+	t.Run("terra disabled", func(t *testing.T) {
+		task := newTask(contract)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.EqualError(t, result.Error, "Terra is not enabled")
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
@@ -701,3 +702,47 @@ func (p *MaybeBigIntParam) UnmarshalPipelineParam(val interface{}) error {
 func (p MaybeBigIntParam) BigInt() *big.Int {
 	return p.n
 }
+
+// BlockNumberParam is the block parameter of a JSON-RPC call: one of the tags
+// "latest", "pending" and "earliest", or a hex-encoded block number. It
+// accepts tags, and block numbers as integers or decimal or hex strings.
+type BlockNumberParam string
+
+const BlockNumberLatest BlockNumberParam = "latest"
+
+func (b *BlockNumberParam) UnmarshalPipelineParam(val interface{}) error {
+	if s, is := val.(string); is {
+		s = strings.TrimSpace(s)
+		switch tag := strings.ToLower(s); tag {
+		case "latest", "pending", "earliest":
+			*b = BlockNumberParam(tag)
+			return nil
+		}
+		if utils.HasHexPrefix(s) {
+			n, ok := big.NewInt(0).SetString(s[2:], 16)
+			if !ok {
+				return errors.Wrapf(ErrBadInput, "invalid block number %s", s)
+			}
+			val = n
+		}
+	}
+
+	var n MaybeBigIntParam
+	if err := n.UnmarshalPipelineParam(val); err != nil {
+		return errors.Wrapf(ErrBadInput, "invalid block number %v", val)
+	}
+	if n.BigInt() == nil || n.BigInt().Sign() < 0 {
+		return errors.Wrapf(ErrBadInput, "invalid block number %v", val)
+	}
+	*b = BlockNumberParam(hexutil.EncodeBig(n.BigInt()))
+	return nil
+}
+
+// BigInt returns the block number, or nil for a tag.
+func (b BlockNumberParam) BigInt() *big.Int {
+	n, err := hexutil.DecodeBig(string(b))
+	if err != nil {
+		return nil
+	}
+	return n
+}
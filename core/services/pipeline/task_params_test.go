@@ -283,6 +283,40 @@ func TestMaybeBigIntParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestBlockNumberParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected pipeline.BlockNumberParam
+		err      error
+	}{
+		{"latest", "latest", "latest", nil},
+		{"pending", "Pending", "pending", nil},
+		{"earliest", "earliest", "earliest", nil},
+		{"decimal string", "256", "0x100", nil},
+		{"hex string", "0x100", "0x100", nil},
+		{"uint64", uint64(256), "0x100", nil},
+		{"*big.Int", big.NewInt(256), "0x100", nil},
+		{"negative", int64(-1), "", pipeline.ErrBadInput},
+		{"bad tag", "yesterday", "", pipeline.ErrBadInput},
+		{"bad hex", "0xzz", "", pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.BlockNumberParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
+
 func TestMaybeInt32Param_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,86 @@
+package pipeline
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+)
+
+var templateNameRegexp = regexp.MustCompile(`\A[a-zA-Z0-9_-]+\z`)
+
+// ErrTemplateInUse is returned when deleting a template which the pipeline
+// of a job still invokes.
+var ErrTemplateInUse = errors.New("pipeline template is used by jobs")
+
+// Template is a named, versioned pipeline which the pipeline of a job can
+// invoke with a subpipeline task. Creating a template with the name of an
+// existing template adds a new version of it.
+type Template struct {
+	ID           int64          `json:"-"`
+	Name         string         `toml:"name"`
+	Version      int32          `toml:"-"`
+	Description  string         `toml:"description"`
+	Parameters   pq.StringArray `toml:"parameters"`
+	DotDagSource string         `toml:"observationSource"`
+	CreatedAt    time.Time      `toml:"-"`
+}
+
+// Pipeline parses the pipeline of the template.
+func (t Template) Pipeline() (*Pipeline, error) {
+	return Parse(t.DotDagSource)
+}
+
+// ValidatedTemplateSpec parses and validates a pipeline template from TOML,
+// for example:
+//
+//     name = "fetch-price"
+//     description = "Fetches the price of a symbol from two sources"
+//     parameters = ["symbol"]
+//     observationSource = """
+//     ds1 [type=http url="https://a.example.com/price?symbol=$(symbol)"]
+//     ...
+//     """
+func ValidatedTemplateSpec(tomlString string) (Template, error) {
+	var template Template
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return template, errors.Wrap(err, "toml error on load")
+	}
+	if err = tree.Unmarshal(&template); err != nil {
+		return template, errors.Wrap(err, "toml unmarshal error on template")
+	}
+
+	if !templateNameRegexp.MatchString(template.Name) {
+		return template, errors.Errorf("invalid template name %q: may only contain letters, digits, '-' and '_'", template.Name)
+	}
+	if template.Parameters == nil {
+		template.Parameters = pq.StringArray{}
+	}
+	for _, param := range template.Parameters {
+		if err = NewVarsFrom(nil).Set(param, nil); err != nil {
+			return template, errors.Wrapf(err, "invalid parameter %q", param)
+		}
+	}
+
+	p, err := template.Pipeline()
+	if err != nil {
+		return template, errors.Wrap(err, "invalid observationSource")
+	}
+	if len(p.Tasks) == 0 {
+		return template, errors.New("observationSource must contain at least one task")
+	}
+	if p.RequiresPreInsert() {
+		return template, errors.New("async tasks are not supported in a pipeline template")
+	}
+	for _, task := range p.Tasks {
+		if sub, is := task.(*SubpipelineTask); is && sub.Template == template.Name {
+			return template, errors.Errorf("task %s: a template cannot call itself", task.DotID())
+		}
+	}
+
+	return template, nil
+}
@@ -0,0 +1,61 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestValidatedTemplateSpec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid template", func(t *testing.T) {
+		template, err := pipeline.ValidatedTemplateSpec(`
+name = "fetch-price"
+description = "Fetches a price"
+parameters = ["symbol", "multiplier"]
+observationSource = """
+ds  [type=memo value="$(symbol)"]
+mul [type=multiply input="$(ds)" times="$(multiplier)"]
+ds -> mul
+"""
+`)
+		require.NoError(t, err)
+		assert.Equal(t, "fetch-price", template.Name)
+		assert.Equal(t, "Fetches a price", template.Description)
+		assert.Equal(t, []string{"symbol", "multiplier"}, []string(template.Parameters))
+		assert.Contains(t, template.DotDagSource, "ds -> mul")
+	})
+
+	tests := []struct {
+		name string
+		toml string
+		err  string
+	}{
+		{"invalid toml", `name = `, "toml error on load"},
+		{"missing name", `observationSource = "ds [type=memo value=1]"`, "invalid template name"},
+		{"invalid name", `name = "a b"
+observationSource = "ds [type=memo value=1]"`, "invalid template name"},
+		{"invalid parameter", `name = "a"
+parameters = ["a.b"]
+observationSource = "ds [type=memo value=1]"`, "invalid parameter"},
+		{"empty pipeline", `name = "a"`, "at least one task"},
+		{"invalid pipeline", `name = "a"
+observationSource = "ds [type=nonexistent]"`, "invalid observationSource"},
+		{"async task", `name = "a"
+observationSource = "tx [type=ethtx]"`, "async tasks are not supported"},
+		{"recursive", `name = "a"
+observationSource = "sub [type=subpipeline template=a]"`, "cannot call itself"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pipeline.ValidatedTemplateSpec(tt.toml)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -17,7 +17,8 @@ var (
 )
 
 type Vars struct {
-	vars map[string]interface{}
+	vars    map[string]interface{}
+	secrets *secretsResolver
 }
 
 // NewVarsFrom creates new Vars from the given map.
@@ -42,6 +43,13 @@ func (vars Vars) Get(keypathStr string) (interface{}, error) {
 		return nil, ErrVarsRoot
 	}
 
+	if keypath.Part0 == SecretsKey {
+		if keypath.NumParts != 2 {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "keypath %v must name a secret, e.g. $(secrets.name)", keypathStr)
+		}
+		return vars.secrets.get(keypath.Part1)
+	}
+
 	var val interface{}
 	var exists bool
 
@@ -98,5 +106,5 @@ func (vars Vars) Copy() Vars {
 	for k, v := range vars.vars {
 		newVars[k] = v
 	}
-	return NewVarsFrom(newVars)
+	return Vars{vars: newVars, secrets: vars.secrets}
 }
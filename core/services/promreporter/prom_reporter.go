@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -29,6 +30,12 @@ type (
 		wgDone       sync.WaitGroup
 		reportPeriod time.Duration
 
+		// Cursors for the job metrics, only used by the event loop
+		lastRunFinishedAt time.Time
+		// lastFinalBlocks holds the last final block whose transactions were
+		// reported, by EVM chain ID
+		lastFinalBlocks map[string]int64
+
 		utils.StartStopOnce
 	}
 
@@ -38,6 +45,10 @@ type (
 		SetMaxUnconfirmedBlocks(*big.Int, int64)
 		SetPipelineRunsQueued(n int)
 		SetPipelineTaskRunsQueued(n int)
+		ObserveJobRun(jobType, externalJobID, status string, seconds float64)
+		ObserveJobTxInclusion(jobType, externalJobID string, seconds float64)
+		AddJobGasUsed(jobType, externalJobID string, gasUsed uint64)
+		AddJobGasFee(jobType, externalJobID string, feeWei *big.Int)
 	}
 
 	defaultBackend struct{}
@@ -64,6 +75,30 @@ var (
 		Name: "pipeline_task_runs_queued",
 		Help: "The total number of pipeline task runs that are awaiting execution",
 	})
+	// jobDurationBuckets range from 100ms to roughly 1 hour
+	jobDurationBuckets     = prometheus.ExponentialBuckets(0.1, 2, 16)
+	promJobRunDurationTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "job_run_duration_seconds",
+		Help:    "Time from a job being triggered to its pipeline run finishing (in seconds)",
+		Buckets: jobDurationBuckets,
+	}, []string{"job_type", "external_job_id"})
+	promJobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "job_runs_total",
+		Help: "The total number of finished pipeline runs of a job, by status (completed or errored)",
+	}, []string{"job_type", "external_job_id", "status"})
+	promJobTxInclusionTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "job_tx_inclusion_seconds",
+		Help:    "Time from a job being triggered to the timestamp of the block which included a transaction sent by its pipeline run (in seconds)",
+		Buckets: jobDurationBuckets,
+	}, []string{"job_type", "external_job_id"})
+	promJobGasUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "job_gas_used_total",
+		Help: "The total gas (in units of gas) used by final transactions sent by the pipeline runs of a job",
+	}, []string{"job_type", "external_job_id"})
+	promJobGasFee = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "job_gas_fee_wei_total",
+		Help: "The total fees (in wei) paid for the gas used by final transactions sent by the pipeline runs of a job",
+	}, []string{"job_type", "external_job_id"})
 )
 
 func (defaultBackend) SetUnconfirmedTransactions(evmChainID *big.Int, n int64) {
@@ -86,6 +121,24 @@ func (defaultBackend) SetPipelineTaskRunsQueued(n int) {
 	promPipelineRunsQueued.Set(float64(n))
 }
 
+func (defaultBackend) ObserveJobRun(jobType, externalJobID, status string, seconds float64) {
+	promJobRunDurationTime.WithLabelValues(jobType, externalJobID).Observe(seconds)
+	promJobRuns.WithLabelValues(jobType, externalJobID, status).Inc()
+}
+
+func (defaultBackend) ObserveJobTxInclusion(jobType, externalJobID string, seconds float64) {
+	promJobTxInclusionTime.WithLabelValues(jobType, externalJobID).Observe(seconds)
+}
+
+func (defaultBackend) AddJobGasUsed(jobType, externalJobID string, gasUsed uint64) {
+	promJobGasUsed.WithLabelValues(jobType, externalJobID).Add(float64(gasUsed))
+}
+
+func (defaultBackend) AddJobGasFee(jobType, externalJobID string, feeWei *big.Int) {
+	fee, _ := new(big.Float).SetInt(feeWei).Float64()
+	promJobGasFee.WithLabelValues(jobType, externalJobID).Add(fee)
+}
+
 func NewPromReporter(db *sql.DB, lggr logger.Logger, opts ...interface{}) *promReporter {
 	var backend PrometheusBackend = defaultBackend{}
 	period := 15 * time.Second
@@ -106,6 +159,9 @@ func NewPromReporter(db *sql.DB, lggr logger.Logger, opts ...interface{}) *promR
 		newHeads:     utils.NewMailbox[*evmtypes.Head](1),
 		chStop:       chStop,
 		reportPeriod: period,
+		// Only runs finished after the reporter was created are reported
+		lastRunFinishedAt: time.Now(),
+		lastFinalBlocks:   make(map[string]int64),
 	}
 }
 
@@ -144,7 +200,11 @@ func (pr *promReporter) eventLoop() {
 			}
 			pr.reportHeadMetrics(ctx, head)
 		case <-time.After(pr.reportPeriod):
-			if err := errors.Wrap(pr.reportPipelineRunStats(ctx), "reportPipelineRunStats failed"); err != nil {
+			err := multierr.Combine(
+				errors.Wrap(pr.reportPipelineRunStats(ctx), "reportPipelineRunStats failed"),
+				errors.Wrap(pr.reportJobRuns(ctx), "reportJobRuns failed"),
+			)
+			if err != nil && ctx.Err() == nil {
 				pr.lggr.Errorw("Error reporting prometheus metrics", "err", err)
 			}
 
@@ -160,6 +220,7 @@ func (pr *promReporter) reportHeadMetrics(ctx context.Context, head *evmtypes.He
 		errors.Wrap(pr.reportPendingEthTxes(ctx, evmChainID), "reportPendingEthTxes failed"),
 		errors.Wrap(pr.reportMaxUnconfirmedAge(ctx, evmChainID), "reportMaxUnconfirmedAge failed"),
 		errors.Wrap(pr.reportMaxUnconfirmedBlocks(ctx, head), "reportMaxUnconfirmedBlocks failed"),
+		errors.Wrap(pr.reportJobTxes(ctx, head), "reportJobTxes failed"),
 	)
 
 	if err != nil && ctx.Err() == nil {
@@ -241,3 +302,139 @@ SELECT pipeline_run_id FROM pipeline_task_runs WHERE finished_at IS NULL
 
 	return nil
 }
+
+// reportJobRuns reports the duration and status of the pipeline runs of jobs
+// that finished since the last report. Runs are triggered (by a log, a cron
+// tick, a webhook etc.) at the moment they are created.
+func (pr *promReporter) reportJobRuns(ctx context.Context) (err error) {
+	until := time.Now()
+	rows, err := pr.db.QueryContext(ctx, `
+SELECT jobs.type, jobs.external_job_id, pipeline_runs.state,
+	EXTRACT(EPOCH FROM pipeline_runs.finished_at - pipeline_runs.created_at)
+FROM pipeline_runs
+JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE pipeline_runs.finished_at > $1 AND pipeline_runs.finished_at <= $2`, pr.lastRunFinishedAt, until)
+	if err != nil {
+		return errors.Wrap(err, "failed to query for finished pipeline runs")
+	}
+	defer func() {
+		err = multierr.Combine(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		var jobType, externalJobID, state string
+		var seconds float64
+		if err = rows.Scan(&jobType, &externalJobID, &state, &seconds); err != nil {
+			return errors.Wrap(err, "unexpected error scanning row")
+		}
+		pr.backend.ObserveJobRun(jobType, externalJobID, state, seconds)
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	pr.lastRunFinishedAt = until
+	return nil
+}
+
+// reportJobTxes reports the gas used and the time to inclusion of the
+// transactions sent by the pipeline runs of jobs, once they are final. Blocks
+// are final once they are at least as deep as the earliest head that the head
+// tracker keeps in the chain, which is at least the finality depth of the
+// chain. Their receipts were saved by the confirmer while they were recent, so
+// they are reported once, from their canonical receipt.
+func (pr *promReporter) reportJobTxes(ctx context.Context, head *evmtypes.Head) (err error) {
+	evmChainID := head.EVMChainID.String()
+	finalBlock := head.EarliestInChain().Number
+	lastFinalBlock, ok := pr.lastFinalBlocks[evmChainID]
+	if !ok {
+		// Only transactions which become final after the reporter has started
+		// are reported
+		pr.lastFinalBlocks[evmChainID] = finalBlock
+		return nil
+	}
+	if finalBlock <= lastFinalBlock {
+		return nil
+	}
+
+	// The latest receipt of a confirmed transaction is its canonical receipt:
+	// earlier ones are from blocks which were reorged out before the
+	// confirmer saw it in its final block.
+	rows, err := pr.db.QueryContext(ctx, `
+SELECT jobs.type, jobs.external_job_id, receipts.gas_used,
+	EXTRACT(EPOCH FROM evm_heads.timestamp - pipeline_runs.created_at),
+	receipts.gas_price, receipts.gas_tip_cap, receipts.gas_fee_cap, evm_heads.base_fee_per_gas
+FROM (
+	SELECT DISTINCT ON (eth_txes.id) eth_txes.pipeline_task_run_id, eth_receipts.block_number, eth_receipts.block_hash,
+		eth_receipts.receipt->>'gasUsed' AS gas_used,
+		eth_tx_attempts.gas_price, eth_tx_attempts.gas_tip_cap, eth_tx_attempts.gas_fee_cap
+	FROM eth_txes
+	JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+	JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+	WHERE eth_txes.evm_chain_id = $1 AND eth_txes.state = 'confirmed' AND eth_txes.pipeline_task_run_id IS NOT NULL
+	AND eth_txes.id IN (
+		SELECT eth_tx_attempts.eth_tx_id FROM eth_receipts
+		JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+		WHERE eth_receipts.block_number > $2 AND eth_receipts.block_number <= $3
+	)
+	ORDER BY eth_txes.id, eth_receipts.block_number DESC, eth_receipts.id DESC
+) receipts
+JOIN pipeline_task_runs ON pipeline_task_runs.id = receipts.pipeline_task_run_id
+JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+LEFT JOIN evm_heads ON evm_heads.hash = receipts.block_hash AND evm_heads.evm_chain_id = $1
+WHERE receipts.block_number > $2 AND receipts.block_number <= $3`, evmChainID, lastFinalBlock, finalBlock)
+	if err != nil {
+		return errors.Wrap(err, "failed to query for final eth_txes of job runs")
+	}
+	defer func() {
+		err = multierr.Combine(err, rows.Close())
+	}()
+
+	for rows.Next() {
+		var jobType, externalJobID string
+		var gasUsed null.String
+		var seconds sql.NullFloat64
+		var gasPrice, gasTipCap, gasFeeCap, baseFee *utils.Big
+		if err = rows.Scan(&jobType, &externalJobID, &gasUsed, &seconds, &gasPrice, &gasTipCap, &gasFeeCap, &baseFee); err != nil {
+			return errors.Wrap(err, "unexpected error scanning row")
+		}
+		// The block is missing if the head tracker has already trimmed it
+		if seconds.Valid {
+			pr.backend.ObserveJobTxInclusion(jobType, externalJobID, seconds.Float64)
+		}
+		if !gasUsed.Valid {
+			continue
+		}
+		used, perr := hexutil.DecodeUint64(gasUsed.String)
+		if perr != nil {
+			pr.lggr.Warnw("Failed to parse gasUsed of eth_receipt", "jobType", jobType, "externalJobID", externalJobID, "gasUsed", gasUsed.String, "err", perr)
+			continue
+		}
+		pr.backend.AddJobGasUsed(jobType, externalJobID, used)
+		if price := effectiveGasPrice(gasPrice, gasTipCap, gasFeeCap, baseFee); price != nil {
+			pr.backend.AddJobGasFee(jobType, externalJobID, new(big.Int).Mul(new(big.Int).SetUint64(used), price))
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	pr.lastFinalBlocks[evmChainID] = finalBlock
+	return nil
+}
+
+// effectiveGasPrice returns the price paid per gas by a transaction: its gas
+// price, or for an EIP-1559 transaction, the base fee of its block plus its
+// tip, up to its fee cap. It returns nil if the base fee is unknown.
+func effectiveGasPrice(gasPrice, gasTipCap, gasFeeCap, baseFee *utils.Big) *big.Int {
+	if gasPrice != nil {
+		return gasPrice.ToInt()
+	}
+	if gasTipCap == nil || gasFeeCap == nil || baseFee == nil {
+		return nil
+	}
+	price := new(big.Int).Add(baseFee.ToInt(), gasTipCap.ToInt())
+	if price.Cmp(gasFeeCap.ToInt()) > 0 {
+		return gasFeeCap.ToInt()
+	}
+	return price
+}
@@ -129,4 +129,88 @@ func Test_PromReporter_OnNewLongestChain(t *testing.T) {
 
 		backend.AssertExpectations(t)
 	})
+
+	t.Run("with finished job runs and their transactions", func(t *testing.T) {
+		db := pgtest.NewSqlxDB(t)
+		cfg := cltest.NewTestGeneralConfig(t)
+		borm := cltest.NewTxmORM(t, db, cfg)
+		ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+		_, fromAddress := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		jobType, externalJobID := string(jb.Type), jb.ExternalJobID.String()
+
+		var heads, runs, fees atomic.Int32
+
+		backend := new(mocks.PrometheusBackend)
+		backend.Test(t)
+		backend.On("SetPipelineTaskRunsQueued", mock.Anything).Return()
+		backend.On("SetPipelineRunsQueued", mock.Anything).Return()
+		backend.On("SetUnconfirmedTransactions", mock.Anything, mock.Anything).Return()
+		backend.On("SetMaxUnconfirmedAge", mock.Anything, mock.Anything).Return()
+		backend.On("SetMaxUnconfirmedBlocks", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				heads.Inc()
+			}).
+			Return()
+		reporter := promreporter.NewPromReporter(db.DB, logger.TestLogger(t), backend, 10*time.Millisecond)
+		reporter.Start(testutils.Context(t))
+		defer reporter.Close()
+
+		// The first head only sets the final block from which transactions
+		// are reported
+		head := evmtypes.Head{Number: 10, EVMChainID: utils.NewBig(&cltest.FixtureChainID)}
+		reporter.OnNewLongestChain(context.Background(), &head)
+		require.Eventually(t, func() bool { return heads.Load() >= 1 }, 12*time.Second, 100*time.Millisecond)
+
+		backend.On("ObserveJobRun", jobType, externalJobID, "completed", mock.MatchedBy(func(s float64) bool {
+			return s >= 9.9 && s <= 10.1
+		})).
+			Run(func(args mock.Arguments) {
+				runs.Inc()
+			}).
+			Once().
+			Return()
+		backend.On("ObserveJobTxInclusion", jobType, externalJobID, mock.MatchedBy(func(s float64) bool {
+			return s >= 29.9 && s <= 30.1
+		})).Once().Return()
+		backend.On("AddJobGasUsed", jobType, externalJobID, uint64(21000)).Once().Return()
+		// The legacy attempt has a gas price of 1 wei
+		backend.On("AddJobGasFee", jobType, externalJobID, big.NewInt(21000)).
+			Run(func(args mock.Arguments) {
+				fees.Inc()
+			}).
+			Once().
+			Return()
+
+		// Finish the run after the current report window so that it is not missed
+		finishedAt := time.Now().Add(time.Second)
+		createdAt := finishedAt.Add(-10 * time.Second)
+		var runID int64
+		require.NoError(t, db.Get(&runID, `INSERT INTO pipeline_runs (state, pipeline_spec_id, created_at, finished_at, outputs, fatal_errors, all_errors)
+VALUES ('completed', $1, $2, $3, '[]', '[null]', '[null]') RETURNING id`, jb.PipelineSpecID, createdAt, finishedAt))
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, runID)
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE eth_txes SET pipeline_task_run_id = $1 WHERE id = $2`, tr.ID, etx.ID)
+		// A receipt from a block which was reorged out, and the canonical one
+		pgtest.MustExec(t, db, `INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+VALUES ($1, $2, 11, 0, '{"gasUsed": "0x5208"}', NOW())`, etx.EthTxAttempts[0].Hash, utils.NewHash())
+		blockHash := utils.NewHash()
+		pgtest.MustExec(t, db, `INSERT INTO eth_receipts (tx_hash, block_hash, block_number, transaction_index, receipt, created_at)
+VALUES ($1, $2, 12, 0, '{"gasUsed": "0x5208"}', NOW())`, etx.EthTxAttempts[0].Hash, blockHash)
+		pgtest.MustExec(t, db, `INSERT INTO evm_heads (hash, number, parent_hash, created_at, timestamp, evm_chain_id)
+VALUES ($1, 12, $2, NOW(), $3, $4)`, blockHash, utils.NewHash(), createdAt.Add(30*time.Second), utils.NewBig(&cltest.FixtureChainID))
+
+		// Block 12 is final once it is the earliest head in the chain
+		head = evmtypes.Head{Number: 13, EVMChainID: utils.NewBig(&cltest.FixtureChainID)}
+		head.Parent = &evmtypes.Head{Number: 12, Hash: blockHash, EVMChainID: utils.NewBig(&cltest.FixtureChainID)}
+		reporter.OnNewLongestChain(context.Background(), &head)
+		require.Eventually(t, func() bool { return runs.Load() >= 1 && fees.Load() >= 1 }, 12*time.Second, 100*time.Millisecond)
+
+		// The transaction is not reported again
+		head = evmtypes.Head{Number: 14, EVMChainID: utils.NewBig(&cltest.FixtureChainID)}
+		reporter.OnNewLongestChain(context.Background(), &head)
+		require.Eventually(t, func() bool { return heads.Load() >= 3 }, 12*time.Second, 100*time.Millisecond)
+
+		backend.AssertExpectations(t)
+	})
 }
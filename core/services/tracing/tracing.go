@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// shutdownTimeout is how long Close waits for the spans which have not been
+// exported yet to be sent to the collector.
+const shutdownTimeout = 5 * time.Second
+
+// Config is the configuration of tracing.
+type Config interface {
+	TracingCollectorTarget() string
+	TracingSamplingRatio() float64
+}
+
+// Provider exports the tracing spans of the node to an OpenTelemetry
+// collector over OTLP/HTTP. Once started, it is the global tracer provider,
+// so the tracers returned by otel.Tracer record spans. Until then, and once
+// it is closed, they are no-ops.
+//
+// Tracers should be looked up with otel.Tracer when starting a span rather
+// than once per package: the tracers returned before a global provider is set
+// keep delegating to the first one, even once it is closed.
+type Provider struct {
+	utils.StartStopOnce
+	cfg      Config
+	lggr     logger.Logger
+	provider *sdktrace.TracerProvider
+}
+
+// NewProvider returns a new Provider.
+func NewProvider(cfg Config, lggr logger.Logger) *Provider {
+	return &Provider{
+		cfg:  cfg,
+		lggr: lggr.Named("Tracing"),
+	}
+}
+
+// Start sets the provider as the global tracer provider, and the W3C trace
+// context as the global propagator.
+func (p *Provider) Start(ctx context.Context) error {
+	return p.StartOnce("Tracing", func() error {
+		exporter, err := otlptracehttp.New(ctx,
+			otlptracehttp.WithEndpoint(p.cfg.TracingCollectorTarget()),
+			// The collector is expected to run next to the node
+			otlptracehttp.WithInsecure(),
+		)
+		if err != nil {
+			return errors.Wrap(err, "failed to create OTLP exporter")
+		}
+		res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String("chainlink"),
+			semconv.ServiceVersionKey.String(static.Version),
+		))
+		if err != nil {
+			return errors.Wrap(err, "failed to create tracing resource")
+		}
+		p.provider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(p.cfg.TracingSamplingRatio()))),
+		)
+		otel.SetTracerProvider(p.provider)
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			p.lggr.Debugw("Tracing error", "err", err)
+		}))
+
+		p.lggr.Infow("Exporting tracing spans", "collectorTarget", p.cfg.TracingCollectorTarget(), "samplingRatio", p.cfg.TracingSamplingRatio())
+		return nil
+	})
+}
+
+// Close exports the remaining spans and stops the provider. The global tracer
+// provider and propagator are reset to no-ops.
+func (p *Provider) Close() error {
+	return p.StopOnce("Tracing", func() error {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return p.provider.Shutdown(ctx)
+	})
+}
+
+// RecordError marks the span as failed with err, if err is not nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
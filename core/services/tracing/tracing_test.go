@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
+)
+
+type tracingConfig struct{}
+
+func (tracingConfig) TracingCollectorTarget() string { return "localhost:4318" }
+func (tracingConfig) TracingSamplingRatio() float64  { return 1 }
+
+func TestProvider(t *testing.T) {
+	p := tracing.NewProvider(tracingConfig{}, logger.TestLogger(t))
+	require.NoError(t, p.Start(testutils.Context(t)))
+
+	_, span := otel.Tracer("test").Start(context.Background(), "started")
+	assert.True(t, span.IsRecording())
+	span.End()
+
+	require.NoError(t, p.Close())
+
+	// The global tracer provider is reset to a no-op
+	_, span = otel.Tracer("test").Start(context.Background(), "closed")
+	assert.False(t, span.IsRecording())
+	span.End()
+	_, isSDK := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	assert.False(t, isSDK)
+}
+
+func TestRecordError(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "ok")
+	tracing.RecordError(span, nil)
+	span.End()
+
+	_, span = tracer.Start(context.Background(), "failed")
+	tracing.RecordError(span, errors.New("boom"))
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Empty(t, spans[0].Events())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "boom", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+	assert.Equal(t, "exception", spans[1].Events()[0].Name)
+}
@@ -26,6 +26,9 @@ type ExternalInitiatorManager interface {
 	Notify(webhookSpecID int32) error
 	DeleteJob(webhookSpecID int32) error
 	FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error)
+	// Load returns the external initiators of a webhook spec, with their specs
+	// and the external job ID of the webhook job.
+	Load(webhookSpecID int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error)
 }
 
 //go:generate mockery --name HTTPClient --output ./mocks/ --case=underscore
@@ -212,3 +215,6 @@ func (NullExternalInitiatorManager) DeleteJob(int32) error { return nil }
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
+func (NullExternalInitiatorManager) Load(int32) ([]job.ExternalInitiatorWebhookSpec, uuid.UUID, error) {
+	return nil, uuid.UUID{}, nil
+}
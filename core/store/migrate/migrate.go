@@ -123,6 +123,24 @@ func Current(db *sql.DB, lggr logger.Logger) (int64, error) {
 	return goose.EnsureDBVersion(db)
 }
 
+// Version returns the migration version of db, without migrating it.
+func Version(db *sql.DB) (int64, error) {
+	return goose.GetDBVersion(db)
+}
+
+// Latest returns the version of the latest migration known to this node.
+func Latest() (int64, error) {
+	migrations, err := goose.CollectMigrations(MIGRATIONS_DIR, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, err
+	}
+	return last.Version, nil
+}
+
 func Status(db *sql.DB, lggr logger.Logger) error {
 	ensureMigrated(db, lggr)
 	return goose.Status(db, MIGRATIONS_DIR)
@@ -11,10 +11,8 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
-	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
-	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
 	"github.com/smartcontractkit/chainlink/core/store/migrate"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -62,20 +60,19 @@ func getOCR2Spec100() OffchainReporting2OracleSpec100 {
 
 func TestMigrate_0100_BootstrapConfigs(t *testing.T) {
 	_, db := heavyweight.FullTestDBEmpty(t, migrationDir)
-	lggr := logger.TestLogger(t)
-	cfg := configtest.NewTestGeneralConfig(t)
 	err := goose.UpTo(db.DB, migrationDir, 99)
 	require.NoError(t, err)
 
-	pipelineORM := pipeline.NewORM(db, lggr, cfg)
-	pipelineID, err := pipelineORM.CreateSpec(pipeline.Pipeline{}, 0)
-	require.NoError(t, err)
-	pipelineID2, err := pipelineORM.CreateSpec(pipeline.Pipeline{}, 0)
-	require.NoError(t, err)
-	nonBootstrapPipelineID, err := pipelineORM.CreateSpec(pipeline.Pipeline{}, 0)
-	require.NoError(t, err)
-	newFormatBoostrapPipelineID2, err := pipelineORM.CreateSpec(pipeline.Pipeline{}, 0)
-	require.NoError(t, err)
+	// pipeline_specs at migration v0099
+	createPipelineSpec := func() int32 {
+		var id int32
+		require.NoError(t, db.Get(&id, `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at) VALUES ('', 0, NOW()) RETURNING id`))
+		return id
+	}
+	pipelineID := createPipelineSpec()
+	pipelineID2 := createPipelineSpec()
+	nonBootstrapPipelineID := createPipelineSpec()
+	newFormatBoostrapPipelineID2 := createPipelineSpec()
 
 	// OCR2 struct at migration v0099
 	type OffchainReporting2OracleSpec struct {
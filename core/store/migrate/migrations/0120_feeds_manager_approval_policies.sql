@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds_managers
+ADD COLUMN approval_policies JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE job_proposal_specs
+ADD COLUMN approval_policy VARCHAR;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE job_proposal_specs
+DROP COLUMN approval_policy;
+
+ALTER TABLE feeds_managers
+DROP COLUMN approval_policies;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pipeline_templates (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    version INT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    parameters TEXT[] NOT NULL DEFAULT '{}',
+    dot_dag_source TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT pipeline_templates_name_version_key UNIQUE (name, version),
+    CONSTRAINT chk_version CHECK (version > 0)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE pipeline_templates;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+ADD COLUMN max_concurrency INT NOT NULL DEFAULT 0 CHECK (max_concurrency >= 0),
+ADD COLUMN rate_limit DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (rate_limit >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+DROP COLUMN max_concurrency,
+DROP COLUMN rate_limit;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE eth_key_top_ups (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE,
+    from_address bytea NOT NULL CHECK (octet_length(from_address) = 20),
+    to_address bytea NOT NULL CHECK (octet_length(to_address) = 20),
+    amount numeric(78,0) NOT NULL CHECK (amount > 0),
+    balance numeric(78,0) NOT NULL,
+    eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL,
+    created_at timestamp with time zone NOT NULL
+);
+CREATE INDEX idx_eth_key_top_ups_evm_chain_id_created_at ON eth_key_top_ups (evm_chain_id, created_at);
+CREATE INDEX idx_eth_key_top_ups_eth_tx_id ON eth_key_top_ups (eth_tx_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE eth_key_top_ups;
+-- +goose StatementEnd
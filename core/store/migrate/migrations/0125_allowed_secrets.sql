@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs ADD COLUMN allowed_secrets TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE pipeline_specs ADD COLUMN allowed_secrets TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs DROP COLUMN allowed_secrets;
+ALTER TABLE pipeline_specs DROP COLUMN allowed_secrets;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE eth_key_states ADD COLUMN signing_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE eth_key_states DROP COLUMN signing_enabled;
+-- +goose StatementEnd
@@ -3,6 +3,7 @@ package web
 import (
 	"database/sql"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
 
@@ -48,6 +49,12 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.MaxConcurrency > math.MaxInt32 {
+		fe.Add(fmt.Sprintf("MaxConcurrency must be at most %d", math.MaxInt32))
+	}
+	if bt.RateLimit < 0 {
+		fe.Add("RateLimit must not be negative")
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -93,7 +100,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusConflict, apiErr)
 		return
 	default:
-		resource := presenters.NewBridgeResource(*bt)
+		resource := presenters.NewBridgeResource(*bt, btc.App.GetCircuitBreakers())
 		resource.IncomingToken = bta.IncomingToken
 
 		jsonAPIResponse(c, resource, "bridge")
@@ -106,7 +113,7 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *presenters.NewBridgeResource(bridge, btc.App.GetCircuitBreakers()))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -132,7 +139,7 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.GetCircuitBreakers()), "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -170,7 +177,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.GetCircuitBreakers()), "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -207,5 +214,5 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, presenters.NewBridgeResource(bt, btc.App.GetCircuitBreakers()), "bridge")
 }
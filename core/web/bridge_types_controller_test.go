@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"math"
 	"net/http"
 	"testing"
 
@@ -85,6 +86,24 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"invalid RateLimit negative",
+			bridges.BridgeTypeRequest{
+				Name:      "adapterwithdockerurl",
+				URL:       cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				RateLimit: -1,
+			},
+			models.NewJSONAPIErrorsWith("RateLimit must not be negative"),
+		},
+		{
+			"invalid MaxConcurrency out of range",
+			bridges.BridgeTypeRequest{
+				Name:           "adapterwithdockerurl",
+				URL:            cltest.WebURL(t, "http://chainlink_cmc-adapter_1:8080"),
+				MaxConcurrency: math.MaxInt32 + 1,
+			},
+			models.NewJSONAPIErrorsWith("MaxConcurrency must be at most 2147483647"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -250,14 +269,18 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	}
 	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
 
-	ud := bytes.NewBuffer([]byte(`{"name": "BRidgea","url":"http://yourbridge"}`))
+	ud := bytes.NewBuffer([]byte(`{"name": "BRidgea","url":"http://yourbridge","maxConcurrency":3,"rateLimit":0.5}`))
 	resp, cleanup := client.Patch("/v2/bridge_types/bridgea", ud)
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	respJSON := cltest.ParseJSON(t, resp.Body)
+	assert.Equal(t, "closed", respJSON.Get("data.attributes.circuitBreaker").String())
 
 	ubt, err := app.BridgeORM().FindBridge(bt.Name)
 	assert.NoError(t, err)
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
+	assert.Equal(t, uint32(3), ubt.MaxConcurrency)
+	assert.Equal(t, 0.5, ubt.RateLimit)
 }
 
 func TestBridgeController_Show(t *testing.T) {
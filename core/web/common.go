@@ -2,7 +2,9 @@ package web
 
 import (
 	"math/big"
+	"net/http"
 
+	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 )
@@ -35,3 +37,19 @@ func getChain(cs evm.ChainSet, chainIDstr string) (chain evm.Chain, err error) {
 	}
 	return chain, nil
 }
+
+// getChainOrError gets the chain given by the evmChainID query string param and
+// responds with an error if it cannot be found.
+func getChainOrError(c *gin.Context, cs evm.ChainSet) (evm.Chain, bool) {
+	chain, err := getChain(cs, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return nil, false
+	case nil:
+		return chain, true
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return nil, false
+	}
+}
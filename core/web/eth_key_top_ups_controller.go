@@ -0,0 +1,58 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultTopUpsLimit is the number of top-ups which are listed when no limit
+// is given.
+const defaultTopUpsLimit = 25
+
+// ETHKeyTopUpsController shows the audit trail of the top-ups of sending keys
+// from the funding key of an EVM chain.
+type ETHKeyTopUpsController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest top-ups of the chain, newest first.
+// Example:
+//  "<application>/keys/eth/top_ups?evmChainID=1&limit=10"
+func (tc *ETHKeyTopUpsController) Index(c *gin.Context) {
+	limit := uint64(defaultTopUpsLimit)
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.ParseUint(l, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'limit' query string param"))
+			return
+		}
+	}
+
+	chain, ok := getChainOrError(c, tc.App.GetChains().EVM)
+	if !ok {
+		return
+	}
+
+	orm := monitor.NewTopUpORM(tc.App.GetSqlxDB(), tc.App.GetLogger(), tc.App.GetConfig(), *chain.ID())
+	topUps, err := orm.TopUps(int(limit), pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.ETHKeyTopUpResource{}
+	for _, t := range topUps {
+		resources = append(resources, presenters.NewETHKeyTopUpResource(t))
+	}
+
+	jsonAPIResponse(c, resources, "eth_key_top_ups")
+}
@@ -0,0 +1,54 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestETHKeyTopUpsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	orm := monitor.NewTopUpORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig(), cltest.FixtureChainID)
+	from, to := testutils.NewAddress(), testutils.NewAddress()
+	for _, amount := range []int64{100, 200, 300} {
+		require.NoError(t, orm.InsertTopUp(&monitor.TopUp{
+			FromAddress: from,
+			ToAddress:   to,
+			Amount:      assets.NewEthValue(amount),
+			Balance:     assets.NewEthValue(1),
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/keys/eth/top_ups?limit=2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var topUps []presenters.ETHKeyTopUpResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &topUps))
+	require.Len(t, topUps, 2)
+	assert.Equal(t, "300", topUps[0].Amount.ToInt().String())
+	assert.Equal(t, "200", topUps[1].Amount.ToInt().String())
+	assert.Equal(t, from.Hex(), topUps[0].FromAddress)
+	assert.Equal(t, to.Hex(), topUps[0].ToAddress)
+	assert.Equal(t, cltest.FixtureChainID.String(), topUps[0].EVMChainID.String())
+	assert.Nil(t, topUps[0].EthTxID)
+
+	resp, cleanup = client.Get("/v2/keys/eth/top_ups?limit=-1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -137,18 +137,42 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 // Update an ETH key's parameters
 // Example:
 // "PUT <application>/keys/eth/:keyID?maxGasPriceGWei=12345"
+// "PUT <application>/keys/eth/:keyID?disabled=true"
+// "PUT <application>/keys/eth/:keyID?signingEnabled=true"
 func (ekc *ETHKeysController) Update(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
-	if c.Query("maxGasPriceGWei") == "" {
+	if c.Query("maxGasPriceGWei") == "" && c.Query("disabled") == "" && c.Query("signingEnabled") == "" {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("no parameters passed to update"))
 		return
 	}
 
-	maxGasPriceGWei, err := strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
-	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, err)
-		return
+	var maxGasPriceGWei int64
+	var err error
+	if c.Query("maxGasPriceGWei") != "" {
+		maxGasPriceGWei, err = strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	var disabled bool
+	if c.Query("disabled") != "" {
+		disabled, err = strconv.ParseBool(c.Query("disabled"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	var signingEnabled bool
+	if c.Query("signingEnabled") != "" {
+		signingEnabled, err = strconv.ParseBool(c.Query("signingEnabled"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
 	}
 
 	keyID := c.Param("keyID")
@@ -164,11 +188,45 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 		return
 	}
 
-	maxGasPriceWei := assets.GWei(maxGasPriceGWei)
-	updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
-	if err = ekc.App.GetChains().EVM.UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+	if c.Query("maxGasPriceGWei") != "" {
+		maxGasPriceWei := assets.GWei(maxGasPriceGWei)
+		updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
+		if err = ekc.App.GetChains().EVM.UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if c.Query("disabled") != "" {
+		if disabled {
+			err = ethKeyStore.Disable(key.Address.Address(), state.EVMChainID.ToInt())
+		} else {
+			err = ethKeyStore.Enable(key.Address.Address(), state.EVMChainID.ToInt())
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if state, err = ethKeyStore.GetState(keyID); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if c.Query("signingEnabled") != "" {
+		if signingEnabled {
+			err = ethKeyStore.EnableSigning(key.Address.Address(), state.EVMChainID.ToInt())
+		} else {
+			err = ethKeyStore.DisableSigning(key.Address.Address(), state.EVMChainID.ToInt())
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if state, err = ethKeyStore.GetState(keyID); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
 	}
 
 	r, err := presenters.NewETHKeyResource(key, state,
@@ -193,3 +193,99 @@ func TestETHKeysController_UpdateSuccess(t *testing.T) {
 
 	require.Equal(t, assets.GWei(777), chain.Config().KeySpecificMaxGasPriceWei(key.Address.Address()))
 }
+
+func TestETHKeysController_UpdateDisabled(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	verify := cltest.MockApplicationEthCalls(t, app, ethClient)
+	defer verify()
+
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient()
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	keys, err := app.KeyStore.Eth().SendingKeys(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	require.NotEmpty(t, keys)
+	key := keys[0]
+
+	resp, cleanup := client.Put("/v2/keys/eth/"+key.Address.Hex()+"?disabled=true", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.True(t, resource.Disabled)
+
+	state, err := app.KeyStore.Eth().GetState(key.ID())
+	require.NoError(t, err)
+	assert.True(t, state.Disabled)
+
+	resp, cleanup = client.Put("/v2/keys/eth/"+key.Address.Hex()+"?disabled=false", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	state, err = app.KeyStore.Eth().GetState(key.ID())
+	require.NoError(t, err)
+	assert.False(t, state.Disabled)
+
+	resp, cleanup = client.Put("/v2/keys/eth/"+key.Address.Hex()+"?disabled=maybe", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestETHKeysController_UpdateSigningEnabled(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	config.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	verify := cltest.MockApplicationEthCalls(t, app, ethClient)
+	defer verify()
+
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	client := app.NewHTTPClient()
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	keys, err := app.KeyStore.Eth().SendingKeys(&cltest.FixtureChainID)
+	require.NoError(t, err)
+	require.NotEmpty(t, keys)
+	key := keys[0]
+
+	state, err := app.KeyStore.Eth().GetState(key.ID())
+	require.NoError(t, err)
+	assert.False(t, state.SigningEnabled)
+
+	resp, cleanup := client.Put("/v2/keys/eth/"+key.Address.Hex()+"?signingEnabled=true", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.True(t, resource.SigningEnabled)
+
+	state, err = app.KeyStore.Eth().GetState(key.ID())
+	require.NoError(t, err)
+	assert.True(t, state.SigningEnabled)
+
+	resp, cleanup = client.Put("/v2/keys/eth/"+key.Address.Hex()+"?signingEnabled=false", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	state, err = app.KeyStore.Eth().GetState(key.ID())
+	require.NoError(t, err)
+	assert.False(t, state.SigningEnabled)
+}
@@ -0,0 +1,93 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultBlocksLimit is the number of blocks which are listed when no limit is
+// given.
+const defaultBlocksLimit = 25
+
+// EVMBlocksController shows the blocks which have been saved by the head
+// tracker of an EVM chain.
+type EVMBlocksController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest blocks of the chain, highest first.
+// Example:
+//  "<application>/blocks/evm?evmChainID=1&limit=10"
+func (bc *EVMBlocksController) Index(c *gin.Context) {
+	limit := uint64(defaultBlocksLimit)
+	if l := c.Query("limit"); l != "" {
+		var err error
+		limit, err = strconv.ParseUint(l, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'limit' query string param"))
+			return
+		}
+	}
+
+	chain, ok := bc.getChain(c)
+	if !ok {
+		return
+	}
+
+	heads, err := bc.headORM(chain).LatestHeads(c.Request.Context(), uint(limit))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.EVMBlockResource{}
+	for _, h := range heads {
+		resources = append(resources, presenters.NewEVMBlockResource(*h))
+	}
+
+	jsonAPIResponse(c, resources, "evm_blocks")
+}
+
+// Show returns the block with the given number.
+// Example:
+//  "<application>/blocks/evm/:number?evmChainID=1"
+func (bc *EVMBlocksController) Show(c *gin.Context) {
+	number, err := strconv.ParseInt(c.Param("number"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, ok := bc.getChain(c)
+	if !ok {
+		return
+	}
+
+	head, err := bc.headORM(chain).HeadByNumber(c.Request.Context(), number)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if head == nil {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Block not found"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMBlockResource(*head), "evm_blocks")
+}
+
+func (bc *EVMBlocksController) getChain(c *gin.Context) (evm.Chain, bool) {
+	return getChainOrError(c, bc.App.GetChains().EVM)
+}
+
+func (bc *EVMBlocksController) headORM(chain evm.Chain) headtracker.ORM {
+	return headtracker.NewORM(bc.App.GetSqlxDB(), bc.App.GetLogger(), bc.App.GetConfig(), *chain.ID())
+}
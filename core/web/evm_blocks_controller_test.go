@@ -0,0 +1,94 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func setupEVMBlocksControllerTest(t *testing.T) (cltest.HTTPClientCleaner, []int64) {
+	t.Helper()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	orm := headtracker.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig(), cltest.FixtureChainID)
+	numbers := []int64{1000000, 1000001, 1000002}
+	for _, n := range numbers {
+		require.NoError(t, orm.IdempotentInsertHead(testutils.Context(t), cltest.Head(n)))
+	}
+
+	return app.NewHTTPClient(), numbers
+}
+
+func TestEVMBlocksController_Index(t *testing.T) {
+	t.Parallel()
+
+	client, numbers := setupEVMBlocksControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/blocks/evm?limit=2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var blocks []presenters.EVMBlockResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &blocks))
+	require.Len(t, blocks, 2)
+	assert.Equal(t, numbers[2], blocks[0].Number)
+	assert.Equal(t, numbers[1], blocks[1].Number)
+	assert.Equal(t, cltest.FixtureChainID.String(), blocks[0].EVMChainID.String())
+}
+
+func TestEVMBlocksController_Index_InvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setupEVMBlocksControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/blocks/evm?limit=-1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestEVMBlocksController_Show(t *testing.T) {
+	t.Parallel()
+
+	client, numbers := setupEVMBlocksControllerTest(t)
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/blocks/evm/%d", numbers[1]))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var block presenters.EVMBlockResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &block))
+	assert.Equal(t, numbers[1], block.Number)
+	assert.Equal(t, fmt.Sprintf("%d", numbers[1]), block.ID)
+}
+
+func TestEVMBlocksController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setupEVMBlocksControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/blocks/evm/999999999")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestEVMBlocksController_Show_InvalidNumber(t *testing.T) {
+	t.Parallel()
+
+	client, _ := setupEVMBlocksControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/blocks/evm/latest")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,86 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// maxLogsBlockRange is the largest block range which can be queried for logs
+// in a single request.
+const maxLogsBlockRange = 10000
+
+// EVMLogsController queries the logs which have been saved by the log poller
+// of an EVM chain.
+type EVMLogsController struct {
+	App chainlink.Application
+}
+
+// Index returns the logs in the block range, optionally filtered by address
+// and topic.
+// Example:
+//  "<application>/logs/evm?evmChainID=1&from=100&to=200&address=0x...&topic=0x..."
+func (lc *EVMLogsController) Index(c *gin.Context) {
+	from, err := strconv.ParseInt(c.Query("from"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'from' query string param"))
+		return
+	}
+	to, err := strconv.ParseInt(c.Query("to"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'to' query string param"))
+		return
+	}
+	if from < 0 || to < from {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid block range: %d to %d", from, to))
+		return
+	}
+	if to-from >= maxLogsBlockRange {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("block range cannot exceed %d blocks", maxLogsBlockRange))
+		return
+	}
+
+	var address *common.Address
+	if a := c.Query("address"); a != "" {
+		addr, aerr := utils.ParseEthereumAddress(a)
+		if aerr != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(aerr, "invalid 'address' query string param"))
+			return
+		}
+		address = &addr
+	}
+
+	var topic *common.Hash
+	if tp := c.Query("topic"); tp != "" {
+		h, herr := hexutil.Decode(tp)
+		if herr != nil || len(h) != common.HashLength {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid 'topic' query string param, must be a 32 byte hex string"))
+			return
+		}
+		hash := common.BytesToHash(h)
+		topic = &hash
+	}
+
+	chain, ok := getChainOrError(c, lc.App.GetChains().EVM)
+	if !ok {
+		return
+	}
+
+	orm := logpoller.NewORM(chain.ID(), lc.App.GetSqlxDB(), lc.App.GetLogger(), lc.App.GetConfig())
+	logs, err := orm.SelectLogsByBlockRangeAddressTopic(from, to, address, topic)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMLogResources(logs), "evm_logs")
+}
@@ -0,0 +1,112 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestEVMLogsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	addr1 := testutils.NewAddress()
+	addr2 := testutils.NewAddress()
+	topic1 := utils.NewHash()
+	topic2 := utils.NewHash()
+	newLog := func(idx, block int64, addr common.Address, topic common.Hash) logpoller.Log {
+		return logpoller.Log{
+			EvmChainId:  utils.NewBig(&cltest.FixtureChainID),
+			LogIndex:    idx,
+			BlockHash:   utils.NewHash(),
+			BlockNumber: block,
+			EventSig:    topic[:],
+			Topics:      [][]byte{topic[:]},
+			Address:     addr,
+			TxHash:      utils.NewHash(),
+			Data:        []byte("hello"),
+		}
+	}
+
+	orm := logpoller.NewORM(&cltest.FixtureChainID, app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig())
+	require.NoError(t, orm.InsertLogs([]logpoller.Log{
+		newLog(1, 10, addr1, topic1),
+		newLog(2, 11, addr1, topic2),
+		newLog(3, 12, addr2, topic1),
+		newLog(4, 20, addr1, topic1),
+	}))
+
+	tests := []struct {
+		name    string
+		query   string
+		indexes []int64
+	}{
+		{"block range", "from=10&to=12", []int64{1, 2, 3}},
+		{"address", fmt.Sprintf("from=10&to=20&address=%s", addr1.Hex()), []int64{1, 2, 4}},
+		{"topic", fmt.Sprintf("from=10&to=20&topic=%s", topic1.Hex()), []int64{1, 3, 4}},
+		{"address and topic", fmt.Sprintf("from=10&to=12&address=%s&topic=%s", addr1.Hex(), topic1.Hex()), []int64{1}},
+		{"no match", "from=13&to=19", nil},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/logs/evm?" + tc.query)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+			var logs []presenters.EVMLogResource
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &logs))
+
+			var indexes []int64
+			for _, l := range logs {
+				indexes = append(indexes, l.LogIndex)
+			}
+			assert.ElementsMatch(t, tc.indexes, indexes)
+		})
+	}
+}
+
+func TestEVMLogsController_Index_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"missing from", "to=10"},
+		{"missing to", "from=10"},
+		{"inverted range", "from=10&to=5"},
+		{"range too large", "from=0&to=10000"},
+		{"bad address", "from=0&to=10&address=0xnotanaddress"},
+		{"short topic", "from=0&to=10&topic=0x1234"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Get("/v2/logs/evm?" + tc.query)
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		})
+	}
+}
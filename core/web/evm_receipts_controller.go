@@ -0,0 +1,38 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EVMReceiptsController shows the transaction receipts which have been saved
+// by the transaction manager.
+type EVMReceiptsController struct {
+	App chainlink.Application
+}
+
+// Show returns the receipt of the transaction with the given hash.
+// Example:
+//  "<application>/receipts/evm/:TxHash"
+func (rc *EVMReceiptsController) Show(c *gin.Context) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	receipt, err := rc.App.TxmORM().FindEthReceiptByTxHash(hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Receipt not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEVMReceiptResource(*receipt), "evm_receipts")
+}
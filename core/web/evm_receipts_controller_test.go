@@ -0,0 +1,50 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestEVMReceiptsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+	attempt := tx.EthTxAttempts[0]
+	receipt := cltest.MustInsertEthReceipt(t, app.TxmORM(), 1, utils.NewHash(), attempt.Hash)
+
+	resp, cleanup := client.Get("/v2/receipts/evm/" + attempt.Hash.Hex())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var res presenters.EVMReceiptResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &res))
+	assert.Equal(t, attempt.Hash, res.TxHash)
+	assert.Equal(t, receipt.BlockHash, res.BlockHash)
+	assert.Equal(t, int64(1), res.BlockNumber)
+}
+
+func TestEVMReceiptsController_Show_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/receipts/evm/" + utils.NewHash().Hex())
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
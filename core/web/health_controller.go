@@ -1,12 +1,15 @@
 package web
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/healthreport"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -14,10 +17,27 @@ type HealthController struct {
 	App chainlink.Application
 }
 
-// NOTE: We only implement the k8s readiness check, *not* the liveness check. Liveness checks are only recommended in cases
-// where the app doesn't crash itself on panic, and if implemented incorrectly can cause cascading failures.
+// Livez responds as long as the node is able to serve HTTP requests.
+//
+// NOTE: Liveness checks, if implemented incorrectly, can cause cascading failures, so Livez deliberately does not
+// depend on the health of any service or dependency. Use Readyz for that.
 // See the following for more information:
 // - https://srcco.de/posts/kubernetes-liveness-probes-are-dangerous.html
+func (hc *HealthController) Livez(c *gin.Context) {
+	c.Status(http.StatusOK)
+}
+
+// Readyz responds 200 when the node's services are ready, and 503 otherwise
+// or while the node is on standby. With the "full" query parameter, it also
+// summarizes the latest health report: the health of each chain, and the
+// number of failing jobs and dependencies.
+//
+// NOTE: The health report does not change the readiness of the node, a
+// failing job or external adapter is no reason to stop routing requests to
+// it. Readyz is not authenticated, so it never generates a report, which
+// queries the DB and the external adapters, but summarizes the one the
+// reporter generates every minute. The current report, with the names of the
+// jobs and dependencies, is only served by /v2/health.
 func (hc *HealthController) Readyz(c *gin.Context) {
 	status := http.StatusOK
 
@@ -25,7 +45,9 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 
 	ready, errors := checker.IsReady()
 
-	if !ready {
+	// A standby node does not run any services, so it is never ready
+	role := hc.App.GetRole()
+	if !ready || role == chainlink.RoleStandby {
 		status = http.StatusServiceUnavailable
 	}
 
@@ -53,6 +75,8 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 			Output: output,
 		})
 	}
+	checks = append(checks, roleCheck(role, true))
+	checks = append(checks, hc.reportChecks()...)
 
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
@@ -65,6 +89,14 @@ func (hc *HealthController) Health(c *gin.Context) {
 
 	healthy, errors := checker.IsHealthy()
 
+	role := hc.App.GetRole()
+	var standbyChecks []presenters.Check
+	if role == chainlink.RoleStandby {
+		var standbyHealthy bool
+		standbyChecks, standbyHealthy = chainStandbyChecks(hc.App.GetChains().EVM)
+		healthy = healthy && standbyHealthy
+	}
+
 	if !healthy {
 		status = http.StatusServiceUnavailable
 	}
@@ -89,7 +121,130 @@ func (hc *HealthController) Health(c *gin.Context) {
 			Output: output,
 		})
 	}
+	checks = append(checks, roleCheck(role, false))
+	checks = append(checks, standbyChecks...)
 
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
 }
+
+// roleCheck reports the role of the node, which fails readiness while the
+// node is on standby.
+func roleCheck(role chainlink.Role, failStandby bool) presenters.Check {
+	status := services.StatusPassing
+	if failStandby && role == chainlink.RoleStandby {
+		status = services.StatusFailing
+	}
+	return presenters.Check{
+		JAID:   presenters.NewJAID("Role"),
+		Name:   "Role",
+		Status: status,
+		Output: string(role),
+	}
+}
+
+// chainStandbyChecks reports the latest head seen by each chain of a standby
+// node. A chain without heads would not be able to take over quickly.
+func chainStandbyChecks(chainSet evm.ChainSet) (checks []presenters.Check, healthy bool) {
+	healthy = true
+	if chainSet == nil {
+		return
+	}
+	for _, chain := range chainSet.Chains() {
+		name := fmt.Sprintf("EVM.%s.Standby", chain.ID())
+		check := presenters.Check{
+			JAID:   presenters.NewJAID(name),
+			Name:   name,
+			Status: services.StatusPassing,
+		}
+		if head := chain.StandbyHead(); head != nil {
+			check.Output = fmt.Sprintf("latest head %d", head.Number)
+		} else {
+			check.Status = services.StatusFailing
+			check.Output = "no heads received"
+			healthy = false
+		}
+		checks = append(checks, check)
+	}
+	return
+}
+
+// reportChecks summarizes the latest health report, see Readyz.
+func (hc *HealthController) reportChecks() []presenters.Check {
+	report, err := hc.App.GetHealthReporter().LatestReport()
+	if err != nil {
+		return []presenters.Check{{
+			JAID:   presenters.NewJAID("HealthReport"),
+			Name:   "HealthReport",
+			Status: services.StatusFailing,
+			Output: err.Error(),
+		}}
+	}
+	return healthReportChecks(*report)
+}
+
+// healthReportChecks returns a check for each chain of report, one for its
+// jobs and one for each type of dependency.
+func healthReportChecks(report healthreport.Report) (checks []presenters.Check) {
+	for _, chain := range report.Chains {
+		name := fmt.Sprintf("EVM.%s.Health", chain.EVMChainID)
+		checks = append(checks, presenters.Check{
+			JAID:   presenters.NewJAID(name),
+			Name:   name,
+			Status: chain.Status,
+			Output: chain.Output,
+		})
+	}
+
+	var failingJobs int
+	for _, j := range report.Jobs {
+		if j.Status == services.StatusFailing {
+			failingJobs++
+		}
+	}
+	checks = append(checks, countCheck("Jobs", failingJobs, len(report.Jobs)))
+
+	var types []healthreport.DependencyType
+	total := make(map[healthreport.DependencyType]int)
+	failing := make(map[healthreport.DependencyType]int)
+	for _, d := range report.Dependencies {
+		if total[d.Type] == 0 {
+			types = append(types, d.Type)
+		}
+		total[d.Type]++
+		if d.Status == services.StatusFailing {
+			failing[d.Type]++
+		}
+	}
+	for _, t := range types {
+		checks = append(checks, countCheck("Dependencies."+string(t), failing[t], total[t]))
+	}
+	return
+}
+
+// countCheck is a check failing when any of total items are failing.
+func countCheck(name string, failing, total int) presenters.Check {
+	status := services.StatusPassing
+	if failing > 0 {
+		status = services.StatusFailing
+	}
+	return presenters.Check{
+		JAID:   presenters.NewJAID(name),
+		Name:   name,
+		Status: status,
+		Output: fmt.Sprintf("%d/%d failing", failing, total),
+	}
+}
+
+// Report returns the health of the node's chains, jobs and external dependencies.
+// Example:
+// "GET <application>/v2/health"
+func (hc *HealthController) Report(c *gin.Context) {
+	report, err := hc.App.GetHealthReporter().Report(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewHealthReportResource(*report, string(hc.App.GetRole())), "healthReport")
+}
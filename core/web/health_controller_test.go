@@ -2,11 +2,16 @@ package web_test
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/healthreport"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +52,110 @@ func TestHealthController_Readyz(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthController_ReadyzFull(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+
+	// The adapter is down, which is reported without failing readiness. The
+	// bridge is created before the reporter generates its first report.
+	ea := httptest.NewServer(http.NotFoundHandler())
+	ea.Close()
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: ea.URL})
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+	var byName map[string]presenters.Check
+	// The report is generated in the background after start
+	require.Eventually(t, func() bool {
+		resp, cleanup := client.Get("/readyz?full")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var checks []presenters.Check
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &checks))
+		byName = make(map[string]presenters.Check)
+		for _, c := range checks {
+			byName[c.Name] = c
+		}
+		_, ok := byName["Jobs"]
+		return ok
+	}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+	if assert.Contains(t, byName, "Jobs") {
+		assert.Equal(t, services.StatusPassing, byName["Jobs"].Status)
+		assert.Equal(t, "0/0 failing", byName["Jobs"].Output)
+	}
+	if assert.Contains(t, byName, "Dependencies.bridge") {
+		assert.Equal(t, services.StatusFailing, byName["Dependencies.bridge"].Status)
+		assert.Equal(t, "1/1 failing", byName["Dependencies.bridge"].Output)
+	}
+	// The names of the dependencies are left out
+	for _, c := range byName {
+		assert.NotContains(t, c.Output, bt.Name.String())
+	}
+}
+
+func TestHealthController_Livez(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/livez")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHealthController_Report(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	ea := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(ea.Close)
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: ea.URL})
+	require.NoError(t, app.BridgeORM().CreateBridgeType(bt))
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/health")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var report presenters.HealthReportResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &report))
+
+	assert.Equal(t, string(chainlink.RoleLeader), report.Role)
+	assert.Equal(t, services.StatusPassing, report.Status)
+	assert.Empty(t, report.Chains)
+	assert.Empty(t, report.Jobs)
+	require.Len(t, report.Dependencies, 1)
+	assert.Equal(t, healthreport.DependencyTypeBridge, report.Dependencies[0].Type)
+	assert.Equal(t, bt.Name.String(), report.Dependencies[0].Name)
+	assert.Equal(t, services.StatusPassing, report.Dependencies[0].Status)
+}
+
+func TestHealthController_Standby(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.StartStandby(testutils.Context(t)))
+	assert.Equal(t, chainlink.RoleStandby, app.GetRole())
+
+	client := app.NewHTTPClient()
+	get := func(path string) int {
+		resp, cleanup := client.Get(path)
+		t.Cleanup(cleanup)
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get("/livez"))
+	assert.Equal(t, http.StatusOK, get("/health"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/readyz"))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/v2/health"))
+
+	// promotion
+	require.NoError(t, app.Start(testutils.Context(t)))
+	assert.Equal(t, chainlink.RoleLeader, app.GetRole())
+
+	assert.Equal(t, http.StatusOK, get("/readyz"))
+	assert.Equal(t, http.StatusOK, get("/v2/health"))
+}
@@ -3,13 +3,16 @@ package web
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
@@ -18,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
@@ -59,28 +63,34 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 // Example:
 // "GET <application>/jobs/:ID"
 func (jc *JobsController) Show(c *gin.Context) {
-	var err error
-	jobSpec := job.Job{}
-	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
+	jobSpec, status, err := jc.findJob(c.Request.Context(), c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// findJob finds a job by job ID or external job ID, and returns the HTTP
+// status to respond with on error.
+func (jc *JobsController) findJob(ctx context.Context, id string) (jobSpec job.Job, status int, err error) {
+	if externalJobID, pErr := uuid.FromString(id); pErr == nil {
 		// Find a job by external job ID
-		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
-	} else if pErr = jobSpec.SetID(c.Param("ID")); pErr == nil {
+		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(ctx))
+	} else if pErr = jobSpec.SetID(id); pErr == nil {
 		// Find a job by job ID
 		jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
 	} else {
-		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
-		return
+		return jobSpec, http.StatusUnprocessableEntity, pErr
 	}
 	if err != nil {
 		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
-			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
-		} else {
-			jsonAPIError(c, http.StatusInternalServerError, err)
+			return jobSpec, http.StatusNotFound, errors.New("job not found")
 		}
-		return
+		return jobSpec, http.StatusInternalServerError, err
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+	return jobSpec, http.StatusOK, nil
 }
 
 // CreateJobRequest represents a request to create and start a job (V2).
@@ -98,65 +108,70 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	var jb job.Job
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	err = jc.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validateJobSpec validates a TOML job spec for its job type, and returns the
+// HTTP status to respond with on error.
+func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status int, err error) {
+	jobType, err := job.ValidateSpec(tomlString)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, request.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, tomlString)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), request.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), tomlString)
 		if !config.Dev() && !config.FeatureOffchainReporting2() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlString, jc.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(request.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(request.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
-		return
-	}
-	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
-		return
+		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
-
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
-	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return jb, http.StatusBadRequest, err
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	return jb, http.StatusOK, nil
 }
 
 // Delete hard deletes a job spec.
@@ -185,3 +200,338 @@ func (jc *JobsController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Export exports jobs as a bundle, together with the bridges, external
+// initiators and keys they depend on. All jobs are exported unless job IDs or
+// external job IDs are given.
+// Example:
+// "GET <application>/jobs/export?id=1&id=2"
+func (jc *JobsController) Export(c *gin.Context) {
+	var jobs []job.Job
+	if ids := c.QueryArray("id"); len(ids) > 0 {
+		for _, id := range ids {
+			jb, status, err := jc.findJob(c.Request.Context(), id)
+			if err != nil {
+				jsonAPIError(c, status, errors.Wrapf(err, "job %s", id))
+				return
+			}
+			jobs = append(jobs, jb)
+		}
+	} else {
+		for {
+			page, count, err := jc.App.JobORM().FindJobs(len(jobs), 1000)
+			if err != nil {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+				return
+			}
+			jobs = append(jobs, page...)
+			if len(page) == 0 || len(jobs) >= count {
+				break
+			}
+		}
+		// Oldest first, so that jobs are imported in the same order
+		sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	}
+
+	bundle, err := jc.bundle(jobs)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	b, err := json.Marshal(bundle)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(http.StatusOK, MediaType, b)
+}
+
+func (jc *JobsController) bundle(jobs []job.Job) (job.Bundle, error) {
+	bundle := job.Bundle{
+		Version:            job.BundleVersion,
+		ExportedAt:         time.Now(),
+		Jobs:               []job.BundleJob{},
+		Bridges:            []bridges.BridgeTypeRequest{},
+		ExternalInitiators: []bridges.ExternalInitiatorRequest{},
+		Keys:               []job.KeyReference{},
+	}
+	var bridgeNames []bridges.BridgeName
+	seenBridges := make(map[bridges.BridgeName]struct{})
+	seenEIs := make(map[string]struct{})
+	seenKeys := make(map[string]struct{})
+	for _, jb := range jobs {
+		if jb.WebhookSpecID != nil && jb.WebhookSpec != nil {
+			eiWebhookSpecs, _, err := jc.App.GetExternalInitiatorManager().Load(*jb.WebhookSpecID)
+			if err != nil {
+				return bundle, err
+			}
+			jb.WebhookSpec.ExternalInitiatorWebhookSpecs = eiWebhookSpecs
+		}
+		toml, err := jb.TOML()
+		if err != nil {
+			return bundle, errors.Wrapf(err, "failed to export job %d", jb.ID)
+		}
+		bundle.Jobs = append(bundle.Jobs, job.BundleJob{Name: jb.Name.ValueOrZero(), Type: jb.Type, TOML: toml})
+
+		names, err := jb.BridgeNames()
+		if err != nil {
+			return bundle, errors.Wrapf(err, "failed to export job %d", jb.ID)
+		}
+		for _, name := range names {
+			if _, ok := seenBridges[name]; !ok {
+				seenBridges[name] = struct{}{}
+				bridgeNames = append(bridgeNames, name)
+			}
+		}
+		for _, name := range jb.ExternalInitiatorNames() {
+			if _, ok := seenEIs[name]; ok {
+				continue
+			}
+			seenEIs[name] = struct{}{}
+			ei, err := jc.App.BridgeORM().FindExternalInitiatorByName(name)
+			if err != nil {
+				return bundle, errors.Wrapf(err, "failed to find external initiator %s", name)
+			}
+			bundle.ExternalInitiators = append(bundle.ExternalInitiators, bridges.ExternalInitiatorRequest{Name: ei.Name, URL: ei.URL})
+		}
+		for _, ref := range jb.KeyReferences() {
+			key := ref.Type + "/" + ref.ID
+			if ref.EVMChainID != nil {
+				key += "/" + ref.EVMChainID.String()
+			}
+			if _, ok := seenKeys[key]; !ok {
+				seenKeys[key] = struct{}{}
+				bundle.Keys = append(bundle.Keys, ref)
+			}
+		}
+	}
+
+	if len(bridgeNames) > 0 {
+		bts, err := jc.App.BridgeORM().FindBridges(bridgeNames)
+		if err != nil {
+			return bundle, err
+		}
+		for _, bt := range bts {
+			bundle.Bridges = append(bundle.Bridges, bridges.BridgeTypeRequest{
+				Name:                   bt.Name,
+				URL:                    bt.URL,
+				Confirmations:          bt.Confirmations,
+				MinimumContractPayment: bt.MinimumContractPayment,
+				MaxConcurrency:         bt.MaxConcurrency,
+				RateLimit:              bt.RateLimit,
+			})
+		}
+	}
+	return bundle, nil
+}
+
+// Import validates all jobs of a bundle against this node's chains, keys,
+// bridges and external initiators, and creates the jobs and any missing
+// bridges in a single transaction. Nothing is created if any item of the
+// bundle is invalid, or for a dry run. The report returns the incoming tokens
+// of the created bridges, and the errors of jobs that failed to start.
+// Example:
+// "POST <application>/jobs/import?dryRun=true"
+func (jc *JobsController) Import(c *gin.Context) {
+	var bundle job.Bundle
+	if err := c.ShouldBindJSON(&bundle); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if bundle.Version != job.BundleVersion {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unsupported bundle version %d", bundle.Version))
+		return
+	}
+
+	report := presenters.JobImportResource{
+		JAID:   presenters.NewJAID("import"),
+		DryRun: c.Query("dryRun") == "true",
+		Items:  []presenters.JobImportItemResource{},
+	}
+	if report.DryRun {
+		report.JAID = presenters.NewJAID("dryRun")
+	}
+	addItem := func(typ, name, action string, err error) {
+		item := presenters.JobImportItemResource{Type: typ, Name: name, Action: action}
+		if err != nil {
+			item.Action = ""
+			item.Error = err.Error()
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	bts, btas, availableBridges := jc.importBridges(bundle.Bridges, addItem)
+	jc.importExternalInitiators(bundle.ExternalInitiators, addItem)
+	jc.importKeys(bundle.Keys, addItem)
+	jbs := jc.importJobs(c.Request.Context(), bundle.Jobs, availableBridges, addItem)
+
+	if report.DryRun || report.HasErrors() {
+		jsonAPIResponse(c, report, "jobImports")
+		return
+	}
+
+	startErrs, err := jc.App.ImportJobs(c.Request.Context(), bts, jbs)
+	if err != nil {
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	report.Imported = true
+
+	// Without errors, the items to create are exactly the bridges and jobs
+	// that were imported, in the same order
+	var bridgeIdx, jobIdx int
+	for i := range report.Items {
+		item := &report.Items[i]
+		if item.Action != presenters.JobImportActionCreate {
+			continue
+		}
+		switch item.Type {
+		case presenters.JobImportItemBridge:
+			item.IncomingToken = btas[bridgeIdx].IncomingToken
+			bridgeIdx++
+		case presenters.JobImportItemJob:
+			if startErrs[jobIdx] != nil {
+				item.StartError = startErrs[jobIdx].Error()
+			}
+			jobIdx++
+		}
+	}
+	jsonAPIResponse(c, report, "jobImports")
+}
+
+type addImportItemFunc func(typ, name, action string, err error)
+
+// importBridges returns the bridges to create with their authentication, and
+// the names of all bridges that jobs can use once they are created.
+func (jc *JobsController) importBridges(btrs []bridges.BridgeTypeRequest, addItem addImportItemFunc) ([]*bridges.BridgeType, []*bridges.BridgeTypeAuthentication, map[bridges.BridgeName]struct{}) {
+	var bts []*bridges.BridgeType
+	var btas []*bridges.BridgeTypeAuthentication
+	available := make(map[bridges.BridgeName]struct{})
+	for i := range btrs {
+		btr := btrs[i]
+		name := btr.Name.String()
+		if err := ValidateBridgeType(&btr); err != nil {
+			addItem(presenters.JobImportItemBridge, name, "", err)
+			continue
+		}
+		existing, err := jc.App.BridgeORM().FindBridge(btr.Name)
+		switch {
+		case err == nil && existing.URL.String() != btr.URL.String():
+			addItem(presenters.JobImportItemBridge, name, "", errors.Errorf("bridge already exists with a different URL: %s", existing.URL.String()))
+		case err == nil:
+			available[btr.Name] = struct{}{}
+			addItem(presenters.JobImportItemBridge, name, presenters.JobImportActionExists, nil)
+		case errors.Is(err, sql.ErrNoRows):
+			bta, bt, err := bridges.NewBridgeType(&btr)
+			if err == nil {
+				bts = append(bts, bt)
+				btas = append(btas, bta)
+				available[btr.Name] = struct{}{}
+			}
+			addItem(presenters.JobImportItemBridge, name, presenters.JobImportActionCreate, err)
+		default:
+			addItem(presenters.JobImportItemBridge, name, "", err)
+		}
+	}
+	return bts, btas, available
+}
+
+// importExternalInitiators checks that the external initiators exist, as they
+// cannot be created without handing out their credentials.
+func (jc *JobsController) importExternalInitiators(eirs []bridges.ExternalInitiatorRequest, addItem addImportItemFunc) {
+	for _, eir := range eirs {
+		_, err := jc.App.BridgeORM().FindExternalInitiatorByName(eir.Name)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.New("external initiator does not exist, it must be created first")
+		}
+		addItem(presenters.JobImportItemExternalInitiator, eir.Name, presenters.JobImportActionExists, err)
+	}
+}
+
+// importKeys checks that the referenced keys exist, as bundles contain no key
+// material.
+func (jc *JobsController) importKeys(refs []job.KeyReference, addItem addImportItemFunc) {
+	ks := jc.App.GetKeyStore()
+	for _, ref := range refs {
+		var err error
+		switch ref.Type {
+		case job.KeyTypeEth:
+			var state ethkey.State
+			state, err = ks.Eth().GetState(ref.ID)
+			if err == nil && ref.EVMChainID != nil && !state.EVMChainID.Equal(ref.EVMChainID) {
+				err = errors.Errorf("key is not enabled for chain %s", ref.EVMChainID.String())
+			}
+		case job.KeyTypeOCR:
+			_, err = ks.OCR().Get(ref.ID)
+		case job.KeyTypeOCR2:
+			_, err = ks.OCR2().Get(ref.ID)
+		case job.KeyTypeVRF:
+			_, err = ks.VRF().Get(ref.ID)
+		case job.KeyTypeSolana:
+			_, err = ks.Solana().Get(ref.ID)
+		case job.KeyTypeTerra:
+			_, err = ks.Terra().Get(ref.ID)
+		default:
+			err = errors.Errorf("unknown key type: %s", ref.Type)
+		}
+		addItem(presenters.JobImportItemKey, ref.Type+" "+ref.ID, presenters.JobImportActionExists, err)
+	}
+}
+
+// importJobs validates the jobs like Create does, and checks that their chains
+// and bridges are available and that they do not exist yet.
+func (jc *JobsController) importJobs(ctx context.Context, bjs []job.BundleJob, availableBridges map[bridges.BridgeName]struct{}, addItem addImportItemFunc) []*job.Job {
+	var jbs []*job.Job
+	seen := make(map[uuid.UUID]struct{})
+	for _, bj := range bjs {
+		jb, err := jc.validateImportedJob(ctx, bj, availableBridges)
+		name := bj.Name
+		if name == "" {
+			name = jb.ExternalJobID.String()
+		}
+		if _, ok := seen[jb.ExternalJobID]; ok && err == nil {
+			err = errors.Errorf("duplicate external job ID %s", jb.ExternalJobID)
+		}
+		if err == nil {
+			seen[jb.ExternalJobID] = struct{}{}
+			jbs = append(jbs, &jb)
+		}
+		addItem(presenters.JobImportItemJob, name, presenters.JobImportActionCreate, err)
+	}
+	return jbs
+}
+
+func (jc *JobsController) validateImportedJob(ctx context.Context, bj job.BundleJob, availableBridges map[bridges.BridgeName]struct{}) (job.Job, error) {
+	jb, _, err := jc.validateJobSpec(bj.TOML)
+	if err != nil {
+		return jb, err
+	}
+	if chainID := jb.EVMChainID(); chainID != nil {
+		if _, err = jc.App.GetChains().EVM.Get(chainID.ToInt()); err != nil {
+			return jb, err
+		}
+	}
+	_, err = jc.App.JobORM().FindJobByExternalJobID(jb.ExternalJobID, pg.WithParentCtx(ctx))
+	if err == nil {
+		return jb, errors.Errorf("job with external job ID %s already exists", jb.ExternalJobID)
+	} else if !errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		return jb, err
+	}
+	names, err := jb.BridgeNames()
+	if err != nil {
+		return jb, err
+	}
+	for _, name := range names {
+		if _, ok := availableBridges[name]; !ok {
+			if _, err = jc.App.BridgeORM().FindBridge(name); err != nil {
+				return jb, errors.Errorf("bridge %s does not exist", name)
+			}
+			availableBridges[name] = struct{}{}
+		}
+	}
+	return jb, nil
+}
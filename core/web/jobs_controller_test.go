@@ -3,6 +3,7 @@ package web_test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -21,7 +22,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -29,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -369,6 +373,122 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Import_DryRun(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	body, err := json.Marshal(job.Bundle{Version: job.BundleVersion + 1})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/import?dryRun=true", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+	body, err = json.Marshal(job.Bundle{
+		Version: job.BundleVersion,
+		Jobs: []job.BundleJob{{
+			Type: job.Webhook,
+			TOML: fmt.Sprintf(testspecs.WebhookSpecNoBody, fetchBridge.Name.String(), "missing"),
+		}},
+	})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/jobs/import?dryRun=true", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var report presenters.JobImportResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &report))
+	assert.True(t, report.DryRun)
+	assert.False(t, report.Imported)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, presenters.JobImportItemJob, report.Items[0].Type)
+	assert.Contains(t, report.Items[0].Error, "missing")
+
+	jobs, _, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestJobsController_Import(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+	submitBridge := bridges.BridgeTypeRequest{
+		Name: bridges.MustParseBridgeName("submitter"),
+		URL:  cltest.WebURL(t, "https://testing.com/submitter"),
+	}
+	body, err := json.Marshal(job.Bundle{
+		Version: job.BundleVersion,
+		Bridges: []bridges.BridgeTypeRequest{submitBridge},
+		Jobs: []job.BundleJob{{
+			Type: job.Webhook,
+			TOML: fmt.Sprintf(testspecs.WebhookSpecNoBody, fetchBridge.Name.String(), submitBridge.Name.String()),
+		}},
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/import", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var report presenters.JobImportResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &report))
+	assert.True(t, report.Imported)
+	assert.False(t, report.HasErrors())
+	assert.False(t, report.HasStartErrors())
+	require.Len(t, report.Items, 2)
+
+	// The bridge gets new tokens, the incoming one is only returned once
+	assert.Equal(t, presenters.JobImportItemBridge, report.Items[0].Type)
+	assert.Equal(t, presenters.JobImportActionCreate, report.Items[0].Action)
+	require.NotEmpty(t, report.Items[0].IncomingToken)
+	bt, err := app.BridgeORM().FindBridge(submitBridge.Name)
+	require.NoError(t, err)
+	ok, err := bridges.AuthenticateBridgeType(&bt, report.Items[0].IncomingToken)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.Equal(t, presenters.JobImportItemJob, report.Items[1].Type)
+	assert.Empty(t, report.Items[1].IncomingToken)
+	jobs, _, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	assert.Len(t, jobs, 1)
+}
+
+func TestJobsController_Import_RollsBackBridges(t *testing.T) {
+	// Transactions must not be no-ops, like they are with the txdb
+	cfg, _ := heavyweight.FullTestDB(t, "jobs_import_rollback")
+	cfg.Overrides.EVMEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+	_, bt, err := bridges.NewBridgeType(&bridges.BridgeTypeRequest{
+		Name: bridges.MustParseBridgeName("submitter"),
+		URL:  cltest.WebURL(t, "https://testing.com/submitter"),
+	})
+	require.NoError(t, err)
+
+	// The second job fails to be created, as its external job ID is taken by
+	// the first one
+	var jbs []*job.Job
+	for i := 0; i < 2; i++ {
+		jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(testspecs.WebhookSpecNoBody, fetchBridge.Name.String(), bt.Name.String()), app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		jbs = append(jbs, &jb)
+	}
+	_, err = app.ImportJobs(testutils.Context(t), []*bridges.BridgeType{bt}, jbs)
+	require.Error(t, err)
+
+	_, err = app.BridgeORM().FindBridge(bt.Name)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	jobs, _, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
@@ -0,0 +1,67 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// KeystoreController manages the keystore as a whole.
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotateKeystorePasswordRequest is the request body for rotating the password
+// of the keystore.
+type RotateKeystorePasswordRequest struct {
+	// Password is the password of the user, which is re-entered to confirm
+	// the rotation
+	Password    string `json:"password"`
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+// RotatePassword re-encrypts the keystore under a new password, with the
+// scrypt parameters of the config. The password of the user and the current
+// keystore password are required, and the new one must be strong enough.
+// Example:
+// "POST <application>/keys/rotate_password"
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, err := kc.App.SessionORM().FindUser()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	scryptParams := utils.GetScryptParams(kc.App.GetConfig())
+	err = kc.App.GetKeyStore().RotatePassword(request.OldPassword, request.NewPassword, scryptParams)
+	switch {
+	case errors.Is(err, keystore.ErrWrongPassword):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case errors.Is(err, keystore.ErrWeakPassword):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
@@ -0,0 +1,83 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/core/web"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	newPassword := "5up3r-53cr3t-n3w-pa55w0rd"
+	tests := []struct {
+		name        string
+		password    string
+		oldPassword string
+		newPassword string
+		status      int
+	}{
+		{"wrong user password", "wrong", cltest.Password, newPassword, http.StatusUnauthorized},
+		{"wrong old password", cltest.Password, "wrong", newPassword, http.StatusConflict},
+		{"weak new password", cltest.Password, cltest.Password, "short", http.StatusUnprocessableEntity},
+		{"success", cltest.Password, cltest.Password, newPassword, http.StatusNoContent},
+		{"old password after rotation", cltest.Password, cltest.Password, newPassword, http.StatusConflict},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, err := json.Marshal(web.RotateKeystorePasswordRequest{
+				Password:    test.password,
+				OldPassword: test.oldPassword,
+				NewPassword: test.newPassword,
+			})
+			require.NoError(t, err)
+
+			resp, cleanup := client.Post("/v2/keys/rotate_password", bytes.NewReader(body))
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, test.status)
+		})
+	}
+}
+
+func TestKeystoreController_RotatePassword_RequiresSession(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	user, err := app.SessionORM().FindUser()
+	require.NoError(t, err)
+	token, err := app.SessionORM().CreateAndSetAuthToken(&user)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		Password:    cltest.Password,
+		OldPassword: cltest.Password,
+		NewPassword: "5up3r-53cr3t-n3w-pa55w0rd",
+	})
+	require.NoError(t, err)
+	request, err := http.NewRequest("POST", app.Server.URL+"/v2/keys/rotate_password", bytes.NewReader(body))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", web.MediaType)
+	request.Header.Set(webauth.APIKey, token.AccessKey)
+	request.Header.Set(webauth.APISecret, token.Secret)
+
+	resp, err := clhttptest.NewTestLocalOnlyHTTPClient().Do(request)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -232,6 +232,33 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Replay_HappyPath(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/pipeline/runs/" + fmt.Sprintf("%v", runIDs[0]) + "/replay")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunReplayResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+	require.NoError(t, err)
+
+	assert.Equal(t, parsedResponse.ID, strconv.Itoa(int(runIDs[0])))
+	assert.False(t, parsedResponse.Diverged)
+	assert.Empty(t, parsedResponse.Divergences)
+	require.Len(t, parsedResponse.Outputs, 1)
+	assert.Equal(t, "3", *parsedResponse.Outputs[0])
+	require.Len(t, parsedResponse.TaskRuns, 8)
+}
+
+func TestPipelineRunsController_Replay_NotFound(t *testing.T) {
+	client, _, _ := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get("/v2/pipeline/runs/999999/replay")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	app := cltest.NewApplicationEVMDisabled(t)
@@ -0,0 +1,131 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PipelineTemplatesController manages pipeline templates, which the
+// pipelines of jobs invoke with subpipeline tasks.
+type PipelineTemplatesController struct {
+	App chainlink.Application
+}
+
+// CreatePipelineTemplateRequest is the request to create a new version of a
+// pipeline template from its TOML spec.
+type CreatePipelineTemplateRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Index lists the latest version of every pipeline template.
+// Example:
+// "GET <application>/pipeline_templates"
+func (ptc *PipelineTemplatesController) Index(c *gin.Context) {
+	templates, err := ptc.App.PipelineORM().FindTemplates()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResources(templates), "pipelineTemplates")
+}
+
+// Show returns the latest version of a pipeline template, or the version
+// given by the version query parameter.
+// Example:
+// "GET <application>/pipeline_templates/:Name?version=2"
+func (ptc *PipelineTemplatesController) Show(c *gin.Context) {
+	var version int64
+	if v := c.Query("version"); v != "" {
+		var err error
+		version, err = strconv.ParseInt(v, 10, 32)
+		if err != nil || version < 1 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid version: %s", v))
+			return
+		}
+	}
+
+	template, err := ptc.App.PipelineORM().FindTemplate(c.Param("Name"), int32(version))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline template not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResource(template), "pipelineTemplate")
+}
+
+// Versions lists every version of a pipeline template, latest first.
+// Example:
+// "GET <application>/pipeline_templates/:Name/versions"
+func (ptc *PipelineTemplatesController) Versions(c *gin.Context) {
+	templates, err := ptc.App.PipelineORM().FindTemplateVersions(c.Param("Name"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(templates) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline template not found"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResources(templates), "pipelineTemplates")
+}
+
+// Create adds a new version of a pipeline template. The first version is
+// created if no template with the name exists yet.
+// Example:
+// "POST <application>/pipeline_templates"
+func (ptc *PipelineTemplatesController) Create(c *gin.Context) {
+	request := CreatePipelineTemplateRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	template, err := pipeline.ValidatedTemplateSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = ptc.App.PipelineORM().CreateTemplate(&template); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewPipelineTemplateResource(template), "pipelineTemplate", http.StatusCreated)
+}
+
+// Delete deletes every version of a pipeline template, unless jobs still
+// invoke it.
+// Example:
+// "DELETE <application>/pipeline_templates/:Name"
+func (ptc *PipelineTemplatesController) Delete(c *gin.Context) {
+	err := ptc.App.PipelineORM().DeleteTemplate(c.Param("Name"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline template not found"))
+		return
+	}
+	if errors.Is(err, pipeline.ErrTemplateInUse) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "pipelineTemplate", http.StatusNoContent)
+}
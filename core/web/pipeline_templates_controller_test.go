@@ -0,0 +1,132 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+const testPipelineTemplateTOML = `
+name = "fetch"
+description = "%s"
+parameters = ["symbol"]
+observationSource = """
+ds [type=memo value="$(symbol)"]
+"""
+`
+
+func createPipelineTemplate(t *testing.T, client cltest.HTTPClientCleaner, toml string) presenters.PipelineTemplateResource {
+	t.Helper()
+
+	body, err := json.Marshal(web.CreatePipelineTemplateRequest{TOML: toml})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Post("/v2/pipeline_templates", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	resource := presenters.PipelineTemplateResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+	return resource
+}
+
+func TestPipelineTemplatesController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	v1 := createPipelineTemplate(t, client, fmt.Sprintf(testPipelineTemplateTOML, "v1"))
+	assert.Equal(t, "fetch", v1.Name)
+	assert.Equal(t, int32(1), v1.Version)
+	assert.Equal(t, []string{"symbol"}, v1.Parameters)
+
+	v2 := createPipelineTemplate(t, client, fmt.Sprintf(testPipelineTemplateTOML, "v2"))
+	assert.Equal(t, int32(2), v2.Version)
+
+	t.Run("rejects an invalid template", func(t *testing.T) {
+		body, err := json.Marshal(web.CreatePipelineTemplateRequest{TOML: `name = "fetch"`})
+		require.NoError(t, err)
+
+		resp, cleanup := client.Post("/v2/pipeline_templates", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("lists templates", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline_templates")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.PipelineTemplateResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, int32(2), resources[0].Version)
+	})
+
+	t.Run("shows a template", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline_templates/fetch?version=1")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resource := presenters.PipelineTemplateResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+		assert.Equal(t, "v1", resource.Description)
+
+		resp, cleanup = client.Get("/v2/pipeline_templates/nonexistent")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("lists versions", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline_templates/fetch/versions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.PipelineTemplateResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, "v2", resources[0].Description)
+		assert.Equal(t, "v1", resources[1].Description)
+	})
+
+	t.Run("refuses to delete a template which a job invokes", func(t *testing.T) {
+		jb, err := webhook.ValidatedWebhookSpec(`
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+sub [type=subpipeline template="fetch" inputs=<{"symbol": "ETH"}>]
+"""
+`, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+		require.NoError(t, app.AddJobV2(testutils.Context(t), &jb))
+
+		resp, cleanup := client.Delete("/v2/pipeline_templates/fetch")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+		require.NoError(t, app.DeleteJob(testutils.Context(t), jb.ID))
+	})
+
+	t.Run("deletes a template", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/pipeline_templates/fetch")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete("/v2/pipeline_templates/fetch")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
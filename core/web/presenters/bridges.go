@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -17,7 +18,12 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	MaxConcurrency         uint32       `json:"maxConcurrency"`
+	RateLimit              float64      `json:"rateLimit"`
+	// CircuitBreaker is the state of the circuit breaker of the requests to
+	// the host of the bridge URL
+	CircuitBreaker pipeline.CircuitBreakerState `json:"circuitBreaker"`
+	CreatedAt      time.Time                    `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -25,8 +31,9 @@ func (r BridgeResource) GetName() string {
 	return "bridges"
 }
 
-// NewBridgeResource constructs a new BridgeResource
-func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
+// NewBridgeResource constructs a new BridgeResource, with the state of the
+// circuit breaker of the bridge host
+func NewBridgeResource(b bridges.BridgeType, circuitBreakers *pipeline.HostCircuitBreakers) *BridgeResource {
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
@@ -35,6 +42,9 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		MaxConcurrency:         b.MaxConcurrency,
+		RateLimit:              b.RateLimit,
+		CircuitBreaker:         circuitBreakers.State(b.URL.Host),
 		CreatedAt:              b.CreatedAt,
 	}
 }
@@ -29,7 +29,7 @@ func TestBridgeResource(t *testing.T) {
 		CreatedAt:              timestamp,
 	}
 
-	r := NewBridgeResource(bridge)
+	r := NewBridgeResource(bridge, nil)
 
 	b, err := jsonapi.Marshal(r)
 	require.NoError(t, err)
@@ -45,6 +45,9 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"maxConcurrency":0,
+			"rateLimit":0,
+			"circuitBreaker":"closed",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +73,9 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"maxConcurrency":0,
+			"rateLimit":0,
+			"circuitBreaker":"closed",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
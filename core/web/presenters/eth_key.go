@@ -17,6 +17,8 @@ type ETHKeyResource struct {
 	EthBalance     *assets.Eth  `json:"ethBalance"`
 	LinkBalance    *assets.Link `json:"linkBalance"`
 	IsFunding      bool         `json:"isFunding"`
+	Disabled       bool         `json:"disabled"`
+	SigningEnabled bool         `json:"signingEnabled"`
 	CreatedAt      time.Time    `json:"createdAt"`
 	UpdatedAt      time.Time    `json:"updatedAt"`
 	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
@@ -39,14 +41,16 @@ type NewETHKeyOption func(*ETHKeyResource) error
 // Use the functional options to inject the ETH and LINK balances
 func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOption) (*ETHKeyResource, error) {
 	r := &ETHKeyResource{
-		JAID:        NewJAID(k.Address.Hex()),
-		EVMChainID:  state.EVMChainID,
-		Address:     k.Address.Hex(),
-		EthBalance:  nil,
-		LinkBalance: nil,
-		IsFunding:   state.IsFunding,
-		CreatedAt:   state.CreatedAt,
-		UpdatedAt:   state.UpdatedAt,
+		JAID:           NewJAID(k.Address.Hex()),
+		EVMChainID:     state.EVMChainID,
+		Address:        k.Address.Hex(),
+		EthBalance:     nil,
+		LinkBalance:    nil,
+		IsFunding:      state.IsFunding,
+		Disabled:       state.Disabled,
+		SigningEnabled: state.SigningEnabled,
+		CreatedAt:      state.CreatedAt,
+		UpdatedAt:      state.UpdatedAt,
 	}
 
 	for _, opt := range opts {
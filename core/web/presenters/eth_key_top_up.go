@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ETHKeyTopUpResource is a JSONAPI resource of a top-up of a sending key from
+// the funding key of its chain.
+type ETHKeyTopUpResource struct {
+	JAID
+	EVMChainID  utils.Big  `json:"evmChainID"`
+	FromAddress string     `json:"fromAddress"`
+	ToAddress   string     `json:"toAddress"`
+	Amount      assets.Eth `json:"amount"`
+	// Balance is the balance of the sending key when it was topped up
+	Balance   assets.Eth `json:"balance"`
+	EthTxID   *int64     `json:"ethTxID"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyTopUpResource) GetName() string {
+	return "eth_key_top_ups"
+}
+
+// NewETHKeyTopUpResource returns a new ETHKeyTopUpResource for the top-up.
+func NewETHKeyTopUpResource(t monitor.TopUp) ETHKeyTopUpResource {
+	r := ETHKeyTopUpResource{
+		JAID:        NewJAID(strconv.FormatInt(t.ID, 10)),
+		EVMChainID:  t.EVMChainID,
+		FromAddress: t.FromAddress.Hex(),
+		ToAddress:   t.ToAddress.Hex(),
+		Amount:      t.Amount,
+		Balance:     t.Balance,
+		CreatedAt:   t.CreatedAt,
+	}
+	if t.EthTxID.Valid {
+		r.EthTxID = &t.EthTxID.Int64
+	}
+
+	return r
+}
@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EVMBlockResource is an EVM block JSONAPI resource, built from a head which
+// has been saved by the head tracker.
+type EVMBlockResource struct {
+	JAID
+	Number        int64       `json:"number"`
+	Hash          common.Hash `json:"hash"`
+	ParentHash    common.Hash `json:"parentHash"`
+	Timestamp     time.Time   `json:"timestamp"`
+	BaseFeePerGas *utils.Big  `json:"baseFeePerGas"`
+	EVMChainID    *utils.Big  `json:"evmChainID"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	L1BlockNumber *int64      `json:"l1BlockNumber"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMBlockResource) GetName() string {
+	return "evm_blocks"
+}
+
+// NewEVMBlockResource returns a new EVMBlockResource for the head.
+func NewEVMBlockResource(h evmtypes.Head) EVMBlockResource {
+	r := EVMBlockResource{
+		JAID:          NewJAID(fmt.Sprintf("%d", h.Number)),
+		Number:        h.Number,
+		Hash:          h.Hash,
+		ParentHash:    h.ParentHash,
+		Timestamp:     h.Timestamp,
+		BaseFeePerGas: h.BaseFeePerGas,
+		EVMChainID:    h.EVMChainID,
+		CreatedAt:     h.CreatedAt,
+	}
+	if h.L1BlockNumber.Valid {
+		r.L1BlockNumber = &h.L1BlockNumber.Int64
+	}
+
+	return r
+}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EVMLogResource is an EVM log JSONAPI resource, built from a log which has
+// been saved by the log poller.
+type EVMLogResource struct {
+	JAID
+	Address     common.Address `json:"address"`
+	Topics      []common.Hash  `json:"topics"`
+	Data        hexutil.Bytes  `json:"data"`
+	BlockNumber int64          `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    int64          `json:"logIndex"`
+	EVMChainID  *utils.Big     `json:"evmChainID"`
+	CreatedAt   time.Time      `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMLogResource) GetName() string {
+	return "evm_logs"
+}
+
+// NewEVMLogResource returns a new EVMLogResource for the log.
+func NewEVMLogResource(l logpoller.Log) EVMLogResource {
+	return EVMLogResource{
+		JAID:        NewJAID(fmt.Sprintf("%s-%d", l.BlockHash.Hex(), l.LogIndex)),
+		Address:     l.Address,
+		Topics:      l.GetTopics(),
+		Data:        l.Data,
+		BlockNumber: l.BlockNumber,
+		BlockHash:   l.BlockHash,
+		TxHash:      l.TxHash,
+		LogIndex:    l.LogIndex,
+		EVMChainID:  l.EvmChainId,
+		CreatedAt:   l.CreatedAt,
+	}
+}
+
+// NewEVMLogResources returns a slice of EVMLogResources for the logs.
+func NewEVMLogResources(logs []logpoller.Log) []EVMLogResource {
+	rs := []EVMLogResource{}
+	for _, l := range logs {
+		rs = append(rs, NewEVMLogResource(l))
+	}
+
+	return rs
+}
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+)
+
+// EVMReceiptResource is an EVM transaction receipt JSONAPI resource, built
+// from a receipt which has been saved by the transaction manager.
+type EVMReceiptResource struct {
+	JAID
+	TxHash           common.Hash     `json:"txHash"`
+	BlockHash        common.Hash     `json:"blockHash"`
+	BlockNumber      int64           `json:"blockNumber"`
+	TransactionIndex uint            `json:"transactionIndex"`
+	Receipt          json.RawMessage `json:"receipt"`
+	CreatedAt        time.Time       `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMReceiptResource) GetName() string {
+	return "evm_receipts"
+}
+
+// NewEVMReceiptResource returns a new EVMReceiptResource for the receipt.
+func NewEVMReceiptResource(r txmgr.EthReceipt) EVMReceiptResource {
+	return EVMReceiptResource{
+		JAID:             NewJAID(r.TxHash.Hex()),
+		TxHash:           r.TxHash,
+		BlockHash:        r.BlockHash,
+		BlockNumber:      r.BlockNumber,
+		TransactionIndex: r.TransactionIndex,
+		Receipt:          r.Receipt,
+		CreatedAt:        r.CreatedAt,
+	}
+}
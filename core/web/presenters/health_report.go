@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/healthreport"
+)
+
+// HealthReportResource represents the health of the node's chains, jobs and
+// external dependencies.
+type HealthReportResource struct {
+	JAID
+	Role         string                          `json:"role"`
+	Status       services.Status                 `json:"status"`
+	Chains       []healthreport.ChainHealth      `json:"chains"`
+	Jobs         []healthreport.JobHealth        `json:"jobs"`
+	Dependencies []healthreport.DependencyHealth `json:"dependencies"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r HealthReportResource) GetName() string {
+	return "healthReports"
+}
+
+// NewHealthReportResource constructs a new HealthReportResource.
+func NewHealthReportResource(report healthreport.Report, role string) *HealthReportResource {
+	return &HealthReportResource{
+		JAID:         NewJAID("health"),
+		Role:         role,
+		Status:       report.Status,
+		Chains:       report.Chains,
+		Jobs:         report.Jobs,
+		Dependencies: report.Dependencies,
+	}
+}
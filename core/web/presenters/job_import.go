@@ -0,0 +1,63 @@
+package presenters
+
+// Types of the items of a job bundle import
+const (
+	JobImportItemJob               = "job"
+	JobImportItemBridge            = "bridge"
+	JobImportItemExternalInitiator = "externalInitiator"
+	JobImportItemKey               = "key"
+)
+
+// Actions taken, or for a dry run that would be taken, for the items of a job
+// bundle import
+const (
+	JobImportActionCreate = "create"
+	JobImportActionExists = "exists"
+)
+
+// JobImportItemResource is the result of importing a single item of a job
+// bundle.
+type JobImportItemResource struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Action string `json:"action,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// The IncomingToken is only provided for bridges created by the import
+	IncomingToken string `json:"incomingToken,omitempty"`
+	// StartError is set for jobs that were created but failed to start
+	StartError string `json:"startError,omitempty"`
+}
+
+// JobImportResource is the report of a job bundle import. Nothing is imported
+// unless every item can be.
+type JobImportResource struct {
+	JAID
+	DryRun   bool                    `json:"dryRun"`
+	Imported bool                    `json:"imported"`
+	Items    []JobImportItemResource `json:"items"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobImportResource) GetName() string {
+	return "jobImports"
+}
+
+// HasStartErrors returns whether any job was imported but failed to start.
+func (r JobImportResource) HasStartErrors() bool {
+	for _, item := range r.Items {
+		if item.StartError != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasErrors returns whether any item of the bundle could not be imported.
+func (r JobImportResource) HasErrors() bool {
+	for _, item := range r.Items {
+		if item.Error != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -72,12 +72,7 @@ func (r PipelineTaskRunResource) GetName() string {
 }
 
 func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
-	var output *string
-	if tr.Output.Valid {
-		outputBytes, _ := tr.Output.MarshalJSON()
-		outputStr := string(outputBytes)
-		output = &outputStr
-	}
+	output := taskRunOutputString(tr.Output)
 	var error *string
 	if tr.Error.Valid {
 		error = &tr.Error.String
@@ -101,3 +96,76 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource is the outcome of replaying a pipeline run
+type PipelineRunReplayResource struct {
+	JAID
+	Diverged        bool                                `json:"diverged"`
+	OutputsDiverged bool                                `json:"outputsDiverged"`
+	Outputs         []*string                           `json:"outputs"`
+	FatalErrors     []*string                           `json:"fatalErrors"`
+	Divergences     []PipelineTaskRunDivergenceResource `json:"divergences"`
+	TaskRuns        []PipelineTaskRunResource           `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// NewPipelineRunReplayResource constructs a new PipelineRunReplayResource for
+// the replay of the run with the given ID
+func NewPipelineRunReplayResource(runID int64, rr pipeline.ReplayResult, lggr logger.Logger) PipelineRunReplayResource {
+	lggr = lggr.Named("PipelineRunReplayResource")
+	var trs []PipelineTaskRunResource
+	for i := range rr.Run.PipelineTaskRuns {
+		trs = append(trs, NewPipelineTaskRunResource(rr.Run.PipelineTaskRuns[i]))
+	}
+
+	var divergences []PipelineTaskRunDivergenceResource
+	for _, d := range rr.Divergences {
+		divergences = append(divergences, PipelineTaskRunDivergenceResource{
+			DotID:          d.DotID,
+			Type:           d.Type,
+			RecordedOutput: taskRunOutputString(d.RecordedOutput),
+			RecordedError:  d.RecordedError.Ptr(),
+			ReplayedOutput: taskRunOutputString(d.ReplayedOutput),
+			ReplayedError:  d.ReplayedError.Ptr(),
+		})
+	}
+
+	outputs, err := rr.Run.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", rr.Run.Outputs)
+	}
+
+	return PipelineRunReplayResource{
+		JAID:            NewJAIDInt64(runID),
+		Diverged:        rr.Diverged(),
+		OutputsDiverged: rr.OutputsDiverged,
+		Outputs:         outputs,
+		FatalErrors:     rr.Run.StringFatalErrors(),
+		Divergences:     divergences,
+		TaskRuns:        trs,
+	}
+}
+
+// PipelineTaskRunDivergenceResource is a task whose replayed output or error
+// differs from the recorded one
+type PipelineTaskRunDivergenceResource struct {
+	DotID          string            `json:"dotId"`
+	Type           pipeline.TaskType `json:"type"`
+	RecordedOutput *string           `json:"recordedOutput"`
+	RecordedError  *string           `json:"recordedError"`
+	ReplayedOutput *string           `json:"replayedOutput"`
+	ReplayedError  *string           `json:"replayedError"`
+}
+
+func taskRunOutputString(output pipeline.JSONSerializable) *string {
+	if !output.Valid {
+		return nil
+	}
+	outputBytes, _ := output.MarshalJSON()
+	outputStr := string(outputBytes)
+	return &outputStr
+}
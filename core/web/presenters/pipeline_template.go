@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// PipelineTemplateResource represents a version of a pipeline template
+// JSONAPI resource.
+type PipelineTemplateResource struct {
+	JAID
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	Description  string    `json:"description"`
+	Parameters   []string  `json:"parameters"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTemplateResource) GetName() string {
+	return "pipelineTemplates"
+}
+
+// NewPipelineTemplateResource constructs a new PipelineTemplateResource
+func NewPipelineTemplateResource(t pipeline.Template) *PipelineTemplateResource {
+	params := []string{}
+	params = append(params, t.Parameters...)
+
+	return &PipelineTemplateResource{
+		JAID:         NewJAID(fmt.Sprintf("%s@%d", t.Name, t.Version)),
+		Name:         t.Name,
+		Version:      t.Version,
+		Description:  t.Description,
+		Parameters:   params,
+		DotDagSource: t.DotDagSource,
+		CreatedAt:    t.CreatedAt,
+	}
+}
+
+// NewPipelineTemplateResources constructs a slice of PipelineTemplateResources
+func NewPipelineTemplateResources(ts []pipeline.Template) []PipelineTemplateResource {
+	rs := []PipelineTemplateResource{}
+	for _, t := range ts {
+		rs = append(rs, *NewPipelineTemplateResource(t))
+	}
+	return rs
+}
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+)
+
+// ProfileSampleResource represents a set of profiles sampled at the same time
+// by the continuous profiler.
+type ProfileSampleResource struct {
+	JAID
+	Time  time.Time `json:"time"`
+	Types []string  `json:"types"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ProfileSampleResource) GetName() string {
+	return "profileSamples"
+}
+
+// NewProfileSampleResource constructs a new ProfileSampleResource.
+func NewProfileSampleResource(sample services.ProfileSample) *ProfileSampleResource {
+	return &ProfileSampleResource{
+		JAID:  NewJAID(strconv.FormatInt(sample.Time.UnixNano(), 10)),
+		Time:  sample.Time,
+		Types: sample.Types,
+	}
+}
+
+// NewProfileSampleResources constructs a slice of ProfileSampleResources.
+func NewProfileSampleResources(samples []services.ProfileSample) []ProfileSampleResource {
+	rs := []ProfileSampleResource{}
+	for _, sample := range samples {
+		rs = append(rs, *NewProfileSampleResource(sample))
+	}
+
+	return rs
+}
@@ -0,0 +1,86 @@
+package presenters
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ReplayedLogResource is a log which was, or for a dry run would be,
+// re-delivered by a targeted replay.
+type ReplayedLogResource struct {
+	JobID       int32          `json:"jobID,omitempty"`
+	LogPoller   bool           `json:"logPoller"`
+	Address     common.Address `json:"address"`
+	Topic       common.Hash    `json:"topic"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    uint           `json:"logIndex"`
+}
+
+// ReplayResource is a targeted replay JSONAPI resource.
+type ReplayResource struct {
+	JAID
+	EVMChainID     *utils.Big            `json:"evmChainID"`
+	FromBlock      int64                 `json:"fromBlock"`
+	ToBlock        int64                 `json:"toBlock"`
+	CurrentBlock   int64                 `json:"currentBlock"`
+	JobIDs         []int32               `json:"jobIDs"`
+	Addresses      []common.Address      `json:"addresses"`
+	ForceBroadcast bool                  `json:"forceBroadcast"`
+	DryRun         bool                  `json:"dryRun"`
+	LogsFound      int                   `json:"logsFound"`
+	LogsDelivered  int                   `json:"logsDelivered"`
+	Logs           []ReplayedLogResource `json:"logs"`
+	Done           bool                  `json:"done"`
+	Error          string                `json:"error,omitempty"`
+	StartedAt      time.Time             `json:"startedAt"`
+	FinishedAt     *time.Time            `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReplayResource) GetName() string {
+	return "replays"
+}
+
+// NewReplayResource returns a new ReplayResource for the progress of a
+// targeted replay.
+func NewReplayResource(chainID *big.Int, p log.ReplayProgress) *ReplayResource {
+	logs := []ReplayedLogResource{}
+	for _, l := range p.Logs {
+		logs = append(logs, ReplayedLogResource{
+			JobID:       l.JobID,
+			LogPoller:   l.LogPoller,
+			Address:     l.Address,
+			Topic:       l.Topic,
+			BlockNumber: l.BlockNumber,
+			BlockHash:   l.BlockHash,
+			TxHash:      l.TxHash,
+			LogIndex:    l.LogIndex,
+		})
+	}
+
+	return &ReplayResource{
+		JAID:           NewJAID(p.ID),
+		EVMChainID:     utils.NewBig(chainID),
+		FromBlock:      p.FromBlock,
+		ToBlock:        p.ToBlock,
+		CurrentBlock:   p.CurrentBlock,
+		JobIDs:         p.JobIDs,
+		Addresses:      p.Addresses,
+		ForceBroadcast: p.ForceBroadcast,
+		DryRun:         p.DryRun,
+		LogsFound:      p.LogsFound,
+		LogsDelivered:  p.LogsDelivered,
+		Logs:           logs,
+		Done:           p.Done,
+		Error:          p.Error,
+		StartedAt:      p.StartedAt,
+		FinishedAt:     p.FinishedAt,
+	}
+}
@@ -0,0 +1,31 @@
+package presenters
+
+// SecretResource represents a node secret JSONAPI resource. The value of a
+// secret is never presented.
+type SecretResource struct {
+	JAID
+	Name string `json:"name"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (SecretResource) GetName() string {
+	return "secrets"
+}
+
+// NewSecretResource constructs a new SecretResource
+func NewSecretResource(name string) *SecretResource {
+	return &SecretResource{
+		JAID: NewJAID(name),
+		Name: name,
+	}
+}
+
+// NewSecretResources constructs a list of SecretResources
+func NewSecretResources(names []string) []SecretResource {
+	rs := []SecretResource{}
+	for _, name := range names {
+		rs = append(rs, *NewSecretResource(name))
+	}
+
+	return rs
+}
@@ -0,0 +1,152 @@
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/pprof/profile"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ProfilesController serves the profiles sampled by the continuous profiler
+// of the Nurse.
+type ProfilesController struct {
+	App chainlink.Application
+}
+
+// Index lists the profile samples kept on disk, oldest first.
+// Example:
+//  "<application>/debug/profiles"
+func (pc *ProfilesController) Index(c *gin.Context) {
+	nurse, ok := pc.getNurse(c)
+	if !ok {
+		return
+	}
+
+	samples, err := nurse.ProfileSamples()
+	if err != nil {
+		profileError(c, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewProfileSampleResources(samples), "profileSamples")
+}
+
+// Show returns a profile in pprof format, from the latest sample taken at or
+// before the 'at' RFC3339 timestamp, which defaults to now.
+// Example:
+//  "<application>/debug/profiles/heap?at=2022-06-01T12:00:00Z"
+func (pc *ProfilesController) Show(c *gin.Context) {
+	nurse, ok := pc.getNurse(c)
+	if !ok {
+		return
+	}
+	typ, ok := getProfileType(c)
+	if !ok {
+		return
+	}
+	at := time.Now()
+	if c.Query("at") != "" {
+		if at, ok = getProfileTime(c, "at"); !ok {
+			return
+		}
+	}
+
+	p, err := nurse.Profile(typ, at)
+	if err != nil {
+		profileError(c, err)
+		return
+	}
+
+	writeProfile(c, p, fmt.Sprintf("%s.%d.pprof", typ, p.TimeNanos))
+}
+
+// Diff returns the difference between the profiles sampled at or before the
+// 'from' and 'to' RFC3339 timestamps, in pprof format.
+// Example:
+//  "<application>/debug/profiles/heap/diff?from=2022-06-01T12:00:00Z&to=2022-06-01T13:00:00Z"
+func (pc *ProfilesController) Diff(c *gin.Context) {
+	nurse, ok := pc.getNurse(c)
+	if !ok {
+		return
+	}
+	typ, ok := getProfileType(c)
+	if !ok {
+		return
+	}
+	from, ok := getProfileTime(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := getProfileTime(c, "to")
+	if !ok {
+		return
+	}
+	if to.Before(from) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("'from' must be before 'to'"))
+		return
+	}
+
+	p, err := nurse.DiffProfiles(typ, from, to)
+	if err != nil {
+		profileError(c, err)
+		return
+	}
+
+	writeProfile(c, p, fmt.Sprintf("%s.diff.%d.pprof", typ, p.TimeNanos))
+}
+
+func (pc *ProfilesController) getNurse(c *gin.Context) (*services.Nurse, bool) {
+	nurse := pc.App.GetNurse()
+	if nurse == nil {
+		profileError(c, services.ErrContinuousProfilingDisabled)
+		return nil, false
+	}
+	return nurse, true
+}
+
+func getProfileType(c *gin.Context) (string, bool) {
+	typ := c.Param("type")
+	for _, t := range services.ContinuousProfileTypes {
+		if t == typ {
+			return typ, true
+		}
+	}
+	jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid profile type %q, must be one of %v", typ, services.ContinuousProfileTypes))
+	return "", false
+}
+
+func getProfileTime(c *gin.Context, param string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, c.Query(param))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err, "invalid '%s' query string param", param))
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func profileError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrContinuousProfilingDisabled), errors.Is(err, services.ErrProfileNotFound):
+		jsonAPIError(c, http.StatusNotFound, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+func writeProfile(c *gin.Context, p *profile.Profile, filename string) {
+	var buf bytes.Buffer
+	if err := p.Write(&buf); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+}
@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestProfilesController_Disabled(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+	resp, cleanup := client.Get("/v2/debug/profiles")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestProfilesController(t *testing.T) {
+	t.Setenv(envvar.Name("AutoPprofEnabled"), "true")
+	t.Setenv(envvar.Name("AutoPprofProfileRoot"), t.TempDir())
+	t.Setenv(envvar.Name("AutoPprofContinuousEnabled"), "true")
+	t.Setenv(envvar.Name("AutoPprofContinuousInterval"), "200ms")
+	t.Setenv(envvar.Name("AutoPprofGatherDuration"), "10ms")
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	var samples []presenters.ProfileSampleResource
+	require.Eventually(t, func() bool {
+		resp, cleanup := client.Get("/v2/debug/profiles")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &samples))
+		return len(samples) >= 2
+	}, testutils.WaitTimeout(t), 100*time.Millisecond)
+	assert.Equal(t, []string{"block", "cpu", "heap", "mutex"}, samples[0].Types)
+
+	from, to := samples[0].Time.Format(time.RFC3339Nano), samples[1].Time.Format(time.RFC3339Nano)
+
+	t.Run("show", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/debug/profiles/heap?at=" + url.QueryEscape(from))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		p, err := profile.Parse(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, samples[0].Time.UnixNano(), p.TimeNanos)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/debug/profiles/mutex/diff?from=%s&to=%s", url.QueryEscape(from), url.QueryEscape(to)))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		p, err := profile.Parse(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, samples[1].Time.Sub(samples[0].Time).Nanoseconds(), p.DurationNanos)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/debug/profiles/heap?at=2000-01-01T00:00:00Z")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/debug/profiles/goroutine")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 type ReplayController struct {
@@ -70,6 +74,91 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// TargetedReplayRequest is a request to replay the logs of a block range,
+// restricted to a set of jobs and contract addresses.
+type TargetedReplayRequest struct {
+	FromBlock      int64            `json:"fromBlock"`
+	ToBlock        *int64           `json:"toBlock"`
+	JobIDs         []int32          `json:"jobIDs"`
+	Addresses      []common.Address `json:"addresses"`
+	ForceBroadcast bool             `json:"forceBroadcast"`
+	DryRun         bool             `json:"dryRun"`
+}
+
+// Create starts a targeted replay. For a dry run, the response lists the logs
+// which would be re-delivered once the replay is done.
+// Example:
+//  "<application>/v2/replays"
+func (bdc *ReplayController) Create(c *gin.Context) {
+	request := TargetedReplayRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.FromBlock < 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("block number cannot be negative: %v", request.FromBlock))
+		return
+	}
+	if request.ToBlock != nil && *request.ToBlock < request.FromBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("to block %v is before from block %v", *request.ToBlock, request.FromBlock))
+		return
+	}
+
+	chain, ok := getChainOrError(c, bdc.App.GetChains().EVM)
+	if !ok {
+		return
+	}
+
+	req := log.ReplayRequest{
+		FromBlock:      request.FromBlock,
+		JobIDs:         request.JobIDs,
+		Addresses:      request.Addresses,
+		ForceBroadcast: request.ForceBroadcast,
+		DryRun:         request.DryRun,
+	}
+	if request.ToBlock != nil {
+		req.ToBlock = null.Int64From(*request.ToBlock)
+	}
+
+	progress, err := bdc.App.ReplayTargeted(chain.ID(), req)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if request.DryRun {
+		progress, err = chain.LogBroadcaster().AwaitReplay(c.Request.Context(), progress.ID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewReplayResource(chain.ID(), progress), "replays", http.StatusCreated)
+}
+
+// Show returns the progress of a targeted replay.
+// Example:
+//  "<application>/v2/replays/:ID"
+func (bdc *ReplayController) Show(c *gin.Context) {
+	chain, ok := getChainOrError(c, bdc.App.GetChains().EVM)
+	if !ok {
+		return
+	}
+
+	progress, err := chain.LogBroadcaster().ReplayProgress(c.Param("ID"))
+	if errors.Is(err, log.ErrReplayNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReplayResource(chain.ID(), progress), "replays")
+}
+
 type ReplayResponse struct {
 	Message    string     `json:"message"`
 	EVMChainID *utils.Big `json:"evmChainID"`
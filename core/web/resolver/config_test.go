@@ -159,6 +159,10 @@ func TestResolver_Config(t *testing.T) {
         "key": "DATABASE_BACKUP_ON_VERSION_UPGRADE",
         "value": "true"
       },
+      {
+        "key": "DATABASE_BACKUP_RETENTION_COUNT",
+        "value": "0"
+      },
       {
         "key": "DATABASE_LOCKING_MODE",
         "value": "none"
@@ -219,6 +223,26 @@ func TestResolver_Config(t *testing.T) {
         "key": "GAS_ESTIMATOR_MODE",
         "value": ""
       },
+      {
+        "key": "HTTP_CIRCUIT_BREAKER_THRESHOLD",
+        "value": "0"
+      },
+      {
+        "key": "HTTP_CIRCUIT_BREAKER_TIMEOUT",
+        "value": "30s"
+      },
+      {
+        "key": "HEALTH_BRIDGE_TIMEOUT",
+        "value": "5s"
+      },
+      {
+        "key": "HEALTH_HEAD_AGE_THRESHOLD",
+        "value": "5m0s"
+      },
+      {
+        "key": "HEALTH_TX_BACKLOG_THRESHOLD",
+        "value": "100"
+      },
       {
         "key": "INSECURE_FAST_SCRYPT",
         "value": "true"
@@ -287,6 +311,14 @@ func TestResolver_Config(t *testing.T) {
         "key": "LEASE_LOCK_REFRESH_INTERVAL",
         "value": "1s"
       },
+      {
+        "key": "STANDBY_ENABLED",
+        "value": "false"
+      },
+      {
+        "key": "STANDBY_PROMOTION_TIMEOUT",
+        "value": "30s"
+      },
       {
         "key": "FLAGS_CONTRACT_ADDRESS",
         "value": ""
@@ -323,6 +355,22 @@ func TestResolver_Config(t *testing.T) {
         "key": "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
         "value": "30s"
       },
+      {
+        "key": "TRACING_ENABLED",
+        "value": "false"
+      },
+      {
+        "key": "TRACING_COLLECTOR_TARGET",
+        "value": "localhost:4318"
+      },
+      {
+        "key": "TRACING_SAMPLING_RATIO",
+        "value": "1"
+      },
+      {
+        "key": "ALERT_DEDUPE_INTERVAL",
+        "value": "1h0m0s"
+      },
       {
         "key": "OCR_DEFAULT_TRANSACTION_QUEUE_DEPTH",
         "value": "1"
@@ -5,8 +5,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
@@ -52,6 +54,14 @@ func (r *ETHKeyResolver) IsFunding() bool {
 	return r.key.state.IsFunding
 }
 
+func (r *ETHKeyResolver) IsDisabled() bool {
+	return r.key.state.Disabled
+}
+
+func (r *ETHKeyResolver) IsSigningEnabled() bool {
+	return r.key.state.SigningEnabled
+}
+
 // ETHBalance returns the ETH balance available
 func (r *ETHKeyResolver) ETHBalance(ctx context.Context) *string {
 	if r.key.chain == nil {
@@ -130,3 +140,41 @@ func NewETHKeysPayload(keys []ETHKey) *ETHKeysPayloadResolver {
 func (r *ETHKeysPayloadResolver) Results() []*ETHKeyResolver {
 	return NewETHKeys(r.keys)
 }
+
+// -- UpdateEthKey mutation --
+
+type UpdateETHKeySuccessResolver struct {
+	key ETHKey
+}
+
+func NewUpdateETHKeySuccess(key ETHKey) *UpdateETHKeySuccessResolver {
+	return &UpdateETHKeySuccessResolver{key: key}
+}
+
+func (r *UpdateETHKeySuccessResolver) EthKey() *ETHKeyResolver {
+	return NewETHKey(r.key)
+}
+
+type UpdateETHKeyPayloadResolver struct {
+	key *ETHKey
+	NotFoundErrorUnionType
+}
+
+func NewUpdateETHKeyPayload(key *ETHKey, err error) *UpdateETHKeyPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: err.Error(), isExpectedErrorFn: func(err error) bool {
+			return errors.As(err, &keystore.KeyNotFoundError{})
+		}}
+	}
+
+	return &UpdateETHKeyPayloadResolver{key: key, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateETHKeyPayloadResolver) ToUpdateEthKeySuccess() (*UpdateETHKeySuccessResolver, bool) {
+	if r.key != nil {
+		return NewUpdateETHKeySuccess(*r.key), true
+	}
+	return nil, false
+}
@@ -487,3 +487,86 @@ func TestResolver_ETHKeys(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_UpdateEthKey(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UpdateEthKey($address: String!, $input: UpdateEthKeyInput!) {
+			updateEthKey(address: $address, input: $input) {
+				... on UpdateEthKeySuccess {
+					ethKey {
+						address
+						isDisabled
+						isSigningEnabled
+					}
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	address := ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81")
+	chainID := utils.NewBigI(42)
+	variables := map[string]interface{}{
+		"address": address.Hex(),
+		"input": map[string]interface{}{
+			"disabled": true,
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateEthKey"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				state := ethkey.State{Address: address, EVMChainID: *chainID}
+				disabledState := state
+				disabledState.Disabled = true
+
+				f.Mocks.ethKs.On("GetState", address.Hex()).Return(state, nil).Once()
+				f.Mocks.ethKs.On("Disable", address.Address(), chainID.ToInt()).Return(nil)
+				f.Mocks.ethKs.On("GetState", address.Hex()).Return(disabledState, nil).Once()
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.Mocks.keystore.On("Eth").Return(f.Mocks.ethKs)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateEthKey": {
+						"ethKey": {
+							"address": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"isDisabled": true,
+							"isSigningEnabled": false
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.ethKs.On("GetState", address.Hex()).Return(ethkey.State{}, errors.New("state not found"))
+				f.Mocks.keystore.On("Eth").Return(f.Mocks.ethKs)
+				f.App.On("GetKeyStore").Return(f.Mocks.keystore)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateEthKey": {
+						"code": "NOT_FOUND",
+						"message": "unable to find Eth key with id 0x5431F5F973781809D18643b87B44921b11355d81"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -11,6 +11,126 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
+// JobType defines the enum values for GQL
+type JobType string
+
+const (
+	// revive:disable
+	JobTypeFluxMonitor JobType = "FLUX_MONITOR"
+	JobTypeOCR         JobType = "OCR"
+	JobTypeOCR2        JobType = "OCR2"
+	// revive:enable
+)
+
+// ToJobType converts the feeds job type into the enum value.
+func ToJobType(jt string) JobType {
+	switch jt {
+	case feeds.JobTypeOffchainReporting:
+		return JobTypeOCR
+	case feeds.JobTypeOffchainReporting2:
+		return JobTypeOCR2
+	default:
+		return JobTypeFluxMonitor
+	}
+}
+
+// FromJobType converts the enum value into the feeds job type.
+func FromJobType(jt JobType) string {
+	switch jt {
+	case JobTypeOCR:
+		return feeds.JobTypeOffchainReporting
+	case JobTypeOCR2:
+		return feeds.JobTypeOffchainReporting2
+	default:
+		return feeds.JobTypeFluxMonitor
+	}
+}
+
+// ApprovalPolicyType defines the enum values for GQL
+type ApprovalPolicyType string
+
+const (
+	// revive:disable
+	ApprovalPolicyTypeAutoApproveUpdates         ApprovalPolicyType = "AUTO_APPROVE_UPDATES"
+	ApprovalPolicyTypeAutoRejectUnlistedJobTypes ApprovalPolicyType = "AUTO_REJECT_UNLISTED_JOB_TYPES"
+	// revive:enable
+)
+
+// ToApprovalPolicyType converts the feeds approval policy type into the enum
+// value.
+func ToApprovalPolicyType(t feeds.ApprovalPolicyType) ApprovalPolicyType {
+	switch t {
+	case feeds.ApprovalPolicyAutoRejectUnlistedJobTypes:
+		return ApprovalPolicyTypeAutoRejectUnlistedJobTypes
+	default:
+		return ApprovalPolicyTypeAutoApproveUpdates
+	}
+}
+
+// FromApprovalPolicyType converts the enum value into the feeds approval
+// policy type.
+func FromApprovalPolicyType(t ApprovalPolicyType) feeds.ApprovalPolicyType {
+	switch t {
+	case ApprovalPolicyTypeAutoRejectUnlistedJobTypes:
+		return feeds.ApprovalPolicyAutoRejectUnlistedJobTypes
+	default:
+		return feeds.ApprovalPolicyAutoApproveUpdates
+	}
+}
+
+// ApprovalPolicyResolver resolves the ApprovalPolicy type.
+type ApprovalPolicyResolver struct {
+	policy feeds.ApprovalPolicy
+}
+
+func NewApprovalPolicies(policies feeds.ApprovalPolicies) []*ApprovalPolicyResolver {
+	resolvers := []*ApprovalPolicyResolver{}
+	for _, p := range policies {
+		resolvers = append(resolvers, &ApprovalPolicyResolver{policy: p})
+	}
+
+	return resolvers
+}
+
+// Type resolves the approval policy's type.
+func (r *ApprovalPolicyResolver) Type() ApprovalPolicyType {
+	return ToApprovalPolicyType(r.policy.Type)
+}
+
+// JobTypes resolves the approval policy's job types.
+func (r *ApprovalPolicyResolver) JobTypes() []JobType {
+	jts := []JobType{}
+	for _, jt := range r.policy.JobTypes {
+		jts = append(jts, ToJobType(jt))
+	}
+
+	return jts
+}
+
+// approvalPolicyInput defines the input of an approval policy.
+type approvalPolicyInput struct {
+	Type     ApprovalPolicyType
+	JobTypes *[]JobType
+}
+
+// toApprovalPolicies converts the approval policy inputs into feeds approval
+// policies.
+func toApprovalPolicies(inputs []approvalPolicyInput) feeds.ApprovalPolicies {
+	policies := feeds.ApprovalPolicies{}
+	for _, in := range inputs {
+		p := feeds.ApprovalPolicy{Type: FromApprovalPolicyType(in.Type)}
+		if in.JobTypes != nil {
+			for _, jt := range *in.JobTypes {
+				p.JobTypes = append(p.JobTypes, FromJobType(jt))
+			}
+		}
+
+		policies = append(policies, p)
+	}
+
+	return policies
+}
+
 // FeedsManagerResolver resolves the FeedsManager type.
 type FeedsManagerResolver struct {
 	mgr feeds.FeedsManager
@@ -49,6 +169,11 @@ func (r *FeedsManagerResolver) PublicKey() string {
 	return r.mgr.PublicKey.String()
 }
 
+// ApprovalPolicies resolves the feed managers's approval policies.
+func (r *FeedsManagerResolver) ApprovalPolicies() []*ApprovalPolicyResolver {
+	return NewApprovalPolicies(r.mgr.ApprovalPolicies)
+}
+
 func (r *FeedsManagerResolver) JobProposals(ctx context.Context) ([]*JobProposalResolver, error) {
 	jps, err := loader.GetJobProposalsByFeedsManagerID(ctx, stringutils.FromInt64(r.mgr.ID))
 	if err != nil {
@@ -325,6 +325,10 @@ func Test_UpdateFeedsManager(t *testing.T) {
 							name
 							uri
 							publicKey
+							approvalPolicies {
+								type
+								jobTypes
+							}
 							isConnectionActive
 							createdAt
 						}
@@ -366,12 +370,19 @@ func Test_UpdateFeedsManager(t *testing.T) {
 					Name:      name,
 					URI:       uri,
 					PublicKey: *pubKey,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{Type: feeds.ApprovalPolicyAutoApproveUpdates},
+					},
+					CreatedAt: f.Timestamp(),
 				}).Return(nil)
 				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(&feeds.FeedsManager{
-					ID:                 mgrID,
-					Name:               name,
-					URI:                uri,
-					PublicKey:          *pubKey,
+					ID:        mgrID,
+					Name:      "old name",
+					URI:       "localhost:1000",
+					PublicKey: *pubKey,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{Type: feeds.ApprovalPolicyAutoApproveUpdates},
+					},
 					IsConnectionActive: false,
 					CreatedAt:          f.Timestamp(),
 				}, nil)
@@ -386,18 +397,112 @@ func Test_UpdateFeedsManager(t *testing.T) {
 						"name": "manager1",
 						"uri": "localhost:2000",
 						"publicKey": "3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808",
+						"approvalPolicies": [{
+							"type": "AUTO_APPROVE_UPDATES",
+							"jobTypes": []
+						}],
+						"isConnectionActive": false,
+						"createdAt": "2021-01-01T00:00:00Z"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "success with approval policies",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(&feeds.FeedsManager{
+					ID:        mgrID,
+					Name:      name,
+					URI:       uri,
+					PublicKey: *pubKey,
+					CreatedAt: f.Timestamp(),
+				}, nil)
+				f.Mocks.feedsSvc.On("UpdateManager", mock.Anything, feeds.FeedsManager{
+					ID:        mgrID,
+					Name:      name,
+					URI:       uri,
+					PublicKey: *pubKey,
+					ApprovalPolicies: feeds.ApprovalPolicies{
+						{
+							Type:     feeds.ApprovalPolicyAutoRejectUnlistedJobTypes,
+							JobTypes: []string{feeds.JobTypeFluxMonitor, feeds.JobTypeOffchainReporting},
+						},
+					},
+					CreatedAt: f.Timestamp(),
+				}).Return(nil)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "1",
+				"input": map[string]interface{}{
+					"name":      name,
+					"uri":       uri,
+					"publicKey": pubKeyHex,
+					"approvalPolicies": []interface{}{
+						map[string]interface{}{
+							"type":     "AUTO_REJECT_UNLISTED_JOB_TYPES",
+							"jobTypes": []interface{}{"FLUX_MONITOR", "OCR"},
+						},
+					},
+				},
+			},
+			result: `
+			{
+				"updateFeedsManager": {
+					"feedsManager": {
+						"id": "1",
+						"name": "manager1",
+						"uri": "localhost:2000",
+						"publicKey": "3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808",
+						"approvalPolicies": [{
+							"type": "AUTO_REJECT_UNLISTED_JOB_TYPES",
+							"jobTypes": ["FLUX_MONITOR", "OCR"]
+						}],
 						"isConnectionActive": false,
 						"createdAt": "2021-01-01T00:00:00Z"
 					}
 				}
 			}`,
 		},
+		{
+			name:          "invalid approval policies",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(&feeds.FeedsManager{ID: mgrID}, nil)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "1",
+				"input": map[string]interface{}{
+					"name":      name,
+					"uri":       uri,
+					"publicKey": pubKeyHex,
+					"approvalPolicies": []interface{}{
+						map[string]interface{}{
+							"type": "AUTO_REJECT_UNLISTED_JOB_TYPES",
+						},
+					},
+				},
+			},
+			result: `
+			{
+				"updateFeedsManager": {
+					"errors": [{
+						"path": "input/approvalPolicies",
+						"message": "at least one job type must be listed",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
 		{
 			name:          "not found",
 			authenticated: true,
 			before: func(f *gqlTestFramework) {
 				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
-				f.Mocks.feedsSvc.On("UpdateManager", mock.Anything, mock.IsType(feeds.FeedsManager{})).Return(nil)
 				f.Mocks.feedsSvc.On("GetManager", mgrID).Return(nil, sql.ErrNoRows)
 			},
 			query:     mutation,
@@ -76,6 +76,18 @@ func (r *JobProposalSpecResolver) Status() SpecStatus {
 	return ToSpecStatus(r.spec.Status)
 }
 
+// ApprovalPolicy resolves to the type of the approval policy which approved or
+// rejected the job proposal spec.
+func (r *JobProposalSpecResolver) ApprovalPolicy() *ApprovalPolicyType {
+	if !r.spec.ApprovalPolicy.Valid {
+		return nil
+	}
+
+	t := ToApprovalPolicyType(feeds.ApprovalPolicyType(r.spec.ApprovalPolicy.String))
+
+	return &t
+}
+
 // StatusUpdatedAt resolves to the the last timestamp that the spec status was
 // updated.
 func (r *JobProposalSpecResolver) StatusUpdatedAt() graphql.Time {
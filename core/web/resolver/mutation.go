@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
@@ -340,9 +342,10 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 }
 
 type createFeedsManagerInput struct {
-	Name      string
-	URI       string
-	PublicKey string
+	Name             string
+	URI              string
+	PublicKey        string
+	ApprovalPolicies *[]approvalPolicyInput
 }
 
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
@@ -365,6 +368,15 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 		PublicKey: *publicKey,
 	}
 
+	if args.Input.ApprovalPolicies != nil {
+		params.ApprovalPolicies = toApprovalPolicies(*args.Input.ApprovalPolicies)
+		if err = params.ApprovalPolicies.Validate(); err != nil {
+			return NewCreateFeedsManagerPayload(nil, nil, map[string]string{
+				"input/approvalPolicies": err.Error(),
+			}), nil
+		}
+	}
+
 	feedsService := r.App.GetFeedsService()
 
 	id, err := feedsService.RegisterManager(params)
@@ -450,9 +462,10 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 }
 
 type updateFeedsManagerInput struct {
-	Name      string
-	URI       string
-	PublicKey string
+	Name             string
+	URI              string
+	PublicKey        string
+	ApprovalPolicies *[]approvalPolicyInput
 }
 
 func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
@@ -475,14 +488,30 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 		}), nil
 	}
 
-	mgr := &feeds.FeedsManager{
-		ID:        id,
-		URI:       args.Input.URI,
-		Name:      args.Input.Name,
-		PublicKey: *publicKey,
+	feedsService := r.App.GetFeedsService()
+
+	mgr, err := feedsService.GetManager(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerPayload(nil, err, nil), nil
+		}
+
+		return nil, err
 	}
 
-	feedsService := r.App.GetFeedsService()
+	mgr.URI = args.Input.URI
+	mgr.Name = args.Input.Name
+	mgr.PublicKey = *publicKey
+
+	// The approval policies are only replaced when they are provided
+	if args.Input.ApprovalPolicies != nil {
+		mgr.ApprovalPolicies = toApprovalPolicies(*args.Input.ApprovalPolicies)
+		if err = mgr.ApprovalPolicies.Validate(); err != nil {
+			return NewUpdateFeedsManagerPayload(nil, nil, map[string]string{
+				"input/approvalPolicies": err.Error(),
+			}), nil
+		}
+	}
 
 	if err = feedsService.UpdateManager(ctx, *mgr); err != nil {
 		return nil, err
@@ -1116,6 +1145,57 @@ func (r *Resolver) DeleteChain(ctx context.Context, args struct {
 	return NewDeleteChainPayload(&chain, nil), nil
 }
 
+type updateETHKeyInput struct {
+	Disabled bool
+}
+
+func (r *Resolver) UpdateEthKey(ctx context.Context, args struct {
+	Address string
+	Input   updateETHKeyInput
+}) (*UpdateETHKeyPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(args.Address) {
+		return nil, fmt.Errorf("invalid address: %s", args.Address)
+	}
+	address := common.HexToAddress(args.Address)
+
+	ks := r.App.GetKeyStore().Eth()
+	state, err := ks.GetState(address.Hex())
+	if err != nil {
+		return NewUpdateETHKeyPayload(nil, keystore.KeyNotFoundError{ID: address.Hex(), KeyType: "Eth"}), nil
+	}
+
+	if args.Input.Disabled {
+		err = ks.Disable(address, state.EVMChainID.ToInt())
+	} else {
+		err = ks.Enable(address, state.EVMChainID.ToInt())
+	}
+	if err != nil {
+		if errors.As(err, &keystore.KeyNotFoundError{}) {
+			return NewUpdateETHKeyPayload(nil, err), nil
+		}
+		return nil, err
+	}
+
+	state, err = ks.GetState(address.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	key := ETHKey{addr: state.Address, state: state}
+	chain, err := r.App.GetChains().EVM.Get(state.EVMChainID.ToInt())
+	if err == nil {
+		key.chain = chain
+	} else if !errors.Is(errors.Cause(err), evm.ErrNoChains) {
+		return nil, fmt.Errorf("error getting EVM Chain: %v", err)
+	}
+
+	return NewUpdateETHKeyPayload(&key, nil), nil
+}
+
 func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	Input struct {
 		TOML string
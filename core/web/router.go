@@ -66,6 +66,7 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 		engine.Use(prometheus.Instrument())
 	}
 	engine.Use(helmet.Default())
+	engine.Use(standbyGuard(app))
 
 	api := engine.Group(
 		"/",
@@ -118,6 +119,29 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 	}
 }
 
+// standbyPaths are the only paths served while the node is on standby.
+var standbyPaths = map[string]struct{}{
+	"/livez":   {},
+	"/readyz":  {},
+	"/health":  {},
+	"/metrics": {},
+}
+
+// standbyGuard rejects requests while the node is on standby, since a standby
+// node must not write to the database, which authenticating a request does.
+func standbyGuard(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if app.GetRole() != chainlink.RoleStandby {
+			return
+		}
+		if _, ok := standbyPaths[c.Request.URL.Path]; ok {
+			return
+		}
+		jsonAPIError(c, http.StatusServiceUnavailable, errors.New("node is on standby"))
+		c.Abort()
+	}
+}
+
 func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	store := memory.NewStore()
 	rate := limiter.Rate{
@@ -215,6 +239,7 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 
 func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	hc := HealthController{app}
+	r.GET("/livez", hc.Livez)
 	r.GET("/readyz", hc.Readyz)
 	r.GET("/health", hc.Health)
 }
@@ -226,6 +251,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
+	// Rotating the keystore password requires a session of the node's admin
+	// user, which an API token does not grant
+	adminv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
+		auth.AuthenticateBySession,
+	))
+	{
+		kc := KeystoreController{app}
+		adminv2.POST("/keys/rotate_password", kc.RotatePassword)
+	}
+
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
@@ -252,6 +287,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
 		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
 
+		ptc := PipelineTemplatesController{app}
+		authv2.GET("/pipeline_templates", ptc.Index)
+		authv2.POST("/pipeline_templates", ptc.Create)
+		authv2.GET("/pipeline_templates/:Name", ptc.Show)
+		authv2.GET("/pipeline_templates/:Name/versions", ptc.Versions)
+		authv2.DELETE("/pipeline_templates/:Name", ptc.Delete)
+
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", ets.Create)
 		authv2.POST("/transfers/evm", ets.Create)
@@ -260,6 +302,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		sts := SolanaTransfersController{app}
 		authv2.POST("/transfers/solana", sts.Create)
 
+		hc := HealthController{app}
+		authv2.GET("/health", hc.Report)
+
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.PATCH("/config", cc.Patch)
@@ -276,6 +321,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		authv2.POST("/replays", rc.Create)
+		authv2.GET("/replays/:ID", rc.Show)
+
+		ebc := EVMBlocksController{app}
+		authv2.GET("/blocks/evm", ebc.Index)
+		authv2.GET("/blocks/evm/:number", ebc.Show)
+
+		elc := EVMLogsController{app}
+		authv2.GET("/logs/evm", elc.Index)
+
+		erc := EVMReceiptsController{app}
+		authv2.GET("/receipts/evm/:TxHash", erc.Show)
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -283,6 +340,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/csa/import", csakc.Import)
 		authv2.POST("/keys/csa/export/:ID", csakc.Export)
 
+		sc := SecretsController{app}
+		authv2.GET("/keys/secrets", sc.Index)
+		authv2.POST("/keys/secrets", sc.Create)
+		authv2.DELETE("/keys/secrets/:Name", sc.Delete)
+
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
 		authv2.POST("/keys/eth", ekc.Create)
@@ -291,6 +353,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/eth/import", ekc.Import)
 		authv2.POST("/keys/eth/export/:address", ekc.Export)
 
+		ektc := ETHKeyTopUpsController{app}
+		authv2.GET("/keys/eth/top_ups", ektc.Index)
+
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
 		authv2.POST("/keys/ocr", ocrkc.Create)
@@ -335,6 +400,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
+		authv2.GET("/jobs/export", jc.Export)
+		authv2.POST("/jobs/import", jc.Import)
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", jc.Create)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
@@ -343,6 +410,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/pipeline/runs/:runID/replay", prc.Replay)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -402,6 +470,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		// Debug routes accessible via authentication
 		metricRoutes(authv2)
+
+		pc := ProfilesController{app}
+		authv2.GET("/debug/profiles", pc.Index)
+		authv2.GET("/debug/profiles/:type", pc.Show)
+		authv2.GET("/debug/profiles/:type/diff", pc.Diff)
 	}
 
 	ping := PingController{app}
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// SecretsController manages the node secrets which job pipelines refer to as
+// $(secrets.name). Their values can be set, but are never returned.
+type SecretsController struct {
+	App chainlink.Application
+}
+
+// SetSecretRequest is the request body for setting a secret
+type SetSecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Index lists the names of the secrets
+// Example:
+// "GET <application>/keys/secrets"
+func (sc *SecretsController) Index(c *gin.Context) {
+	names, err := sc.App.GetKeyStore().Secrets().GetNames()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResources(names), "secrets")
+}
+
+// Create sets a secret, replacing its value if it already exists
+// Example:
+// "POST <application>/keys/secrets"
+func (sc *SecretsController) Create(c *gin.Context) {
+	var request SetSecretRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := sc.App.GetKeyStore().Secrets().Set(request.Name, request.Value)
+	if errors.Is(err, keystore.ErrInvalidSecret) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewSecretResource(request.Name), "secret", http.StatusCreated)
+}
+
+// Delete deletes a secret
+// Example:
+// "DELETE <application>/keys/secrets/:Name"
+func (sc *SecretsController) Delete(c *gin.Context) {
+	err := sc.App.GetKeyStore().Secrets().Delete(c.Param("Name"))
+	var notFound keystore.KeyNotFoundError
+	if errors.As(err, &notFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "secret", http.StatusNoContent)
+}
@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestSecretsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	setSecret := func(t *testing.T, name, value string, expectedStatus int) *http.Response {
+		body, err := json.Marshal(web.SetSecretRequest{Name: name, Value: value})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/keys/secrets", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, expectedStatus)
+		return resp
+	}
+
+	t.Run("sets a secret without returning its value", func(t *testing.T) {
+		resp := setSecret(t, "apiKey", "s3cr3t", http.StatusCreated)
+		body := cltest.ParseResponseBody(t, resp)
+		assert.NotContains(t, string(body), "s3cr3t")
+
+		resource := presenters.SecretResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(body, &resource))
+		assert.Equal(t, "apiKey", resource.Name)
+
+		value, err := app.GetKeyStore().Secrets().Get("apiKey")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", value)
+	})
+
+	t.Run("rejects an invalid secret", func(t *testing.T) {
+		setSecret(t, "api.key", "s3cr3t", http.StatusBadRequest)
+		setSecret(t, "empty", "", http.StatusBadRequest)
+	})
+
+	t.Run("lists secret names", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/keys/secrets")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		body := cltest.ParseResponseBody(t, resp)
+		assert.NotContains(t, string(body), "s3cr3t")
+
+		var resources []presenters.SecretResource
+		require.NoError(t, web.ParseJSONAPIResponse(body, &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, "apiKey", resources[0].Name)
+	})
+
+	t.Run("deletes a secret", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/keys/secrets/apiKey")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		resp, cleanup = client.Delete("/v2/keys/secrets/apiKey")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}